@@ -0,0 +1,54 @@
+package authn
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// _metadataKey is the gRPC metadata key carrying the bearer token (gRPC lowercases metadata keys).
+const _metadataKey = "authorization"
+
+// InterceptorGRPC is the gRPC counterpart of [MiddlewareHTTP], authenticating callers through bearer JSON Web
+// Tokens sent as `authorization` metadata.
+//
+// Requests without a valid token fail with the Unauthenticated code.
+type InterceptorGRPC struct {
+	config   Config
+	verifier tokenVerifier
+}
+
+// NewInterceptorGRPC creates a new [InterceptorGRPC] instance.
+func NewInterceptorGRPC(config Config, keySet KeySet) InterceptorGRPC {
+	return InterceptorGRPC{
+		config:   config,
+		verifier: newTokenVerifier(config, keySet),
+	}
+}
+
+// Unary is the [grpc.UnaryServerInterceptor] authenticating callers.
+func (i InterceptorGRPC) Unary(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+	if !i.config.Enabled {
+		return handler(ctx, req)
+	}
+
+	var header string
+	if values := metadata.ValueFromIncomingContext(ctx, _metadataKey); len(values) > 0 {
+		header = values[0]
+	}
+	raw, ok := parseBearerToken(header)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "bearer token is missing")
+	}
+
+	principal, err := i.verifier.verify(ctx, raw)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "bearer token is invalid")
+	}
+	return handler(identity.WithPrincipal(ctx, principal), req)
+}
@@ -0,0 +1,149 @@
+package authn_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/hadroncorp/service-template/authn"
+)
+
+func TestInterceptorGRPC_Unary(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, newKeySetJSON(t, key), 0o600))
+
+	config := authn.Config{
+		Enabled:    true,
+		JWKSFile:   jwksFile,
+		Issuer:     _testIssuer,
+		Audience:   _testAudience,
+		Algorithms: []string{"RS256"},
+		Leeway:     time.Second,
+	}
+	validClaims := func() jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Subject:   "alice",
+			Issuer:    _testIssuer,
+			Audience:  jwt.ClaimStrings{_testAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}
+	}
+	tests := []struct {
+		name         string
+		inConfig     func() authn.Config
+		inMetadata   func() string
+		expCode      codes.Code
+		expPrincipal string
+	}{
+		{
+			name: "valid token",
+			inMetadata: func() string {
+				return "Bearer " + newToken(t, key, validClaims())
+			},
+			expCode:      codes.OK,
+			expPrincipal: "alice",
+		},
+		{
+			name: "disabled",
+			inConfig: func() authn.Config {
+				return authn.Config{}
+			},
+			inMetadata: func() string {
+				return ""
+			},
+			expCode: codes.OK,
+		},
+		{
+			name: "missing metadata",
+			inMetadata: func() string {
+				return ""
+			},
+			expCode: codes.Unauthenticated,
+		},
+		{
+			name: "basic scheme",
+			inMetadata: func() string {
+				return "Basic YWxpY2U6c2VjcmV0"
+			},
+			expCode: codes.Unauthenticated,
+		},
+		{
+			name: "unknown signing key",
+			inMetadata: func() string {
+				return "Bearer " + newToken(t, otherKey, validClaims())
+			},
+			expCode: codes.Unauthenticated,
+		},
+		{
+			name: "expired",
+			inMetadata: func() string {
+				claims := validClaims()
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+				return "Bearer " + newToken(t, key, claims)
+			},
+			expCode: codes.Unauthenticated,
+		},
+		{
+			name: "no subject",
+			inMetadata: func() string {
+				claims := validClaims()
+				claims.Subject = ""
+				return "Bearer " + newToken(t, key, claims)
+			},
+			expCode: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			inConfig := config
+			if tt.inConfig != nil {
+				inConfig = tt.inConfig()
+			}
+			keySet, errKeySet := authn.NewKeySet(inConfig, slog.Default())
+			require.NoError(t, errKeySet)
+			t.Cleanup(keySet.Close)
+			interceptor := authn.NewInterceptorGRPC(inConfig, keySet)
+
+			ctx := context.Background()
+			if value := tt.inMetadata(); value != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", value))
+			}
+			var principal string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				if p, ok := identity.GetPrincipal(ctx); ok {
+					principal = p.ID()
+				}
+				return "ok", nil
+			}
+
+			// act
+			_, errUnary := interceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{
+				FullMethod: "/iam.v1.OrganizationService/GetOrganization",
+			}, handler)
+
+			// assert
+			assert.Equal(t, tt.expCode, status.Code(errUnary))
+			assert.Equal(t, tt.expPrincipal, principal)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/MicahParks/keyfunc/v3"
+)
+
+// ErrMissingKeySet is returned when authentication is enabled but no JSON Web Key Set source was configured.
+var ErrMissingKeySet = errors.New("authn: either JWKS URL or JWKS file must be set")
+
+// KeySet is the JSON Web Key Set (JWKS) holding the public keys that verify token signatures.
+//
+// Key sets loaded from a URL are refreshed in background, also when a token references an unknown key
+// (e.g. keys were rotated). Call [KeySet.Close] to stop refreshing.
+type KeySet struct {
+	keyfunc    keyfunc.Keyfunc
+	cancelFunc context.CancelFunc
+}
+
+// NewKeySet creates a new [KeySet] instance from the source set in config.
+//
+// If both are set, the file takes precedence over the URL. It returns a zero [KeySet] if authentication
+// is disabled.
+func NewKeySet(config Config, logger *slog.Logger) (KeySet, error) {
+	switch {
+	case !config.Enabled:
+		return KeySet{}, nil
+	case config.JWKSFile != "":
+		raw, err := os.ReadFile(config.JWKSFile)
+		if err != nil {
+			return KeySet{}, err
+		}
+		kf, err := keyfunc.NewJWKSetJSON(raw)
+		if err != nil {
+			return KeySet{}, err
+		}
+		return KeySet{keyfunc: kf}, nil
+	case config.JWKSURL != "":
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		kf, err := keyfunc.NewDefaultOverrideCtx(ctx, []string{config.JWKSURL}, keyfunc.Override{
+			RefreshInterval: config.JWKSRefreshInterval,
+			RefreshErrorHandlerFunc: func(url string) func(ctx context.Context, err error) {
+				return func(ctx context.Context, err error) {
+					logger.ErrorContext(ctx, "failed to refresh json web key set",
+						slog.String("url", url),
+						slog.String("error", err.Error()),
+					)
+				}
+			},
+		})
+		if err != nil {
+			cancelFunc()
+			return KeySet{}, err
+		}
+		return KeySet{keyfunc: kf, cancelFunc: cancelFunc}, nil
+	default:
+		return KeySet{}, ErrMissingKeySet
+	}
+}
+
+// Close stops refreshing the key set in background.
+func (k KeySet) Close() {
+	if k.cancelFunc != nil {
+		k.cancelFunc()
+	}
+}
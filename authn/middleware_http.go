@@ -0,0 +1,81 @@
+package authn
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/labstack/echo/v4"
+)
+
+// Config is the configuration of caller authentication.
+type Config struct {
+	// Enabled indicates whether callers must be authenticated. Disable it for local development only.
+	Enabled bool `env:"AUTHN_ENABLED" envDefault:"true"`
+	// JWKSURL is the URL of the JSON Web Key Set verifying token signatures (e.g. the identity provider
+	// `jwks_uri`).
+	JWKSURL string `env:"AUTHN_JWKS_URL"`
+	// JWKSFile is the path of a local file holding the JSON Web Key Set verifying token signatures.
+	JWKSFile string `env:"AUTHN_JWKS_FILE"`
+	// JWKSRefreshInterval is the time to wait between refreshes of the JSON Web Key Set fetched from
+	// JWKSURL.
+	JWKSRefreshInterval time.Duration `env:"AUTHN_JWKS_REFRESH_INTERVAL" envDefault:"1h"`
+	// Issuer is the expected `iss` claim of tokens. Any issuer is accepted if empty.
+	Issuer string `env:"AUTHN_ISSUER"`
+	// Audience is the expected `aud` claim of tokens. Any audience is accepted if empty.
+	Audience string `env:"AUTHN_AUDIENCE"`
+	// Algorithms are the accepted token signing algorithms.
+	Algorithms []string `env:"AUTHN_ALGORITHMS" envDefault:"RS256,ES256,EdDSA"`
+	// Leeway is the clock skew tolerated when validating the `exp`, `nbf` and `iat` claims of tokens.
+	Leeway time.Duration `env:"AUTHN_LEEWAY" envDefault:"30s"`
+}
+
+// NewConfig creates a new [Config] instance from environment variables.
+func NewConfig() (Config, error) {
+	return env.ParseAs[Config]()
+}
+
+// MiddlewareHTTP is the HTTP middleware authenticating callers through bearer JSON Web Tokens (RFC 6750).
+//
+// Tokens must be signed by a key from the [KeySet] and carry a `sub` claim, which becomes the
+// [identity.Principal] of the request context (e.g. the author recorded by audit fields) along the roles of the
+// `roles` claim. Requests without a valid token fail with 401 (Unauthorized).
+type MiddlewareHTTP struct {
+	config   Config
+	verifier tokenVerifier
+}
+
+// NewMiddlewareHTTP creates a new [MiddlewareHTTP] instance.
+func NewMiddlewareHTTP(config Config, keySet KeySet) MiddlewareHTTP {
+	return MiddlewareHTTP{
+		config:   config,
+		verifier: newTokenVerifier(config, keySet),
+	}
+}
+
+// Handle is the [echo.MiddlewareFunc] authenticating callers.
+func (m MiddlewareHTTP) Handle(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		if !m.config.Enabled {
+			return next(e)
+		}
+
+		raw, ok := parseBearerToken(e.Request().Header.Get(echo.HeaderAuthorization))
+		if !ok {
+			e.Response().Header().Set(echo.HeaderWWWAuthenticate, _bearerScheme)
+			return echo.NewHTTPError(http.StatusUnauthorized, "bearer token is missing")
+		}
+
+		ctx := e.Request().Context()
+		principal, err := m.verifier.verify(ctx, raw)
+		if err != nil {
+			e.Response().Header().Set(echo.HeaderWWWAuthenticate, _bearerScheme+` error="invalid_token"`)
+			return echo.NewHTTPError(http.StatusUnauthorized, "bearer token is invalid").SetInternal(err)
+		}
+
+		ctx = identity.WithPrincipal(ctx, principal)
+		e.SetRequest(e.Request().WithContext(ctx))
+		return next(e)
+	}
+}
@@ -0,0 +1,279 @@
+package authn_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hadroncorp/service-template/authn"
+)
+
+const (
+	_testKeyID    = "test-key"
+	_testIssuer   = "https://idp.example.com"
+	_testAudience = "iam"
+)
+
+// newKeySetJSON returns the JSON Web Key Set holding the public part of key.
+func newKeySetJSON(t *testing.T, key *rsa.PrivateKey) []byte {
+	t.Helper()
+	raw, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": _testKeyID,
+				"alg": "RS256",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	})
+	require.NoError(t, err)
+	return raw
+}
+
+// newToken returns a token signed by key, holding the given claims.
+func newToken(t *testing.T, key *rsa.PrivateKey, claims jwt.RegisteredClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = _testKeyID
+	raw, err := token.SignedString(key)
+	require.NoError(t, err)
+	return raw
+}
+
+func TestMiddlewareHTTP_Handle(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, newKeySetJSON(t, key), 0o600))
+
+	config := authn.Config{
+		Enabled:    true,
+		JWKSFile:   jwksFile,
+		Issuer:     _testIssuer,
+		Audience:   _testAudience,
+		Algorithms: []string{"RS256"},
+		Leeway:     time.Second,
+	}
+	validClaims := func() jwt.RegisteredClaims {
+		return jwt.RegisteredClaims{
+			Subject:   "alice",
+			Issuer:    _testIssuer,
+			Audience:  jwt.ClaimStrings{_testAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}
+	}
+	tests := []struct {
+		name         string
+		inConfig     func() authn.Config
+		inHeader     func() string
+		expStatus    int
+		expPrincipal string
+	}{
+		{
+			name: "valid token",
+			inHeader: func() string {
+				return "Bearer " + newToken(t, key, validClaims())
+			},
+			expStatus:    http.StatusOK,
+			expPrincipal: "alice",
+		},
+		{
+			name: "lowercase scheme",
+			inHeader: func() string {
+				return "bearer " + newToken(t, key, validClaims())
+			},
+			expStatus:    http.StatusOK,
+			expPrincipal: "alice",
+		},
+		{
+			name: "disabled",
+			inConfig: func() authn.Config {
+				return authn.Config{}
+			},
+			inHeader: func() string {
+				return ""
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name: "missing header",
+			inHeader: func() string {
+				return ""
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "basic scheme",
+			inHeader: func() string {
+				return "Basic YWxpY2U6c2VjcmV0"
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "malformed token",
+			inHeader: func() string {
+				return "Bearer foo"
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "unknown signing key",
+			inHeader: func() string {
+				return "Bearer " + newToken(t, otherKey, validClaims())
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired",
+			inHeader: func() string {
+				claims := validClaims()
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+				return "Bearer " + newToken(t, key, claims)
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "no expiration",
+			inHeader: func() string {
+				claims := validClaims()
+				claims.ExpiresAt = nil
+				return "Bearer " + newToken(t, key, claims)
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong issuer",
+			inHeader: func() string {
+				claims := validClaims()
+				claims.Issuer = "https://evil.example.com"
+				return "Bearer " + newToken(t, key, claims)
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong audience",
+			inHeader: func() string {
+				claims := validClaims()
+				claims.Audience = jwt.ClaimStrings{"billing"}
+				return "Bearer " + newToken(t, key, claims)
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "no subject",
+			inHeader: func() string {
+				claims := validClaims()
+				claims.Subject = ""
+				return "Bearer " + newToken(t, key, claims)
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "unsigned",
+			inHeader: func() string {
+				raw, errSign := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).
+					SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.NoError(t, errSign)
+				return "Bearer " + raw
+			},
+			expStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			inConfig := config
+			if tt.inConfig != nil {
+				inConfig = tt.inConfig()
+			}
+			keySet, errKeySet := authn.NewKeySet(inConfig, slog.Default())
+			require.NoError(t, errKeySet)
+			t.Cleanup(keySet.Close)
+			middleware := authn.NewMiddlewareHTTP(inConfig, keySet)
+
+			var principal string
+			e := echo.New()
+			e.GET("/organizations", func(e echo.Context) error {
+				if p, ok := identity.GetPrincipal(e.Request().Context()); ok {
+					principal = p.ID()
+				}
+				return e.NoContent(http.StatusOK)
+			}, middleware.Handle)
+			req := httptest.NewRequest(http.MethodGet, "/organizations", nil)
+			if header := tt.inHeader(); header != "" {
+				req.Header.Set(echo.HeaderAuthorization, header)
+			}
+			rec := httptest.NewRecorder()
+
+			// act
+			e.ServeHTTP(rec, req)
+
+			// assert
+			assert.Equal(t, tt.expStatus, rec.Code)
+			assert.Equal(t, tt.expPrincipal, principal)
+			if tt.expStatus == http.StatusUnauthorized {
+				assert.Contains(t, rec.Header().Get(echo.HeaderWWWAuthenticate), "Bearer")
+			}
+		})
+	}
+}
+
+func TestNewKeySet(t *testing.T) {
+	// arrange
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		_, _ = w.Write(newKeySetJSON(t, key))
+	}))
+	t.Cleanup(server.Close)
+	config := authn.Config{
+		Enabled:             true,
+		JWKSURL:             server.URL,
+		JWKSRefreshInterval: time.Hour,
+		Algorithms:          []string{"RS256"},
+	}
+
+	// act
+	keySet, err := authn.NewKeySet(config, slog.Default())
+	_, errMissing := authn.NewKeySet(authn.Config{Enabled: true}, slog.Default())
+
+	// assert
+	require.NoError(t, err)
+	t.Cleanup(keySet.Close)
+	assert.ErrorIs(t, errMissing, authn.ErrMissingKeySet)
+
+	e := echo.New()
+	e.GET("/organizations", func(e echo.Context) error {
+		return e.NoContent(http.StatusOK)
+	}, authn.NewMiddlewareHTTP(config, keySet).Handle)
+	req := httptest.NewRequest(http.MethodGet, "/organizations", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+newToken(t, key, jwt.RegisteredClaims{
+		Subject:   "alice",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
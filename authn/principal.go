@@ -0,0 +1,44 @@
+package authn
+
+import (
+	"slices"
+
+	"github.com/hadroncorp/geck/security/identity"
+)
+
+// Principal is the [identity.Principal] of an authenticated caller.
+type Principal struct {
+	id    string
+	roles []string
+	email string
+}
+
+// compile-time assertion
+var _ identity.Principal = (*Principal)(nil)
+
+// NewPrincipal creates a new [Principal] instance.
+func NewPrincipal(id string, roles ...string) Principal {
+	return Principal{id: id, roles: roles}
+}
+
+// ID returns the unique identifier of the caller (i.e. the token subject).
+func (p Principal) ID() string {
+	return p.id
+}
+
+// Roles returns the platform-wide roles granted to the caller.
+func (p Principal) Roles() []string {
+	return slices.Clone(p.roles)
+}
+
+// WithEmail returns a copy of p holding the verified email address of the caller.
+func (p Principal) WithEmail(email string) Principal {
+	p.email = email
+	return p
+}
+
+// Email returns the verified email address of the caller (i.e. the token `email` claim, if `email_verified` is
+// set). It returns an empty string if the caller has no verified email address.
+func (p Principal) Email() string {
+	return p.email
+}
@@ -0,0 +1,72 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const _bearerScheme = "Bearer"
+
+// errMissingSubject is returned when a valid token carries no `sub` claim.
+var errMissingSubject = errors.New("authn: token has no subject")
+
+// tokenClaims are the claims of tokens read by [MiddlewareHTTP] and [InterceptorGRPC].
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Roles         []string `json:"roles,omitempty"`
+	Email         string   `json:"email,omitempty"`
+	EmailVerified bool     `json:"email_verified,omitempty"`
+}
+
+// tokenVerifier verifies bearer JSON Web Tokens against a [KeySet].
+type tokenVerifier struct {
+	keySet KeySet
+	parser *jwt.Parser
+}
+
+func newTokenVerifier(config Config, keySet KeySet) tokenVerifier {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(config.Algorithms),
+		jwt.WithLeeway(config.Leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if config.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		opts = append(opts, jwt.WithAudience(config.Audience))
+	}
+	return tokenVerifier{
+		keySet: keySet,
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+// verify returns the [Principal] of the raw token.
+func (v tokenVerifier) verify(ctx context.Context, raw string) (Principal, error) {
+	claims := tokenClaims{}
+	if _, err := v.parser.ParseWithClaims(raw, &claims, v.keySet.keyfunc.KeyfuncCtx(ctx)); err != nil {
+		return Principal{}, err
+	} else if claims.Subject == "" {
+		return Principal{}, errMissingSubject
+	}
+	principal := NewPrincipal(claims.Subject, claims.Roles...)
+	if claims.EmailVerified {
+		principal = principal.WithEmail(claims.Email)
+	}
+	return principal, nil
+}
+
+// parseBearerToken returns the token of an Authorization header using the Bearer scheme.
+func parseBearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, _bearerScheme) {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,34 @@
+package authnfx
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/authn"
+)
+
+var Module = fx.Module("hadron/iam/authn",
+	fx.Provide(
+		authn.NewConfig,
+		newKeySet,
+		authn.NewMiddlewareHTTP,
+		authn.NewInterceptorGRPC,
+	),
+)
+
+// newKeySet creates the [authn.KeySet], which stops refreshing once the application stops.
+func newKeySet(lc fx.Lifecycle, config authn.Config, logger *slog.Logger) (authn.KeySet, error) {
+	keySet, err := authn.NewKeySet(config, logger)
+	if err != nil {
+		return authn.KeySet{}, err
+	}
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			keySet.Close()
+			return nil
+		},
+	})
+	return keySet, nil
+}
@@ -0,0 +1,201 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/hadroncorp/geck/syserr"
+)
+
+// ErrPermissionDenied is returned when the caller is not allowed to perform an operation.
+var ErrPermissionDenied = fmt.Errorf("authz: permission denied: %w", syserr.ErrForbidden)
+
+// Permission is an operation guarded by the [Authorizer] (e.g. `org:delete`).
+type Permission string
+
+// Role is a set of permissions granted to a caller, either platform-wide (e.g. through token claims) or on a
+// single organization (e.g. through memberships).
+type Role string
+
+const (
+	// RoleAny matches every authenticated caller.
+	RoleAny Role = "*"
+	// RoleOwner is the role of callers owning an organization.
+	RoleOwner Role = "owner"
+	// RoleAdmin is the role of callers administrating an organization.
+	RoleAdmin Role = "admin"
+	// RoleMember is the role of callers belonging to an organization.
+	RoleMember Role = "member"
+	// RoleIAMAdmin is the platform-wide role of callers administrating every organization (e.g. support staff).
+	RoleIAMAdmin Role = "iam_admin"
+)
+
+// Config is the configuration of the [Authorizer].
+type Config struct {
+	// Enabled indicates whether operations must be authorized. Disable it for local development only.
+	Enabled bool `env:"AUTHZ_ENABLED" envDefault:"true"`
+	// Policies maps permissions to the roles granting them, separated by `|` (e.g. `org:update=owner|admin`).
+	// They override the default policies of the permissions they list, the others keep their defaults.
+	Policies map[string]string `env:"AUTHZ_POLICIES" envSeparator:"," envKeyValSeparator:"="`
+}
+
+// NewConfig creates a new [Config] instance from environment variables.
+func NewConfig() (Config, error) {
+	return env.ParseAs[Config]()
+}
+
+// Policy maps every [Permission] to the roles granting it.
+type Policy map[Permission][]Role
+
+// DEV-NOTE: Packages declaring permissions also declare their default policy (e.g. organization.DefaultPolicy), so
+// deployments only configure the permissions they want to change and new permissions are granted out of the box.
+
+// NewPolicy creates a new [Policy] merging the given default policies, overridden by the policies of config.
+// Permissions configured without roles are denied.
+func NewPolicy(config Config, defaults ...Policy) Policy {
+	policy := make(Policy, len(config.Policies))
+	for _, def := range defaults {
+		for permission, roles := range def {
+			policy[permission] = slices.Clone(roles)
+		}
+	}
+	for permission, roles := range config.Policies {
+		key := Permission(strings.TrimSpace(permission))
+		policy[key] = nil
+		for _, role := range strings.Split(roles, "|") {
+			if role = strings.TrimSpace(role); role != "" {
+				policy[key] = append(policy[key], Role(role))
+			}
+		}
+		if len(policy[key]) == 0 {
+			delete(policy, key)
+		}
+	}
+	return policy
+}
+
+// RoleResolver resolves the roles a caller holds on an organization (e.g. through memberships).
+type RoleResolver interface {
+	// ResolveRoles returns the roles the principal identified by principalID holds on the organization
+	// identified by organizationID.
+	ResolveRoles(ctx context.Context, principalID, organizationID string) ([]Role, error)
+}
+
+// Authorizer is the policy engine deciding whether the caller of an operation is allowed to perform it.
+//
+// Callers are allowed if any of their platform-wide roles (see [identity.Principal]) or organization roles (see
+// [RoleResolver]) grant the permission of the operation. Denials are logged.
+type Authorizer struct {
+	config   Config
+	policy   Policy
+	resolver RoleResolver
+	logger   *slog.Logger
+}
+
+// NewAuthorizer creates a new [Authorizer] instance enforcing the given default policies, overridden by the
+// policies of config (see [NewPolicy]).
+func NewAuthorizer(config Config, resolver RoleResolver, logger *slog.Logger, defaults ...Policy) Authorizer {
+	return Authorizer{
+		config:   config,
+		policy:   NewPolicy(config, defaults...),
+		resolver: resolver,
+		logger:   logger,
+	}
+}
+
+// Authorize returns [ErrPermissionDenied] if the caller of ctx is not allowed to perform the operation
+// guarded by permission on the organization identified by organizationID.
+//
+// Use an empty organizationID for operations not targeting a single organization (e.g. creation).
+func (a Authorizer) Authorize(ctx context.Context, permission Permission, organizationID string) error {
+	decision, err := a.decide(ctx, permission, organizationID)
+	if err != nil {
+		return err
+	} else if !decision.isGranted {
+		a.deny(ctx, permission, organizationID, decision.principalID, decision.roles, decision.reason)
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// IsGranted indicates whether the caller of ctx is allowed to perform the operation guarded by permission on
+// the organization identified by organizationID. Unlike [Authorizer.Authorize], denials are not logged.
+//
+// Use it to narrow operations down to what callers are allowed to see (e.g. lists).
+func (a Authorizer) IsGranted(ctx context.Context, permission Permission, organizationID string) (bool, error) {
+	decision, err := a.decide(ctx, permission, organizationID)
+	return decision.isGranted, err
+}
+
+// decision is the outcome of an authorization.
+type decision struct {
+	isGranted   bool
+	principalID string
+	roles       []Role
+	// reason explains denials.
+	reason string
+}
+
+func (a Authorizer) decide(ctx context.Context, permission Permission, organizationID string) (decision, error) {
+	if !a.config.Enabled {
+		return decision{isGranted: true}, nil
+	}
+
+	principal, ok := identity.GetPrincipal(ctx)
+	if !ok {
+		return decision{reason: "caller is not authenticated"}, nil
+	}
+
+	granting := a.policy[permission]
+	if len(granting) == 0 {
+		return decision{principalID: principal.ID(), reason: "permission is not declared"}, nil
+	} else if slices.Contains(granting, RoleAny) {
+		return decision{isGranted: true, principalID: principal.ID()}, nil
+	}
+	var roles []Role
+	if holder, isHolder := principal.(interface{ Roles() []string }); isHolder {
+		for _, role := range holder.Roles() {
+			roles = append(roles, Role(role))
+		}
+	}
+	if isGranted(granting, roles) {
+		return decision{isGranted: true, principalID: principal.ID(), roles: roles}, nil
+	}
+	if organizationID != "" {
+		orgRoles, err := a.resolver.ResolveRoles(ctx, principal.ID(), organizationID)
+		if err != nil {
+			return decision{}, err
+		}
+		roles = append(roles, orgRoles...)
+		if isGranted(granting, orgRoles) {
+			return decision{isGranted: true, principalID: principal.ID(), roles: roles}, nil
+		}
+	}
+	return decision{principalID: principal.ID(), roles: roles, reason: "no role grants the permission"}, nil
+}
+
+// isGranted indicates whether any of roles is among the granting roles.
+func isGranted(granting, roles []Role) bool {
+	for _, role := range roles {
+		if slices.Contains(granting, role) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a Authorizer) deny(ctx context.Context, permission Permission, organizationID, principalID string,
+	roles []Role, reason string) {
+	a.logger.WarnContext(ctx, "permission denied",
+		slog.String("permission", string(permission)),
+		slog.String("organization_id", organizationID),
+		slog.String("principal_id", principalID),
+		slog.Any("roles", roles),
+		slog.String("reason", reason),
+	)
+}
@@ -0,0 +1,176 @@
+package authz_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/hadroncorp/geck/syserr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/authzmock"
+)
+
+func TestNewConfig(t *testing.T) {
+	// act
+	config, err := authz.NewConfig()
+
+	// assert
+	require.NoError(t, err)
+	assert.True(t, config.Enabled)
+	assert.Empty(t, config.Policies)
+}
+
+func TestNewPolicy(t *testing.T) {
+	// act
+	policy := authz.NewPolicy(authz.Config{
+		Policies: map[string]string{
+			"org:update": "owner| admin",
+			" org:get ":  "*",
+			"org:purge":  "",
+		},
+	})
+
+	// assert
+	assert.Equal(t, authz.Policy{
+		"org:update": {authz.RoleOwner, authz.RoleAdmin},
+		"org:get":    {authz.RoleAny},
+	}, policy)
+}
+
+func TestNewPolicy_Defaults(t *testing.T) {
+	// act
+	policy := authz.NewPolicy(authz.Config{
+		Policies: map[string]string{
+			"org:update": "owner",
+			"org:purge":  "",
+		},
+	}, authz.Policy{
+		"org:update": {authz.RoleOwner, authz.RoleAdmin},
+		"org:purge":  {authz.RoleIAMAdmin},
+	}, authz.Policy{
+		"member:list": {authz.RoleMember},
+	})
+
+	// assert
+	assert.Equal(t, authz.Policy{
+		"org:update":  {authz.RoleOwner},
+		"member:list": {authz.RoleMember},
+	}, policy)
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	config := authz.Config{
+		Enabled: true,
+		Policies: map[string]string{
+			"org:create": "*",
+			"org:update": "owner|admin|iam_admin",
+			"org:delete": "owner",
+		},
+	}
+	errResolve := errors.New("connection refused")
+	tests := []struct {
+		name             string
+		inConfig         authz.Config
+		inPrincipal      identity.Principal
+		inPermission     authz.Permission
+		inOrganizationID string
+		resolvedRoles    []authz.Role
+		resolveErr       error
+		expResolve       bool
+		expErr           error
+	}{
+		{
+			name:         "disabled",
+			inConfig:     authz.Config{},
+			inPermission: "org:delete",
+		},
+		{
+			name:         "unauthenticated",
+			inConfig:     config,
+			inPermission: "org:create",
+			expErr:       authz.ErrPermissionDenied,
+		},
+		{
+			name:         "any role",
+			inConfig:     config,
+			inPrincipal:  authn.NewPrincipal("alice"),
+			inPermission: "org:create",
+		},
+		{
+			name:             "platform role",
+			inConfig:         config,
+			inPrincipal:      authn.NewPrincipal("alice", "iam_admin"),
+			inPermission:     "org:update",
+			inOrganizationID: "1",
+		},
+		{
+			name:             "organization role",
+			inConfig:         config,
+			inPrincipal:      identity.NewBasicPrincipal("alice"),
+			inPermission:     "org:update",
+			inOrganizationID: "1",
+			resolvedRoles:    []authz.Role{authz.RoleAdmin},
+			expResolve:       true,
+		},
+		{
+			name:             "insufficient role",
+			inConfig:         config,
+			inPrincipal:      authn.NewPrincipal("alice", "iam_admin"),
+			inPermission:     "org:delete",
+			inOrganizationID: "1",
+			resolvedRoles:    []authz.Role{authz.RoleAdmin},
+			expResolve:       true,
+			expErr:           authz.ErrPermissionDenied,
+		},
+		{
+			name:         "undeclared permission",
+			inConfig:     config,
+			inPrincipal:  authn.NewPrincipal("alice", "iam_admin"),
+			inPermission: "org:purge",
+			expErr:       authz.ErrPermissionDenied,
+		},
+		{
+			name:             "resolver failure",
+			inConfig:         config,
+			inPrincipal:      authn.NewPrincipal("alice"),
+			inPermission:     "org:delete",
+			inOrganizationID: "1",
+			resolveErr:       errResolve,
+			expResolve:       true,
+			expErr:           errResolve,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			resolver := authzmock.NewMockRoleResolver(gomock.NewController(t))
+			if tt.expResolve {
+				resolver.EXPECT().
+					ResolveRoles(gomock.Any(), tt.inPrincipal.ID(), tt.inOrganizationID).
+					Times(1).
+					Return(tt.resolvedRoles, tt.resolveErr)
+			}
+			ctx := context.Background()
+			if tt.inPrincipal != nil {
+				ctx = identity.WithPrincipal(ctx, tt.inPrincipal)
+			}
+			authorizer := authz.NewAuthorizer(tt.inConfig, resolver, slog.Default())
+
+			// act
+			err := authorizer.Authorize(ctx, tt.inPermission, tt.inOrganizationID)
+
+			// assert
+			assert.ErrorIs(t, err, tt.expErr)
+			if errors.Is(tt.expErr, authz.ErrPermissionDenied) {
+				assert.ErrorIs(t, err, syserr.ErrForbidden)
+			}
+		})
+	}
+}
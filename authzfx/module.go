@@ -0,0 +1,39 @@
+package authzfx
+
+import (
+	"log/slog"
+
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/authz"
+)
+
+// _policyGroup is the value group holding the default [authz.Policy] of every module.
+const _policyGroup = "authz_policies"
+
+// Module provides the [authz.Authorizer]. Modules owning resources must provide the [authz.RoleResolver] and
+// modules declaring permissions their default policy (see [AsPolicy]).
+var Module = fx.Module("hadron/iam/authz",
+	fx.Provide(
+		authz.NewConfig,
+		fx.Annotate(
+			newAuthorizer,
+			fx.ParamTags(``, ``, ``, `group:"`+_policyGroup+`"`),
+		),
+	),
+)
+
+// AsPolicy annotates the given constructor so its result is enforced as a default policy of the
+// [authz.Authorizer]. Policies configured through [authz.Config] override it.
+func AsPolicy(f any) any {
+	return fx.Annotate(
+		f,
+		fx.ResultTags(`group:"`+_policyGroup+`"`),
+	)
+}
+
+// newAuthorizer creates the [authz.Authorizer] enforcing the default policies of every module.
+func newAuthorizer(config authz.Config, resolver authz.RoleResolver, logger *slog.Logger,
+	policies []authz.Policy) authz.Authorizer {
+	return authz.NewAuthorizer(config, resolver, logger, policies...)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: authz/authorizer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=authz/authorizer.go -destination=authzmock/authorizer.go -package=authzmock
+//
+
+// Package authzmock is a generated GoMock package.
+package authzmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	authz "github.com/hadroncorp/service-template/authz"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRoleResolver is a mock of RoleResolver interface.
+type MockRoleResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleResolverMockRecorder
+	isgomock struct{}
+}
+
+// MockRoleResolverMockRecorder is the mock recorder for MockRoleResolver.
+type MockRoleResolverMockRecorder struct {
+	mock *MockRoleResolver
+}
+
+// NewMockRoleResolver creates a new mock instance.
+func NewMockRoleResolver(ctrl *gomock.Controller) *MockRoleResolver {
+	mock := &MockRoleResolver{ctrl: ctrl}
+	mock.recorder = &MockRoleResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleResolver) EXPECT() *MockRoleResolverMockRecorder {
+	return m.recorder
+}
+
+// ResolveRoles mocks base method.
+func (m *MockRoleResolver) ResolveRoles(ctx context.Context, principalID, organizationID string) ([]authz.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRoles", ctx, principalID, organizationID)
+	ret0, _ := ret[0].([]authz.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveRoles indicates an expected call of ResolveRoles.
+func (mr *MockRoleResolverMockRecorder) ResolveRoles(ctx, principalID, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRoles", reflect.TypeOf((*MockRoleResolver)(nil).ResolveRoles), ctx, principalID, organizationID)
+}
@@ -4,8 +4,18 @@ import (
 	"github.com/hadroncorp/enclave"
 	enclavekafka "github.com/hadroncorp/enclave/kafka"
 
+	"github.com/hadroncorp/service-template/authnfx"
+	"github.com/hadroncorp/service-template/authzfx"
+	"github.com/hadroncorp/service-template/grpcserverfx"
+	"github.com/hadroncorp/service-template/idempotencyfx"
+	"github.com/hadroncorp/service-template/invitationfx"
+	"github.com/hadroncorp/service-template/membershipfx"
 	"github.com/hadroncorp/service-template/notificationfx"
+	"github.com/hadroncorp/service-template/openapifx"
 	"github.com/hadroncorp/service-template/organizationfx"
+	"github.com/hadroncorp/service-template/outboxfx"
+	"github.com/hadroncorp/service-template/problemfx"
+	"github.com/hadroncorp/service-template/settingsfx"
 )
 
 func main() {
@@ -17,7 +27,17 @@ func main() {
 		enclave.WithServerHTTP(),
 		enclave.WithFxOptions(
 			organizationfx.Module,
+			membershipfx.Module,
+			invitationfx.Module,
+			settingsfx.Module,
 			notificationfx.Module,
+			outboxfx.Module,
+			idempotencyfx.Module,
+			grpcserverfx.Module,
+			problemfx.Module,
+			openapifx.Module,
+			authnfx.Module,
+			authzfx.Module,
 		),
 	)
 }
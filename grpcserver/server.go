@@ -0,0 +1,86 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/internal/requestid"
+)
+
+// Config is the configuration of the gRPC server.
+type Config struct {
+	// Address is the TCP address the server listens on.
+	Address string `env:"GRPC_SERVER_ADDRESS" envDefault:":8081"`
+	// EnableReflection indicates whether the server reflection service must be registered, letting clients
+	// (e.g. grpcurl) discover services at runtime. Enable it for local development only, it exposes the whole
+	// API surface.
+	EnableReflection bool `env:"GRPC_SERVER_ENABLE_REFLECTION" envDefault:"false"`
+}
+
+// NewConfig creates a new [Config] instance from environment variables.
+func NewConfig() (Config, error) {
+	return env.ParseAs[Config]()
+}
+
+// A Controller exposes one or many gRPC services.
+type Controller interface {
+	// RegisterServices registers the services of the controller into s.
+	RegisterServices(s grpc.ServiceRegistrar)
+}
+
+// Server is a gRPC server exposing [Controller] services along the health checking service.
+type Server struct {
+	*grpc.Server
+	// Health is the health checking service of the server. Services are reported as serving once registered.
+	Health *health.Server
+}
+
+// NewServer creates a new [Server] instance with the services of the given controllers registered.
+//
+// Errors returned by services are translated into gRPC status errors using [NewStatusError]. Request identifiers
+// (x-request-id metadata) are propagated through the request context. Callers are authenticated by authn,
+// except for health checks, which are served to orchestrators (e.g. Kubernetes probes) holding no token.
+func NewServer(config Config, logger *slog.Logger, authn authn.InterceptorGRPC, controllers []Controller) Server {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			NewUnaryErrorInterceptor(logger),
+			requestid.NewUnaryInterceptor(),
+			skipHealthChecks(authn.Unary),
+		),
+	)
+	for _, controller := range controllers {
+		controller.RegisterServices(srv)
+	}
+
+	healthSrv := health.NewServer()
+	for name := range srv.GetServiceInfo() {
+		healthSrv.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	if config.EnableReflection {
+		reflection.Register(srv)
+	}
+	return Server{
+		Server: srv,
+		Health: healthSrv,
+	}
+}
+
+// skipHealthChecks wraps interceptor so it is not applied to the health checking service.
+func skipHealthChecks(interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	prefix := "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, prefix) {
+			return handler(ctx, req)
+		}
+		return interceptor(ctx, req, info, handler)
+	}
+}
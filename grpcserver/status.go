@@ -0,0 +1,66 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/hadroncorp/geck/syserr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewStatusError translates err into a gRPC status error.
+//
+// Errors already carrying a gRPC status are returned as is, [syserr] errors are mapped into their
+// gRPC counterpart and any other error is reported as internal. Conflicts are reported as failed preconditions,
+// controllers must translate retryable conflicts (i.e. version conflicts) into Aborted status errors.
+func NewStatusError(err error) error {
+	if err == nil {
+		return nil
+	} else if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, syserr.ErrResourceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, syserr.ErrResourceAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, syserr.ErrResourceConflict):
+		// the resource state rejects the operation (e.g. it has children or is suspended), retrying is pointless
+		// until the state changes. Controllers translate version conflicts into Aborted themselves (AIP-154).
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, syserr.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// NewUnaryErrorInterceptor creates a [grpc.UnaryServerInterceptor] translating handler errors using
+// [NewStatusError].
+//
+// Internal errors are logged as their details are hidden from clients.
+func NewUnaryErrorInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		res, err := handler(ctx, req)
+		if err == nil {
+			return res, nil
+		}
+
+		statusErr := NewStatusError(err)
+		if status.Code(statusErr) == codes.Internal {
+			logger.ErrorContext(ctx, "grpc request failed",
+				slog.String("method", info.FullMethod),
+				slog.String("error", err.Error()),
+			)
+		}
+		return nil, statusErr
+	}
+}
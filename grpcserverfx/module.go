@@ -0,0 +1,69 @@
+package grpcserverfx
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+
+	"github.com/hadroncorp/service-template/grpcserver"
+)
+
+// _controllerGroup is the value group holding every [grpcserver.Controller] in the dependency graph.
+const _controllerGroup = "grpc_controllers"
+
+var Module = fx.Module("hadron/iam/grpcserver",
+	fx.Provide(
+		grpcserver.NewConfig,
+		fx.Annotate(
+			grpcserver.NewServer,
+			fx.ParamTags(``, ``, ``, `group:"`+_controllerGroup+`"`),
+		),
+	),
+	fx.Invoke(startServer),
+)
+
+// AsController annotates the given constructor so its result is registered as a [grpcserver.Controller]
+// into the gRPC server.
+func AsController(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(grpcserver.Controller)),
+		fx.ResultTags(`group:"`+_controllerGroup+`"`),
+	)
+}
+
+// startServer serves the [grpcserver.Server] in background during the application lifetime.
+func startServer(lc fx.Lifecycle, config grpcserver.Config, srv grpcserver.Server, logger *slog.Logger) {
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", config.Address)
+			if err != nil {
+				return err
+			}
+			go func() {
+				defer close(done)
+				if errServe := srv.Serve(listener); errServe != nil && !errors.Is(errServe, grpc.ErrServerStopped) {
+					logger.Error("grpc server failed", slog.String("error", errServe.Error()))
+				}
+			}()
+			logger.Info("grpc server started", slog.String("address", config.Address))
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			srv.Health.Shutdown()
+			go srv.GracefulStop()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				srv.Stop()
+				return stopCtx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,203 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// HeaderIdempotencyKey is the request header carrying the idempotency key chosen by the client.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed is the response header set when a response is replayed from a previous request.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	_maxKeyLength = 255
+)
+
+// _requestScopedHeaders are the response headers describing a single request (e.g. its identifier). They are not
+// stored, so replayed responses carry the ones of the retry.
+var _requestScopedHeaders = []string{
+	echo.HeaderXRequestID,
+}
+
+// Config is the configuration of idempotency keys.
+type Config struct {
+	// TTL is the time a key is kept after the original request completed. Retries sent after TTL are
+	// processed as new requests.
+	TTL time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	// LockTimeout is the maximum time a key is held by an in-progress request. Retries sent after LockTimeout
+	// take over the key (e.g. the original request crashed before completing).
+	LockTimeout time.Duration `env:"IDEMPOTENCY_KEY_LOCK_TIMEOUT" envDefault:"1m"`
+	// PurgeInterval is the time to wait between expired keys purges.
+	PurgeInterval time.Duration `env:"IDEMPOTENCY_KEY_PURGE_INTERVAL" envDefault:"1h"`
+}
+
+// NewConfig creates a new [Config] instance from environment variables.
+func NewConfig() (Config, error) {
+	return env.ParseAs[Config]()
+}
+
+// MiddlewareHTTP is the HTTP middleware honoring the Idempotency-Key header.
+//
+// The first request sent with a key is processed and its response gets stored along a fingerprint of the request.
+// Retries sent with the same key get the stored response replayed, client errors (4xx) included. Reusing a key with
+// a different request fails with 422 (Unprocessable Entity), while retrying before the original request completes
+// fails with 409 (Conflict).
+//
+// Server errors (5xx) and requests failing once their response was sent (e.g. client gone) are not stored, so
+// clients may retry them.
+//
+// Keys are scoped by the authenticated principal, so place it after the authentication middleware. Different
+// principals sending the same key never share a response.
+//
+// Requests without the header are processed as usual.
+type MiddlewareHTTP struct {
+	config Config
+	store  Store
+	logger *slog.Logger
+}
+
+// NewMiddlewareHTTP creates a new [MiddlewareHTTP] instance.
+func NewMiddlewareHTTP(config Config, store Store, logger *slog.Logger) MiddlewareHTTP {
+	return MiddlewareHTTP{
+		config: config,
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Handle is the [echo.MiddlewareFunc] honoring the Idempotency-Key header.
+func (m MiddlewareHTTP) Handle(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		key := e.Request().Header.Get(HeaderIdempotencyKey)
+		if key == "" {
+			return next(e)
+		} else if len(key) > _maxKeyLength {
+			return echo.NewHTTPError(http.StatusBadRequest, "Idempotency-Key header is too long")
+		}
+
+		body, err := io.ReadAll(e.Request().Body)
+		if err != nil {
+			return err
+		}
+		e.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := e.Request().Context()
+		now := time.Now().UTC()
+		rec := Record{
+			Scope:       newScope(e),
+			Key:         key,
+			Fingerprint: newFingerprint(body),
+			CreateTime:  now,
+			ExpireTime:  now.Add(m.config.LockTimeout),
+		}
+		acquired, err := m.store.Acquire(ctx, rec)
+		if err != nil {
+			return err
+		} else if !acquired {
+			return m.replay(e, rec)
+		}
+
+		recorder := &responseRecorder{ResponseWriter: e.Response().Writer}
+		e.Response().Writer = recorder
+		err = next(e)
+		failed := err != nil && e.Response().Committed
+		if err != nil && !failed {
+			// DEV-NOTE: Render the error right away (i.e. problem details), so the response gets stored and
+			// replayed like any other. Error handlers skip committed responses, so it is not rendered twice.
+			e.Error(err)
+		}
+		// DEV-NOTE: Use a non-cancelable context, the client might be gone already.
+		storeCtx := context.WithoutCancel(ctx)
+		if failed || !e.Response().Committed || e.Response().Status >= http.StatusInternalServerError {
+			// let clients retry failed requests
+			if errRelease := m.store.Release(storeCtx, rec.Scope, rec.Key); errRelease != nil {
+				m.logger.ErrorContext(ctx, "failed to release idempotency key",
+					slog.String("key", rec.Key),
+					slog.String("error", errRelease.Error()),
+				)
+			}
+			return err
+		}
+
+		rec.StatusCode = e.Response().Status
+		rec.Header = e.Response().Header().Clone()
+		for _, name := range _requestScopedHeaders {
+			rec.Header.Del(name)
+		}
+		rec.Body = recorder.body.Bytes()
+		rec.ExpireTime = time.Now().UTC().Add(m.config.TTL)
+		if errComplete := m.store.Complete(storeCtx, rec); errComplete != nil {
+			// response was already sent, the key gets released once the lock times out
+			m.logger.ErrorContext(ctx, "failed to store idempotent response",
+				slog.String("key", rec.Key),
+				slog.String("error", errComplete.Error()),
+			)
+		}
+		return err
+	}
+}
+
+// replay writes the response stored for the key of rec.
+func (m MiddlewareHTTP) replay(e echo.Context, rec Record) error {
+	stored, err := m.store.Get(e.Request().Context(), rec.Scope, rec.Key)
+	if err != nil {
+		return err
+	} else if stored != nil && !bytes.Equal(stored.Fingerprint, rec.Fingerprint) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity,
+			"Idempotency-Key was already used with a different request")
+	} else if stored == nil || !stored.IsCompleted() {
+		return echo.NewHTTPError(http.StatusConflict,
+			"a request with the same Idempotency-Key is being processed, retry later")
+	}
+
+	for name, values := range stored.Header {
+		e.Response().Header()[name] = values
+	}
+	e.Response().Header().Set(HeaderIdempotentReplayed, "true")
+	e.Response().WriteHeader(stored.StatusCode)
+	_, err = e.Response().Write(stored.Body)
+	return err
+}
+
+// newScope returns the scope of the keys sent along the request of e (i.e. principal and operation).
+//
+// Anonymous requests (i.e. authentication disabled) share the same scope.
+func newScope(e echo.Context) string {
+	scope := e.Request().Method + " " + e.Request().URL.Path
+	if principal, ok := identity.GetPrincipal(e.Request().Context()); ok {
+		scope = principal.ID() + " " + scope
+	}
+	return scope
+}
+
+// newFingerprint returns the digest of a request body.
+func newFingerprint(body []byte) []byte {
+	digest := sha256.Sum256(body)
+	return digest[:]
+}
+
+// responseRecorder is a [http.ResponseWriter] keeping a copy of the response body.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the underlying [http.ResponseWriter], used by [http.ResponseController].
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,346 @@
+package idempotency_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/idempotency"
+	"github.com/hadroncorp/service-template/idempotencymock"
+)
+
+type middlewareHTTPSuite struct {
+	suite.Suite
+
+	config idempotency.Config
+}
+
+func TestMiddlewareHTTPSuite(t *testing.T) {
+	suite.Run(t, new(middlewareHTTPSuite))
+}
+
+func (s *middlewareHTTPSuite) SetupSuite() {
+	s.config = idempotency.Config{
+		TTL:         time.Hour,
+		LockTimeout: time.Minute,
+	}
+}
+
+// serve executes an anonymous POST /organizations request with the given key and body, using handler as endpoint.
+func (s *middlewareHTTPSuite) serve(store idempotency.Store, key, body string,
+	handler echo.HandlerFunc) *httptest.ResponseRecorder {
+	return s.serveAs(store, "", key, body, handler)
+}
+
+// serveAs executes a POST /organizations request on behalf of the principal identified by principalID (anonymous
+// if empty) with the given key and body, using handler as endpoint.
+func (s *middlewareHTTPSuite) serveAs(store idempotency.Store, principalID, key, body string,
+	handler echo.HandlerFunc) *httptest.ResponseRecorder {
+	e := echo.New()
+	middleware := idempotency.NewMiddlewareHTTP(s.config, store, slog.Default())
+	authenticate := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(e echo.Context) error {
+			if principalID != "" {
+				ctx := identity.WithPrincipal(e.Request().Context(), identity.NewBasicPrincipal(principalID))
+				e.SetRequest(e.Request().WithContext(ctx))
+			}
+			return next(e)
+		}
+	}
+	e.POST("/organizations", handler, authenticate, middleware.Handle)
+
+	req := httptest.NewRequest(http.MethodPost, "/organizations", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(idempotency.HeaderIdempotencyKey, key)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func newCreatedHandler(calls *int) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		*calls++
+		return e.JSON(http.StatusCreated, map[string]string{"organization_id": "1"})
+	}
+}
+
+func fingerprint(body string) []byte {
+	digest := sha256.Sum256([]byte(body))
+	return digest[:]
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_No_Key() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	calls := 0
+
+	// act
+	rec := s.serve(store, "", `{"name":"foo"}`, newCreatedHandler(&calls))
+
+	// assert
+	s.Assert().Equal(http.StatusCreated, rec.Code)
+	s.Assert().Equal(1, calls)
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_First_Request() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, rec idempotency.Record) (bool, error) {
+			s.Assert().Equal("POST /organizations", rec.Scope)
+			s.Assert().Equal("key-1", rec.Key)
+			s.Assert().Equal(fingerprint(`{"name":"foo"}`), rec.Fingerprint)
+			return true, nil
+		})
+	store.EXPECT().
+		Complete(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, rec idempotency.Record) error {
+			s.Assert().Equal(http.StatusCreated, rec.StatusCode)
+			s.Assert().Equal(echo.MIMEApplicationJSON, rec.Header.Get(echo.HeaderContentType))
+			s.Assert().JSONEq(`{"organization_id":"1"}`, string(rec.Body))
+			s.Assert().WithinDuration(time.Now().Add(s.config.TTL), rec.ExpireTime, time.Minute)
+			return nil
+		})
+	calls := 0
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"foo"}`, newCreatedHandler(&calls))
+
+	// assert
+	s.Assert().Equal(http.StatusCreated, rec.Code)
+	s.Assert().Equal(1, calls)
+	s.Assert().Empty(rec.Header().Get(idempotency.HeaderIdempotentReplayed))
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_Replay() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(false, error(nil))
+	store.EXPECT().
+		Get(gomock.Any(), "POST /organizations", "key-1").
+		Times(1).
+		Return(&idempotency.Record{
+			Scope:       "POST /organizations",
+			Key:         "key-1",
+			Fingerprint: fingerprint(`{"name":"foo"}`),
+			StatusCode:  http.StatusCreated,
+			Header: http.Header{
+				echo.HeaderContentType: []string{echo.MIMEApplicationJSON},
+				"Etag":                 []string{`"0"`},
+			},
+			Body: []byte(`{"organization_id":"1"}`),
+		}, error(nil))
+	calls := 0
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"foo"}`, newCreatedHandler(&calls))
+
+	// assert
+	s.Assert().Equal(http.StatusCreated, rec.Code)
+	s.Assert().Zero(calls)
+	s.Assert().Equal("true", rec.Header().Get(idempotency.HeaderIdempotentReplayed))
+	s.Assert().Equal(`"0"`, rec.Header().Get("ETag"))
+	s.Assert().JSONEq(`{"organization_id":"1"}`, rec.Body.String())
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_Different_Request() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(false, error(nil))
+	store.EXPECT().
+		Get(gomock.Any(), "POST /organizations", "key-1").
+		Times(1).
+		Return(&idempotency.Record{
+			Fingerprint: fingerprint(`{"name":"foo"}`),
+			StatusCode:  http.StatusCreated,
+		}, error(nil))
+	calls := 0
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"bar"}`, newCreatedHandler(&calls))
+
+	// assert
+	s.Assert().Equal(http.StatusUnprocessableEntity, rec.Code)
+	s.Assert().Zero(calls)
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_In_Progress() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(false, error(nil))
+	store.EXPECT().
+		Get(gomock.Any(), "POST /organizations", "key-1").
+		Times(1).
+		Return(&idempotency.Record{
+			Fingerprint: fingerprint(`{"name":"foo"}`),
+		}, error(nil))
+	calls := 0
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"foo"}`, newCreatedHandler(&calls))
+
+	// assert
+	s.Assert().Equal(http.StatusConflict, rec.Code)
+	s.Assert().Zero(calls)
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_In_Progress_Different_Request() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(false, error(nil))
+	store.EXPECT().
+		Get(gomock.Any(), "POST /organizations", "key-1").
+		Times(1).
+		Return(&idempotency.Record{
+			Fingerprint: fingerprint(`{"name":"foo"}`),
+		}, error(nil))
+	calls := 0
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"bar"}`, newCreatedHandler(&calls))
+
+	// assert
+	s.Assert().Equal(http.StatusUnprocessableEntity, rec.Code)
+	s.Assert().Zero(calls)
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_Different_Principals() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	var scopes []string
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ any, rec idempotency.Record) (bool, error) {
+			scopes = append(scopes, rec.Scope)
+			return true, nil
+		})
+	store.EXPECT().
+		Complete(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(error(nil))
+	calls := 0
+
+	// act
+	recAlice := s.serveAs(store, "alice", "key-1", `{"name":"foo"}`, newCreatedHandler(&calls))
+	recBob := s.serveAs(store, "bob", "key-1", `{"name":"foo"}`, newCreatedHandler(&calls))
+
+	// assert
+	s.Assert().Equal(http.StatusCreated, recAlice.Code)
+	s.Assert().Equal(http.StatusCreated, recBob.Code)
+	s.Assert().Empty(recBob.Header().Get(idempotency.HeaderIdempotentReplayed))
+	s.Assert().Equal(2, calls)
+	s.Assert().Equal([]string{"alice POST /organizations", "bob POST /organizations"}, scopes)
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_Failed_Request() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(true, error(nil))
+	store.EXPECT().
+		Release(gomock.Any(), "POST /organizations", "key-1").
+		Times(1).
+		Return(error(nil))
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"foo"}`, func(_ echo.Context) error {
+		return errors.New("unexpected failure")
+	})
+
+	// assert
+	s.Assert().Equal(http.StatusInternalServerError, rec.Code)
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_Client_Error() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(true, error(nil))
+	store.EXPECT().
+		Complete(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, rec idempotency.Record) error {
+			s.Assert().Equal(http.StatusBadRequest, rec.StatusCode)
+			s.Assert().JSONEq(`{"message":"name is invalid"}`, string(rec.Body))
+			return nil
+		})
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"foo"}`, func(_ echo.Context) error {
+		return echo.NewHTTPError(http.StatusBadRequest, "name is invalid")
+	})
+
+	// assert
+	s.Assert().Equal(http.StatusBadRequest, rec.Code)
+	s.Assert().JSONEq(`{"message":"name is invalid"}`, rec.Body.String())
+}
+
+func (s *middlewareHTTPSuite) TestMiddlewareHTTP_Handle_Request_Scoped_Headers() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	store := idempotencymock.NewMockStore(ctrl)
+	store.EXPECT().
+		Acquire(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(true, error(nil))
+	store.EXPECT().
+		Complete(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ any, rec idempotency.Record) error {
+			s.Assert().Empty(rec.Header.Get(echo.HeaderXRequestID))
+			s.Assert().Equal(echo.MIMEApplicationJSON, rec.Header.Get(echo.HeaderContentType))
+			return nil
+		})
+	calls := 0
+
+	// act
+	rec := s.serve(store, "key-1", `{"name":"foo"}`, func(e echo.Context) error {
+		e.Response().Header().Set(echo.HeaderXRequestID, "request-1")
+		return newCreatedHandler(&calls)(e)
+	})
+
+	// assert
+	s.Assert().Equal(http.StatusCreated, rec.Code)
+	s.Assert().Equal("request-1", rec.Header().Get(echo.HeaderXRequestID))
+}
@@ -0,0 +1,43 @@
+package idempotency
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Purger is the component removing expired idempotency keys from a [Store].
+type Purger struct {
+	config Config
+	store  Store
+	logger *slog.Logger
+}
+
+// NewPurger creates a new [Purger] instance.
+func NewPurger(config Config, store Store, logger *slog.Logger) Purger {
+	return Purger{
+		config: config,
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Run purges expired idempotency keys every [Config.PurgeInterval] until ctx is done.
+func (p Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.config.PurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		purged, err := p.store.PurgeExpired(ctx, time.Now().UTC())
+		if err != nil && ctx.Err() == nil {
+			p.logger.ErrorContext(ctx, "failed to purge expired idempotency keys", slog.String("error", err.Error()))
+			continue
+		}
+		p.logger.DebugContext(ctx, "purged expired idempotency keys", slog.Int64("total", purged))
+	}
+}
@@ -0,0 +1,49 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Record is the state of an idempotency key.
+type Record struct {
+	// Scope is the principal and operation the key is used for (e.g. `alice POST /v1/organizations`). Keys are
+	// unique by scope.
+	Scope string
+	// Key is the idempotency key sent by the client.
+	Key string
+	// Fingerprint is the digest of the request originally sent with the key.
+	Fingerprint []byte
+	// StatusCode is the status code of the original response. Zero while the original request is in progress.
+	StatusCode int
+	// Header is the header of the original response.
+	Header http.Header
+	// Body is the body of the original response.
+	Body []byte
+	// CreateTime is the time the key was first used.
+	CreateTime time.Time
+	// ExpireTime is the time the key becomes available again.
+	ExpireTime time.Time
+}
+
+// IsCompleted indicates whether the original request already got a response.
+func (r Record) IsCompleted() bool {
+	return r.StatusCode != 0
+}
+
+// A Store persists idempotency keys along the responses they produced.
+type Store interface {
+	// Acquire reserves the key of rec, so no other request is able to use it until rec.ExpireTime.
+	//
+	// It returns false if the key is already in use (i.e. reserved or completed and not expired yet).
+	Acquire(ctx context.Context, rec Record) (bool, error)
+	// Get retrieves a [Record] by its scope and key. It returns nil if no record was found.
+	Get(ctx context.Context, scope, key string) (*Record, error)
+	// Complete stores the response of rec, keeping the key in use until rec.ExpireTime.
+	Complete(ctx context.Context, rec Record) error
+	// Release frees a key, so it can be used again right away (e.g. the original request failed).
+	Release(ctx context.Context, scope, key string) error
+	// PurgeExpired removes every key expired by the given time. It returns the number of removed keys.
+	PurgeExpired(ctx context.Context, now time.Time) (int64, error)
+}
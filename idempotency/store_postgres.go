@@ -0,0 +1,98 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+)
+
+// PostgresStore is the [Store] implementation for Postgres.
+type PostgresStore struct {
+	db *postgresgen.Queries
+}
+
+// compile-time assertion
+var _ Store = (*PostgresStore)(nil)
+
+// NewPostgresStore creates a new [PostgresStore] instance.
+func NewPostgresStore(db gecksql.DB) PostgresStore {
+	return PostgresStore{
+		db: postgresgen.New(db),
+	}
+}
+
+func (p PostgresStore) Acquire(ctx context.Context, rec Record) (bool, error) {
+	affected, err := p.db.AcquireIdempotencyKey(ctx, postgresgen.AcquireIdempotencyKeyParams{
+		Scope:          rec.Scope,
+		IdempotencyKey: rec.Key,
+		Fingerprint:    rec.Fingerprint,
+		CreateTime:     rec.CreateTime,
+		ExpireTime:     rec.ExpireTime,
+	})
+	return affected > 0, err
+}
+
+func (p PostgresStore) Get(ctx context.Context, scope, key string) (*Record, error) {
+	model, err := p.db.GetIdempotencyKey(ctx, postgresgen.GetIdempotencyKeyParams{
+		Scope:          scope,
+		IdempotencyKey: key,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var header http.Header
+	if len(model.ResponseHeader) > 0 {
+		if err = json.Unmarshal(model.ResponseHeader, &header); err != nil {
+			return nil, err
+		}
+	}
+	return &Record{
+		Scope:       model.Scope,
+		Key:         model.IdempotencyKey,
+		Fingerprint: model.Fingerprint,
+		StatusCode:  int(model.StatusCode.Int32),
+		Header:      header,
+		Body:        model.ResponseBody,
+		CreateTime:  model.CreateTime,
+		ExpireTime:  model.ExpireTime,
+	}, nil
+}
+
+func (p PostgresStore) Complete(ctx context.Context, rec Record) error {
+	header, err := json.Marshal(rec.Header)
+	if err != nil {
+		return err
+	}
+	return p.db.CompleteIdempotencyKey(ctx, postgresgen.CompleteIdempotencyKeyParams{
+		Scope:          rec.Scope,
+		IdempotencyKey: rec.Key,
+		StatusCode: sql.NullInt32{
+			Int32: int32(rec.StatusCode),
+			Valid: true,
+		},
+		ResponseHeader: header,
+		ResponseBody:   rec.Body,
+		ExpireTime:     rec.ExpireTime,
+	})
+}
+
+func (p PostgresStore) Release(ctx context.Context, scope, key string) error {
+	return p.db.DeleteIdempotencyKey(ctx, postgresgen.DeleteIdempotencyKeyParams{
+		Scope:          scope,
+		IdempotencyKey: key,
+	})
+}
+
+func (p PostgresStore) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+	return p.db.DeleteExpiredIdempotencyKeys(ctx, now)
+}
@@ -0,0 +1,137 @@
+//go:build integration
+
+package idempotency_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/persistence/postgres/postgrestest"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hadroncorp/service-template/idempotency"
+)
+
+type postgresStoreIntegrationSuite struct {
+	suite.Suite
+
+	baseCtx           context.Context
+	baseCtxCancelFunc context.CancelFunc
+	dbContainer       *postgrestest.Container
+	store             idempotency.PostgresStore
+}
+
+func TestPostgresStoreIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(postgresStoreIntegrationSuite))
+}
+
+func (s *postgresStoreIntegrationSuite) SetupSuite() {
+	// setup context
+	const testSuiteTimeout = time.Minute
+	s.baseCtx, s.baseCtxCancelFunc = context.WithTimeout(context.Background(), testSuiteTimeout)
+
+	// setup container
+	var err error
+	s.dbContainer, err = postgrestest.NewContainer(s.baseCtx, s.T())
+	s.Require().NoError(err)
+	db, err := postgrestest.StartContainer(s.baseCtx, s.T(), s.dbContainer, "./thirdparty/postgres/migrations")
+	s.Require().NoError(err)
+	s.store = idempotency.NewPostgresStore(gecksql.NewDB(db))
+}
+
+func (s *postgresStoreIntegrationSuite) TearDownSuite() {
+	defer s.baseCtxCancelFunc()
+	shutdownCtx, cancelFunc := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFunc()
+	s.Assert().NoError(s.dbContainer.Instance.Terminate(shutdownCtx))
+}
+
+func newRecord(key string, createTime time.Time, lockTimeout time.Duration) idempotency.Record {
+	return idempotency.Record{
+		Scope:       "POST /organizations",
+		Key:         key,
+		Fingerprint: []byte("fingerprint"),
+		CreateTime:  createTime,
+		ExpireTime:  createTime.Add(lockTimeout),
+	}
+}
+
+func (s *postgresStoreIntegrationSuite) TestPostgresStore_Acquire_Complete() {
+	// arrange
+	now := time.Now().UTC()
+	rec := newRecord("complete", now, time.Minute)
+
+	// act
+	acquired, err := s.store.Acquire(s.baseCtx, rec)
+	s.Require().NoError(err)
+	acquiredAgain, err := s.store.Acquire(s.baseCtx, rec)
+	s.Require().NoError(err)
+
+	rec.StatusCode = http.StatusCreated
+	rec.Header = http.Header{"Content-Type": []string{"application/json"}}
+	rec.Body = []byte(`{"organization_id":"1"}`)
+	rec.ExpireTime = now.Add(time.Hour)
+	s.Require().NoError(s.store.Complete(s.baseCtx, rec))
+	stored, err := s.store.Get(s.baseCtx, rec.Scope, rec.Key)
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().True(acquired)
+	s.Assert().False(acquiredAgain)
+	s.Require().NotNil(stored)
+	s.Assert().True(stored.IsCompleted())
+	s.Assert().Equal(rec.Fingerprint, stored.Fingerprint)
+	s.Assert().Equal(http.StatusCreated, stored.StatusCode)
+	s.Assert().Equal("application/json", stored.Header.Get("Content-Type"))
+	s.Assert().Equal(rec.Body, stored.Body)
+}
+
+func (s *postgresStoreIntegrationSuite) TestPostgresStore_Acquire_Expired() {
+	// arrange
+	past := time.Now().UTC().Add(-time.Hour)
+	_, err := s.store.Acquire(s.baseCtx, newRecord("expired", past, time.Minute))
+	s.Require().NoError(err)
+
+	// act
+	acquired, err := s.store.Acquire(s.baseCtx, newRecord("expired", time.Now().UTC(), time.Minute))
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().True(acquired)
+}
+
+func (s *postgresStoreIntegrationSuite) TestPostgresStore_Release() {
+	// arrange
+	rec := newRecord("release", time.Now().UTC(), time.Minute)
+	_, err := s.store.Acquire(s.baseCtx, rec)
+	s.Require().NoError(err)
+
+	// act
+	err = s.store.Release(s.baseCtx, rec.Scope, rec.Key)
+	stored, errGet := s.store.Get(s.baseCtx, rec.Scope, rec.Key)
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().NoError(errGet)
+	s.Assert().Nil(stored)
+}
+
+func (s *postgresStoreIntegrationSuite) TestPostgresStore_PurgeExpired() {
+	// arrange
+	rec := newRecord("purge", time.Now().UTC().Add(-time.Hour), time.Minute)
+	_, err := s.store.Acquire(s.baseCtx, rec)
+	s.Require().NoError(err)
+
+	// act
+	purged, err := s.store.PurgeExpired(s.baseCtx, time.Now().UTC())
+	stored, errGet := s.store.Get(s.baseCtx, rec.Scope, rec.Key)
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Positive(purged)
+	s.Assert().NoError(errGet)
+	s.Assert().Nil(stored)
+}
@@ -0,0 +1,46 @@
+package idempotencyfx
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/idempotency"
+)
+
+var Module = fx.Module("hadron/iam/idempotency",
+	fx.Provide(
+		idempotency.NewConfig,
+		fx.Annotate(
+			idempotency.NewPostgresStore,
+			fx.As(new(idempotency.Store)),
+		),
+		idempotency.NewMiddlewareHTTP,
+		idempotency.NewPurger,
+	),
+	fx.Invoke(startPurger),
+)
+
+// startPurger runs the [idempotency.Purger] in background during the application lifetime.
+func startPurger(lc fx.Lifecycle, purger idempotency.Purger) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				purger.Run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancelFunc()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
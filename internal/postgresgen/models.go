@@ -6,6 +6,7 @@ package postgresgen
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
@@ -31,6 +32,33 @@ type Employee struct {
 	FullName   string
 }
 
+type IdempotencyKey struct {
+	Scope          string
+	IdempotencyKey string
+	Fingerprint    []byte
+	StatusCode     sql.NullInt32
+	ResponseHeader []byte
+	ResponseBody   []byte
+	CreateTime     time.Time
+	ExpireTime     time.Time
+}
+
+type Invitation struct {
+	InvitationID   string
+	OrganizationID string
+	Email          string
+	Role           string
+	Status         string
+	ExpireTime     time.Time
+	SendCount      int32
+	LastSendTime   time.Time
+	CreateTime     time.Time
+	CreateBy       string
+	LastUpdateTime time.Time
+	LastUpdateBy   string
+	RowVersion     int64
+}
+
 type Organization struct {
 	OrganizationID string
 	Name           string
@@ -40,6 +68,69 @@ type Organization struct {
 	LastUpdateBy   string
 	RowVersion     int64
 	IsDeleted      bool
+	ParentID       sql.NullString
+	Slug           string
+	Description    string
+	WebsiteUrl     string
+	LogoUrl        string
+	CountryCode    string
+	Locale         string
+	TimeZone       string
+	LegalID        string
+	TaxID          string
+	Status         string
+	StatusReason   string
+	Labels         json.RawMessage
+}
+
+type OrganizationHistory struct {
+	RecordID       int64
+	OrganizationID string
+	Operation      string
+	Actor          string
+	RequestID      string
+	BeforeSnapshot json.RawMessage
+	AfterSnapshot  json.RawMessage
+	RecordTime     time.Time
+}
+
+type OrganizationMember struct {
+	OrganizationID string
+	UserID         string
+	Role           string
+	CreateTime     time.Time
+	CreateBy       string
+	LastUpdateTime time.Time
+	LastUpdateBy   string
+	RowVersion     int64
+}
+
+type OrganizationSetting struct {
+	OrganizationID string
+	Settings       json.RawMessage
+	CreateTime     time.Time
+	CreateBy       string
+	LastUpdateTime time.Time
+	LastUpdateBy   string
+	RowVersion     int64
+}
+
+type OutboxEvent struct {
+	SequenceID      int64
+	EventID         string
+	Topic           string
+	PartitionKey    string
+	Source          string
+	Subject         string
+	SchemaSource    string
+	ContentType     string
+	Payload         []byte
+	OccurrenceTime  time.Time
+	CreateTime      time.Time
+	SendTime        sql.NullTime
+	Attempts        int32
+	NextAttemptTime time.Time
+	LastError       sql.NullString
 }
 
 type Place struct {
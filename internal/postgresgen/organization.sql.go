@@ -8,13 +8,15 @@ package postgresgen
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
 const createOrganization = `-- name: CreateOrganization :exec
-INSERT INTO organizations (organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted)
+INSERT INTO organizations (organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted, parent_id, slug,
+                           description, website_url, logo_url, country_code, locale, time_zone, legal_id, tax_id, status, status_reason, labels)
 VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8)
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 `
 
 type CreateOrganizationParams struct {
@@ -26,6 +28,19 @@ type CreateOrganizationParams struct {
 	LastUpdateBy   string
 	RowVersion     int64
 	IsDeleted      bool
+	ParentID       sql.NullString
+	Slug           string
+	Description    string
+	WebsiteUrl     string
+	LogoUrl        string
+	CountryCode    string
+	Locale         string
+	TimeZone       string
+	LegalID        string
+	TaxID          string
+	Status         string
+	StatusReason   string
+	Labels         json.RawMessage
 }
 
 func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error {
@@ -38,6 +53,19 @@ func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganization
 		arg.LastUpdateBy,
 		arg.RowVersion,
 		arg.IsDeleted,
+		arg.ParentID,
+		arg.Slug,
+		arg.Description,
+		arg.WebsiteUrl,
+		arg.LogoUrl,
+		arg.CountryCode,
+		arg.Locale,
+		arg.TimeZone,
+		arg.LegalID,
+		arg.TaxID,
+		arg.Status,
+		arg.StatusReason,
+		arg.Labels,
 	)
 	return err
 }
@@ -60,19 +88,64 @@ func (q *Queries) DeleteOrganizationByName(ctx context.Context, name string) err
 	return err
 }
 
+const deleteOrganizationSlugRedirect = `-- name: DeleteOrganizationSlugRedirect :exec
+DELETE FROM organization_slug_redirects WHERE slug = lower($1)
+`
+
+func (q *Queries) DeleteOrganizationSlugRedirect(ctx context.Context, slug string) error {
+	_, err := q.db.ExecContext(ctx, deleteOrganizationSlugRedirect, slug)
+	return err
+}
+
 const existOrganizationByName = `-- name: ExistOrganizationByName :one
-SELECT EXISTS(SELECT 1 FROM organizations WHERE name = $1 LIMIT 1)
+SELECT EXISTS(
+    SELECT 1
+    FROM organizations
+    WHERE
+        lower(name) = lower($1)
+        AND is_deleted = false
+        AND organization_id <> $2
+    LIMIT 1
+)
 `
 
-func (q *Queries) ExistOrganizationByName(ctx context.Context, name string) (bool, error) {
-	row := q.db.QueryRowContext(ctx, existOrganizationByName, name)
+type ExistOrganizationByNameParams struct {
+	Name                  string
+	ExcludeOrganizationID string
+}
+
+func (q *Queries) ExistOrganizationByName(ctx context.Context, arg ExistOrganizationByNameParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, existOrganizationByName, arg.Name, arg.ExcludeOrganizationID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const existOrganizationBySlug = `-- name: ExistOrganizationBySlug :one
+SELECT EXISTS(
+    SELECT 1
+    FROM organizations
+    WHERE
+        lower(slug) = lower($1)
+        AND organization_id <> $2
+    LIMIT 1
+)
+`
+
+type ExistOrganizationBySlugParams struct {
+	Slug                  string
+	ExcludeOrganizationID string
+}
+
+func (q *Queries) ExistOrganizationBySlug(ctx context.Context, arg ExistOrganizationBySlugParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, existOrganizationBySlug, arg.Slug, arg.ExcludeOrganizationID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
 }
 
 const getOrganizationByID = `-- name: GetOrganizationByID :one
-SELECT organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted FROM organizations WHERE organization_id = $1 LIMIT 1
+SELECT organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted, parent_id, slug, description, website_url, logo_url, country_code, locale, time_zone, legal_id, tax_id, status, status_reason, labels FROM organizations WHERE organization_id = $1 LIMIT 1
 `
 
 func (q *Queries) GetOrganizationByID(ctx context.Context, organizationID string) (Organization, error) {
@@ -87,75 +160,296 @@ func (q *Queries) GetOrganizationByID(ctx context.Context, organizationID string
 		&i.LastUpdateBy,
 		&i.RowVersion,
 		&i.IsDeleted,
+		&i.ParentID,
+		&i.Slug,
+		&i.Description,
+		&i.WebsiteUrl,
+		&i.LogoUrl,
+		&i.CountryCode,
+		&i.Locale,
+		&i.TimeZone,
+		&i.LegalID,
+		&i.TaxID,
+		&i.Status,
+		&i.StatusReason,
+		&i.Labels,
 	)
 	return i, err
 }
 
-const hasMorePagesOrganizationList = `-- name: HasMorePagesOrganizationList :one
-SELECT EXISTS(
-    SELECT 1
-    FROM organizations
-    WHERE
-        create_time > $1::timestamptz
-    LIMIT 1
-) AS has_next,
-EXISTS(
-    SELECT 1
-    FROM organizations
-    WHERE
-        create_time < $2::timestamptz
-    ORDER BY create_time DESC
-    LIMIT 1
-) AS has_prev
+const getOrganizationBySlug = `-- name: GetOrganizationBySlug :one
+SELECT organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted, parent_id, slug, description, website_url, logo_url, country_code, locale, time_zone, legal_id, tax_id, status, status_reason, labels FROM organizations WHERE lower(slug) = lower($1) LIMIT 1
+`
+
+func (q *Queries) GetOrganizationBySlug(ctx context.Context, slug string) (Organization, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationBySlug, slug)
+	var i Organization
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Name,
+		&i.CreateTime,
+		&i.CreateBy,
+		&i.LastUpdateTime,
+		&i.LastUpdateBy,
+		&i.RowVersion,
+		&i.IsDeleted,
+		&i.ParentID,
+		&i.Slug,
+		&i.Description,
+		&i.WebsiteUrl,
+		&i.LogoUrl,
+		&i.CountryCode,
+		&i.Locale,
+		&i.TimeZone,
+		&i.LegalID,
+		&i.TaxID,
+		&i.Status,
+		&i.StatusReason,
+		&i.Labels,
+	)
+	return i, err
+}
+
+const getOrganizationSlugRedirect = `-- name: GetOrganizationSlugRedirect :one
+SELECT organization_id FROM organization_slug_redirects WHERE slug = lower($1) LIMIT 1
 `
 
-type HasMorePagesOrganizationListParams struct {
-	CursorNext sql.NullTime
-	CursorPrev sql.NullTime
+func (q *Queries) GetOrganizationSlugRedirect(ctx context.Context, slug string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationSlugRedirect, slug)
+	var organization_id string
+	err := row.Scan(&organization_id)
+	return organization_id, err
 }
 
-type HasMorePagesOrganizationListRow struct {
-	HasNext bool
-	HasPrev bool
+const listOrganizationAncestors = `-- name: ListOrganizationAncestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT parent.organization_id, parent.parent_id, 1 AS depth
+    FROM organizations child
+    JOIN organizations parent ON parent.organization_id = child.parent_id
+    WHERE child.organization_id = $1
+    UNION ALL
+    SELECT parent.organization_id, parent.parent_id, ancestors.depth + 1
+    FROM ancestors
+    JOIN organizations parent ON parent.organization_id = ancestors.parent_id
+)
+SELECT organizations.organization_id, organizations.name, organizations.create_time, organizations.create_by, organizations.last_update_time, organizations.last_update_by, organizations.row_version, organizations.is_deleted, organizations.parent_id, organizations.slug, organizations.description, organizations.website_url, organizations.logo_url, organizations.country_code, organizations.locale, organizations.time_zone, organizations.legal_id, organizations.tax_id, organizations.status, organizations.status_reason, organizations.labels, ancestors.depth::int AS depth
+FROM ancestors
+JOIN organizations ON organizations.organization_id = ancestors.organization_id
+ORDER BY ancestors.depth ASC
+`
+
+type ListOrganizationAncestorsRow struct {
+	Organization Organization
+	Depth        int32
 }
 
-func (q *Queries) HasMorePagesOrganizationList(ctx context.Context, arg HasMorePagesOrganizationListParams) (HasMorePagesOrganizationListRow, error) {
-	row := q.db.QueryRowContext(ctx, hasMorePagesOrganizationList, arg.CursorNext, arg.CursorPrev)
-	var i HasMorePagesOrganizationListRow
-	err := row.Scan(&i.HasNext, &i.HasPrev)
-	return i, err
+// Lists the ancestors of an organization, from its parent (depth 1) up to the root.
+func (q *Queries) ListOrganizationAncestors(ctx context.Context, organizationID string) ([]ListOrganizationAncestorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationAncestors, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrganizationAncestorsRow
+	for rows.Next() {
+		var i ListOrganizationAncestorsRow
+		if err := rows.Scan(
+			&i.Organization.OrganizationID,
+			&i.Organization.Name,
+			&i.Organization.CreateTime,
+			&i.Organization.CreateBy,
+			&i.Organization.LastUpdateTime,
+			&i.Organization.LastUpdateBy,
+			&i.Organization.RowVersion,
+			&i.Organization.IsDeleted,
+			&i.Organization.ParentID,
+			&i.Organization.Slug,
+			&i.Organization.Description,
+			&i.Organization.WebsiteUrl,
+			&i.Organization.LogoUrl,
+			&i.Organization.CountryCode,
+			&i.Organization.Locale,
+			&i.Organization.TimeZone,
+			&i.Organization.LegalID,
+			&i.Organization.TaxID,
+			&i.Organization.Status,
+			&i.Organization.StatusReason,
+			&i.Organization.Labels,
+			&i.Depth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationDescendants = `-- name: ListOrganizationDescendants :many
+WITH RECURSIVE descendants AS (
+    SELECT child.organization_id, 1 AS depth
+    FROM organizations child
+    WHERE child.parent_id = $1
+    UNION ALL
+    SELECT child.organization_id, descendants.depth + 1
+    FROM descendants
+    JOIN organizations child ON child.parent_id = descendants.organization_id
+)
+SELECT organizations.organization_id, organizations.name, organizations.create_time, organizations.create_by, organizations.last_update_time, organizations.last_update_by, organizations.row_version, organizations.is_deleted, organizations.parent_id, organizations.slug, organizations.description, organizations.website_url, organizations.logo_url, organizations.country_code, organizations.locale, organizations.time_zone, organizations.legal_id, organizations.tax_id, organizations.status, organizations.status_reason, organizations.labels, descendants.depth::int AS depth
+FROM descendants
+JOIN organizations ON organizations.organization_id = descendants.organization_id
+ORDER BY descendants.depth ASC, organizations.organization_id ASC
+`
+
+type ListOrganizationDescendantsRow struct {
+	Organization Organization
+	Depth        int32
+}
+
+// Lists the descendants of an organization breadth-first, from its children (depth 1) down to the leaves.
+func (q *Queries) ListOrganizationDescendants(ctx context.Context, organizationID sql.NullString) ([]ListOrganizationDescendantsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationDescendants, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrganizationDescendantsRow
+	for rows.Next() {
+		var i ListOrganizationDescendantsRow
+		if err := rows.Scan(
+			&i.Organization.OrganizationID,
+			&i.Organization.Name,
+			&i.Organization.CreateTime,
+			&i.Organization.CreateBy,
+			&i.Organization.LastUpdateTime,
+			&i.Organization.LastUpdateBy,
+			&i.Organization.RowVersion,
+			&i.Organization.IsDeleted,
+			&i.Organization.ParentID,
+			&i.Organization.Slug,
+			&i.Organization.Description,
+			&i.Organization.WebsiteUrl,
+			&i.Organization.LogoUrl,
+			&i.Organization.CountryCode,
+			&i.Organization.Locale,
+			&i.Organization.TimeZone,
+			&i.Organization.LegalID,
+			&i.Organization.TaxID,
+			&i.Organization.Status,
+			&i.Organization.StatusReason,
+			&i.Organization.Labels,
+			&i.Depth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const listOrganizations = `-- name: ListOrganizations :many
-SELECT organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted
+SELECT organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted, parent_id, slug, description, website_url, logo_url, country_code, locale, time_zone, legal_id, tax_id, status, status_reason, labels
 FROM organizations
 WHERE
-    -- Optional is_deleted filter
-    $1::boolean IS NULL OR is_deleted = $1::boolean
+    -- Optional filters
+    ($1::boolean IS NULL OR is_deleted = $1::boolean)
+    AND ($2::text IS NULL OR create_by = $2::text)
+    AND ($3::timestamptz IS NULL OR create_time >= $3::timestamptz)
+    AND ($4::timestamptz IS NULL OR create_time < $4::timestamptz)
+    AND ($5::text IS NULL OR starts_with(lower(name), lower($5::text)))
+    AND ($6::text IS NULL OR parent_id = $6::text)
+    AND ($7::text IS NULL OR status = $7::text)
+    -- Optional membership filter, only organizations the user is a member of
+    AND ($8::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM organization_members
+        WHERE organization_members.organization_id = organizations.organization_id AND organization_members.user_id = $8::text
+    ))
+    -- Optional label selector, the containment (@>) prefilter is served by the labels index while requirements
+    -- (a JSON array of {"key", "op", "values"} objects) are evaluated one by one
+    AND ($9::text IS NULL OR labels @> $9::text::jsonb)
+    AND ($10::text IS NULL OR NOT EXISTS (
+        SELECT 1
+        FROM jsonb_to_recordset($10::text::jsonb) AS req(key text, op text, "values" jsonb)
+        WHERE NOT CASE req.op
+            WHEN 'exists' THEN labels ? req.key
+            WHEN '!' THEN NOT labels ? req.key
+            WHEN 'in' THEN COALESCE(req."values" ? (labels ->> req.key), false)
+            WHEN 'notin' THEN NOT COALESCE(req."values" ? (labels ->> req.key), false)
+            ELSE false
+        END
+    ))
     AND (
-        -- Optional page cursor
-        $2::timestamptz IS NULL -- Ignore if no cursor
-        OR ($3::boolean = true AND create_time > $2::timestamptz) -- Next page
-        OR ($3::boolean = false AND create_time < $2::timestamptz) -- Previous page
+        -- Optional page cursor, the sort key is paired with organization_id to break ties
+        $11::text IS NULL -- Ignore if no cursor
+        OR ($12::text = 'create_time' AND $13::boolean = true
+            AND (create_time, organization_id) > ($14::timestamptz, $11::text))
+        OR ($12::text = 'create_time' AND $13::boolean = false
+            AND (create_time, organization_id) < ($14::timestamptz, $11::text))
+        OR ($12::text = 'name' AND $13::boolean = true
+            AND (name, organization_id) > ($15::text, $11::text))
+        OR ($12::text = 'name' AND $13::boolean = false
+            AND (name, organization_id) < ($15::text, $11::text))
     )
 ORDER BY
-    -- Order depends on direction
-    CASE WHEN $3::boolean = true THEN create_time ELSE create_time END ASC
-LIMIT CASE WHEN $4::int IS NULL THEN 100 ELSE $4 END
+    -- Rows are read in seek order (closest rows to the cursor first), callers must reverse them when
+    -- seeking against the sort order (i.e. previous pages)
+    CASE WHEN $12::text = 'create_time' AND $13::boolean = true THEN create_time END ASC,
+    CASE WHEN $12::text = 'create_time' AND $13::boolean = false THEN create_time END DESC,
+    CASE WHEN $12::text = 'name' AND $13::boolean = true THEN name END ASC,
+    CASE WHEN $12::text = 'name' AND $13::boolean = false THEN name END DESC,
+    CASE WHEN $13::boolean = true THEN organization_id END ASC,
+    CASE WHEN $13::boolean = false THEN organization_id END DESC
+LIMIT $16
 `
 
 type ListOrganizationsParams struct {
-	IsDeleted       sql.NullBool
-	CursorValue     sql.NullTime
-	IsCursorForward sql.NullBool
-	PageSize        sql.NullInt32
+	IsDeleted            sql.NullBool
+	CreateBy             sql.NullString
+	CreateTimeStart      sql.NullTime
+	CreateTimeEnd        sql.NullTime
+	NamePrefix           sql.NullString
+	ParentID             sql.NullString
+	Status               sql.NullString
+	MemberUserID         sql.NullString
+	LabelContains        sql.NullString
+	LabelRequirements    sql.NullString
+	CursorOrganizationID sql.NullString
+	SortBy               string
+	IsSeekAscending      bool
+	CursorCreateTime     sql.NullTime
+	CursorName           sql.NullString
+	PageSize             int32
 }
 
 func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error) {
 	rows, err := q.db.QueryContext(ctx, listOrganizations,
 		arg.IsDeleted,
-		arg.CursorValue,
-		arg.IsCursorForward,
+		arg.CreateBy,
+		arg.CreateTimeStart,
+		arg.CreateTimeEnd,
+		arg.NamePrefix,
+		arg.ParentID,
+		arg.Status,
+		arg.MemberUserID,
+		arg.LabelContains,
+		arg.LabelRequirements,
+		arg.CursorOrganizationID,
+		arg.SortBy,
+		arg.IsSeekAscending,
+		arg.CursorCreateTime,
+		arg.CursorName,
 		arg.PageSize,
 	)
 	if err != nil {
@@ -174,6 +468,176 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 			&i.LastUpdateBy,
 			&i.RowVersion,
 			&i.IsDeleted,
+			&i.ParentID,
+			&i.Slug,
+			&i.Description,
+			&i.WebsiteUrl,
+			&i.LogoUrl,
+			&i.CountryCode,
+			&i.Locale,
+			&i.TimeZone,
+			&i.LegalID,
+			&i.TaxID,
+			&i.Status,
+			&i.StatusReason,
+			&i.Labels,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockOrganizationByID = `-- name: LockOrganizationByID :one
+SELECT organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted, parent_id, slug, description, website_url, logo_url, country_code, locale, time_zone, legal_id, tax_id, status, status_reason, labels FROM organizations WHERE organization_id = $1 LIMIT 1 FOR UPDATE
+`
+
+// Retrieves an organization and locks it until the transaction ends, so it cannot change in the meantime.
+func (q *Queries) LockOrganizationByID(ctx context.Context, organizationID string) (Organization, error) {
+	row := q.db.QueryRowContext(ctx, lockOrganizationByID, organizationID)
+	var i Organization
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Name,
+		&i.CreateTime,
+		&i.CreateBy,
+		&i.LastUpdateTime,
+		&i.LastUpdateBy,
+		&i.RowVersion,
+		&i.IsDeleted,
+		&i.ParentID,
+		&i.Slug,
+		&i.Description,
+		&i.WebsiteUrl,
+		&i.LogoUrl,
+		&i.CountryCode,
+		&i.Locale,
+		&i.TimeZone,
+		&i.LegalID,
+		&i.TaxID,
+		&i.Status,
+		&i.StatusReason,
+		&i.Labels,
+	)
+	return i, err
+}
+
+const lockOrganizationHierarchy = `-- name: LockOrganizationHierarchy :exec
+SELECT pg_advisory_xact_lock($1::bigint)
+`
+
+// Locks the organization hierarchy until the transaction ends, so concurrent moves are serialized.
+func (q *Queries) LockOrganizationHierarchy(ctx context.Context, lockID int64) error {
+	_, err := q.db.ExecContext(ctx, lockOrganizationHierarchy, lockID)
+	return err
+}
+
+const searchOrganizations = `-- name: SearchOrganizations :many
+WITH matches AS (
+    SELECT
+        organization_id,
+        (
+            ts_rank(to_tsvector('simple', name), websearch_to_tsquery('simple', $1::text))
+            + ts_rank(to_tsvector('simple', name), to_tsquery('simple', $2::text))
+            + word_similarity($1::text, name)
+        )::float8 AS rank
+    FROM organizations
+    WHERE
+        is_deleted = false
+        AND (
+            -- Full-text match
+            to_tsvector('simple', name) @@ websearch_to_tsquery('simple', $1::text)
+            -- Autocomplete (last term is a prefix)
+            OR to_tsvector('simple', name) @@ to_tsquery('simple', $2::text)
+            -- Typo-tolerant match
+            OR $1::text <% name
+        )
+        -- Optional membership filter, only organizations the user is a member of
+        AND ($3::text IS NULL OR EXISTS (
+            SELECT 1
+            FROM organization_members
+            WHERE organization_members.organization_id = organizations.organization_id AND organization_members.user_id = $3::text
+        ))
+)
+SELECT
+    organizations.organization_id, organizations.name, organizations.create_time, organizations.create_by, organizations.last_update_time, organizations.last_update_by, organizations.row_version, organizations.is_deleted, organizations.parent_id, organizations.slug, organizations.description, organizations.website_url, organizations.logo_url, organizations.country_code, organizations.locale, organizations.time_zone, organizations.legal_id, organizations.tax_id, organizations.status, organizations.status_reason, organizations.labels,
+    matches.rank,
+    ts_headline('simple', organizations.name,
+        websearch_to_tsquery('simple', $1::text) || to_tsquery('simple', $2::text),
+        'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')::text AS highlight
+FROM matches
+JOIN organizations ON organizations.organization_id = matches.organization_id
+WHERE
+    -- Optional page cursor, results are sorted by (rank DESC, organization_id ASC)
+    $4::float8 IS NULL -- Ignore if no cursor
+    OR matches.rank < $4::float8
+    OR (matches.rank = $4::float8 AND matches.organization_id > $5::text)
+ORDER BY matches.rank DESC, matches.organization_id ASC
+LIMIT $6
+`
+
+type SearchOrganizationsParams struct {
+	Query                string
+	PrefixQuery          string
+	MemberUserID         sql.NullString
+	CursorRank           sql.NullFloat64
+	CursorOrganizationID sql.NullString
+	PageSize             int32
+}
+
+type SearchOrganizationsRow struct {
+	Organization Organization
+	Rank         float64
+	Highlight    string
+}
+
+func (q *Queries) SearchOrganizations(ctx context.Context, arg SearchOrganizationsParams) ([]SearchOrganizationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchOrganizations,
+		arg.Query,
+		arg.PrefixQuery,
+		arg.MemberUserID,
+		arg.CursorRank,
+		arg.CursorOrganizationID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchOrganizationsRow
+	for rows.Next() {
+		var i SearchOrganizationsRow
+		if err := rows.Scan(
+			&i.Organization.OrganizationID,
+			&i.Organization.Name,
+			&i.Organization.CreateTime,
+			&i.Organization.CreateBy,
+			&i.Organization.LastUpdateTime,
+			&i.Organization.LastUpdateBy,
+			&i.Organization.RowVersion,
+			&i.Organization.IsDeleted,
+			&i.Organization.ParentID,
+			&i.Organization.Slug,
+			&i.Organization.Description,
+			&i.Organization.WebsiteUrl,
+			&i.Organization.LogoUrl,
+			&i.Organization.CountryCode,
+			&i.Organization.Locale,
+			&i.Organization.TimeZone,
+			&i.Organization.LegalID,
+			&i.Organization.TaxID,
+			&i.Organization.Status,
+			&i.Organization.StatusReason,
+			&i.Organization.Labels,
+			&i.Rank,
+			&i.Highlight,
 		); err != nil {
 			return nil, err
 		}
@@ -188,34 +652,99 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 	return items, nil
 }
 
-const updateOrganization = `-- name: UpdateOrganization :exec
+const updateOrganization = `-- name: UpdateOrganization :execrows
 UPDATE organizations
 SET
     name = $2,
     last_update_time = $3,
     last_update_by = $4,
     row_version = $5,
-    is_deleted = $6
-WHERE organization_id = $1
+    is_deleted = $6,
+    parent_id = $7,
+    slug = $8,
+    description = $9,
+    website_url = $10,
+    logo_url = $11,
+    country_code = $12,
+    locale = $13,
+    time_zone = $14,
+    legal_id = $15,
+    tax_id = $16,
+    status = $17,
+    status_reason = $18,
+    labels = $19
+WHERE organization_id = $1 AND row_version = $20
 `
 
 type UpdateOrganizationParams struct {
-	OrganizationID string
-	Name           string
-	LastUpdateTime time.Time
-	LastUpdateBy   string
-	RowVersion     int64
-	IsDeleted      bool
+	OrganizationID     string
+	Name               string
+	LastUpdateTime     time.Time
+	LastUpdateBy       string
+	RowVersion         int64
+	IsDeleted          bool
+	ParentID           sql.NullString
+	Slug               string
+	Description        string
+	WebsiteUrl         string
+	LogoUrl            string
+	CountryCode        string
+	Locale             string
+	TimeZone           string
+	LegalID            string
+	TaxID              string
+	Status             string
+	StatusReason       string
+	Labels             json.RawMessage
+	ExpectedRowVersion int64
 }
 
-func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) error {
-	_, err := q.db.ExecContext(ctx, updateOrganization,
+func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateOrganization,
 		arg.OrganizationID,
 		arg.Name,
 		arg.LastUpdateTime,
 		arg.LastUpdateBy,
 		arg.RowVersion,
 		arg.IsDeleted,
+		arg.ParentID,
+		arg.Slug,
+		arg.Description,
+		arg.WebsiteUrl,
+		arg.LogoUrl,
+		arg.CountryCode,
+		arg.Locale,
+		arg.TimeZone,
+		arg.LegalID,
+		arg.TaxID,
+		arg.Status,
+		arg.StatusReason,
+		arg.Labels,
+		arg.ExpectedRowVersion,
 	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const upsertOrganizationSlugRedirect = `-- name: UpsertOrganizationSlugRedirect :exec
+INSERT INTO organization_slug_redirects (slug, organization_id, create_time)
+VALUES
+    (lower($1), $2, $3)
+ON CONFLICT (slug) DO UPDATE
+SET
+    organization_id = EXCLUDED.organization_id,
+    create_time = EXCLUDED.create_time
+`
+
+type UpsertOrganizationSlugRedirectParams struct {
+	Slug           string
+	OrganizationID string
+	CreateTime     time.Time
+}
+
+func (q *Queries) UpsertOrganizationSlugRedirect(ctx context.Context, arg UpsertOrganizationSlugRedirectParams) error {
+	_, err := q.db.ExecContext(ctx, upsertOrganizationSlugRedirect, arg.Slug, arg.OrganizationID, arg.CreateTime)
 	return err
 }
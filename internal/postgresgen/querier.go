@@ -6,17 +6,55 @@ package postgresgen
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
 type Querier interface {
+	AcquireIdempotencyKey(ctx context.Context, arg AcquireIdempotencyKeyParams) (int64, error)
+	ClaimPendingOutboxEvents(ctx context.Context, arg ClaimPendingOutboxEventsParams) ([]OutboxEvent, error)
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
+	CreateInvitation(ctx context.Context, arg CreateInvitationParams) error
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) error
+	CreateOrganizationHistoryRecord(ctx context.Context, arg CreateOrganizationHistoryRecordParams) error
+	CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) error
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, expireTime time.Time) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error
 	DeleteOrganization(ctx context.Context, organizationID string) error
 	DeleteOrganizationByName(ctx context.Context, name string) error
-	ExistOrganizationByName(ctx context.Context, name string) (bool, error)
+	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error
+	DeleteOrganizationSlugRedirect(ctx context.Context, slug string) error
+	ExistOrganizationByName(ctx context.Context, arg ExistOrganizationByNameParams) (bool, error)
+	ExistOrganizationBySlug(ctx context.Context, arg ExistOrganizationBySlugParams) (bool, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetInvitationByID(ctx context.Context, invitationID string) (Invitation, error)
 	GetOrganizationByID(ctx context.Context, organizationID string) (Organization, error)
-	HasMorePagesOrganizationList(ctx context.Context, arg HasMorePagesOrganizationListParams) (HasMorePagesOrganizationListRow, error)
+	GetOrganizationBySlug(ctx context.Context, slug string) (Organization, error)
+	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (OrganizationMember, error)
+	GetOrganizationSettings(ctx context.Context, organizationID string) (OrganizationSetting, error)
+	GetOrganizationSlugRedirect(ctx context.Context, slug string) (string, error)
+	GetOutboxLag(ctx context.Context) (GetOutboxLagRow, error)
+	GetPendingInvitation(ctx context.Context, arg GetPendingInvitationParams) (Invitation, error)
+	ListInvitations(ctx context.Context, arg ListInvitationsParams) ([]Invitation, error)
+	ListOrganizationAncestors(ctx context.Context, organizationID string) ([]ListOrganizationAncestorsRow, error)
+	ListOrganizationDescendants(ctx context.Context, organizationID sql.NullString) ([]ListOrganizationDescendantsRow, error)
+	ListOrganizationHistory(ctx context.Context, arg ListOrganizationHistoryParams) ([]OrganizationHistory, error)
+	ListOrganizationMembers(ctx context.Context, arg ListOrganizationMembersParams) ([]OrganizationMember, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error)
-	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) error
+	LockOrganizationByID(ctx context.Context, organizationID string) (Organization, error)
+	LockOrganizationHierarchy(ctx context.Context, lockID int64) error
+	LockOrganizationMembersByRole(ctx context.Context, arg LockOrganizationMembersByRoleParams) ([]string, error)
+	MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error
+	MarkOutboxEventSent(ctx context.Context, arg MarkOutboxEventSentParams) error
+	ReleaseOutboxEvent(ctx context.Context, arg ReleaseOutboxEventParams) error
+	SearchOrganizations(ctx context.Context, arg SearchOrganizationsParams) ([]SearchOrganizationsRow, error)
+	TryLockOutboxRelay(ctx context.Context, lockID int64) (bool, error)
+	UpdateInvitation(ctx context.Context, arg UpdateInvitationParams) (int64, error)
+	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (int64, error)
+	UpdateOrganizationMember(ctx context.Context, arg UpdateOrganizationMemberParams) (int64, error)
+	UpsertOrganizationSettings(ctx context.Context, arg UpsertOrganizationSettingsParams) (int64, error)
+	UpsertOrganizationSlugRedirect(ctx context.Context, arg UpsertOrganizationSlugRedirectParams) error
 }
 
 var _ Querier = (*Queries)(nil)
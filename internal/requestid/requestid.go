@@ -0,0 +1,91 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// DEV-NOTE: Request identifiers are propagated through context.Context so components unaware of the transport
+// (e.g. audit trails) can correlate their records with the request that caused them.
+
+const (
+	// _metadataKey is the gRPC metadata key carrying the request identifier (gRPC lowercases metadata keys).
+	_metadataKey = "x-request-id"
+	// MaxLength is the maximum length of request identifiers, longer identifiers sent by clients are truncated.
+	MaxLength = 128
+)
+
+type requestIDContextKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request identifier id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, id)
+}
+
+// FromContext retrieves the request identifier carried by ctx, it returns an empty string if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// HandleHTTP is an echo middleware putting the request identifier (X-Request-ID header) into the request context.
+//
+// Identifiers generated by the server (set into the response header) take precedence over the ones sent by
+// clients. Requests without a valid identifier get one generated, which is set into the response header.
+func HandleHTTP(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(e echo.Context) error {
+		id := e.Response().Header().Get(echo.HeaderXRequestID)
+		if id == "" {
+			id = sanitize(e.Request().Header.Get(echo.HeaderXRequestID))
+		}
+		if id == "" {
+			id = newID()
+		}
+		e.Response().Header().Set(echo.HeaderXRequestID, id)
+		e.SetRequest(e.Request().WithContext(WithRequestID(e.Request().Context(), id)))
+		return next(e)
+	}
+}
+
+// NewUnaryInterceptor creates a [grpc.UnaryServerInterceptor] putting the request identifier (x-request-id
+// metadata) into the request context. Requests without a valid identifier get one generated.
+func NewUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var id string
+		if ids := metadata.ValueFromIncomingContext(ctx, _metadataKey); len(ids) > 0 {
+			id = sanitize(ids[0])
+		}
+		if id == "" {
+			id = newID()
+		}
+		return handler(WithRequestID(ctx, id), req)
+	}
+}
+
+// sanitize returns the request identifier id sent by a client truncated to [MaxLength]. It returns an empty
+// string if id holds characters other than printable ASCII ones, as they cannot be logged or stored safely.
+func sanitize(id string) string {
+	id = strings.TrimSpace(id)
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x20 || id[i] > 0x7e {
+			return ""
+		}
+	}
+	if len(id) > MaxLength {
+		id = id[:MaxLength]
+	}
+	return id
+}
+
+// newID generates a new random request identifier.
+func newID() string {
+	raw := make([]byte, 16)
+	_, _ = rand.Read(raw)
+	return hex.EncodeToString(raw)
+}
@@ -0,0 +1,89 @@
+package requestid_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hadroncorp/service-template/internal/requestid"
+)
+
+func TestHandleHTTP(t *testing.T) {
+	tests := []struct {
+		name     string
+		inHeader string
+		exp      string
+	}{
+		{
+			name:     "client identifier",
+			inHeader: "req-1",
+			exp:      "req-1",
+		},
+		{
+			name:     "truncated",
+			inHeader: strings.Repeat("a", requestid.MaxLength+10),
+			exp:      strings.Repeat("a", requestid.MaxLength),
+		},
+		{
+			name: "generated",
+		},
+		{
+			name:     "non printable",
+			inHeader: "req-é",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			var id string
+			e := echo.New()
+			e.GET("/", func(e echo.Context) error {
+				id = requestid.FromContext(e.Request().Context())
+				return e.NoContent(http.StatusOK)
+			}, requestid.HandleHTTP)
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.inHeader != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.inHeader)
+			}
+			rec := httptest.NewRecorder()
+
+			// act
+			e.ServeHTTP(rec, req)
+
+			// assert
+			if tt.exp != "" {
+				assert.Equal(t, tt.exp, id)
+			} else {
+				assert.Len(t, id, 32)
+			}
+			assert.Equal(t, id, rec.Header().Get(echo.HeaderXRequestID))
+		})
+	}
+}
+
+func TestNewUnaryInterceptor(t *testing.T) {
+	// arrange
+	interceptor := requestid.NewUnaryInterceptor()
+	var ids []string
+	handler := func(ctx context.Context, _ any) (any, error) {
+		ids = append(ids, requestid.FromContext(ctx))
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("x-request-id", strings.Repeat("a", requestid.MaxLength+1)))
+
+	// act
+	_, _ = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	_, _ = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+	// assert
+	assert.Equal(t, strings.Repeat("a", requestid.MaxLength), ids[0])
+	assert.Len(t, ids[1], 32)
+}
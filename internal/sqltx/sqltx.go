@@ -0,0 +1,126 @@
+package sqltx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+)
+
+// DEV-NOTE: Transactions are propagated through context.Context so several components (e.g. repositories and
+// the transactional outbox) may take part of the very same unit of work without knowing each other.
+
+type txContextKey struct{}
+
+// WithTx returns a copy of ctx carrying tx.
+func WithTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// FromContext retrieves the transaction carried by ctx, if any.
+func FromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*sql.Tx)
+	return tx, ok && tx != nil
+}
+
+// - Connection -
+
+// Conn is a [postgresgen.DBTX] routing every statement to the transaction carried by the statement context.
+// If no transaction was found, the statement is executed through the underlying [gecksql.DB].
+type Conn struct {
+	db gecksql.DB
+}
+
+// compile-time assertion
+var _ postgresgen.DBTX = (*Conn)(nil)
+
+// NewConn creates a new [Conn] instance.
+func NewConn(db gecksql.DB) Conn {
+	return Conn{db: db}
+}
+
+func (c Conn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if tx, ok := FromContext(ctx); ok {
+		return tx.ExecContext(ctx, query, args...)
+	}
+	return c.db.ExecContext(ctx, query, args...)
+}
+
+func (c Conn) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	if tx, ok := FromContext(ctx); ok {
+		return tx.PrepareContext(ctx, query)
+	}
+	return c.db.PrepareContext(ctx, query)
+}
+
+func (c Conn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if tx, ok := FromContext(ctx); ok {
+		return tx.QueryContext(ctx, query, args...)
+	}
+	return c.db.QueryContext(ctx, query, args...)
+}
+
+func (c Conn) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if tx, ok := FromContext(ctx); ok {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+	return c.db.QueryRowContext(ctx, query, args...)
+}
+
+// - Runner -
+
+// A Runner executes units of work within a transaction.
+type Runner interface {
+	// RunInTx executes fn within a transaction. The transaction is committed if fn returns no error, otherwise
+	// it is rolled back.
+	//
+	// If ctx already carries a transaction, fn joins it and the outermost caller owns commit and rollback.
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// DBRunner is the [Runner] implementation using a [gecksql.DB] to begin transactions.
+type DBRunner struct {
+	db gecksql.DB
+}
+
+// compile-time assertion
+var _ Runner = (*DBRunner)(nil)
+
+// NewDBRunner creates a new [DBRunner] instance.
+func NewDBRunner(db gecksql.DB) DBRunner {
+	return DBRunner{db: db}
+}
+
+func (r DBRunner) RunInTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if _, ok := FromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			err = errors.Join(err, ignoreTxDone(tx.Rollback()))
+		}
+	}()
+
+	if err = fn(WithTx(ctx, tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func ignoreTxDone(err error) error {
+	if errors.Is(err, sql.ErrTxDone) {
+		return nil
+	}
+	return err
+}
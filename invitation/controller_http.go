@@ -0,0 +1,231 @@
+package invitation
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/transport"
+	geckhttp "github.com/hadroncorp/geck/transport/http"
+	"github.com/hadroncorp/geck/validation"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/idempotency"
+	"github.com/hadroncorp/service-template/membership"
+)
+
+type ControllerHTTP struct {
+	manager     Manager
+	lister      Lister
+	authn       authn.MiddlewareHTTP
+	idempotency idempotency.MiddlewareHTTP
+	idFactory   identifier.Factory
+	validator   validation.Validator
+}
+
+// compile-time assertion
+var _ geckhttp.Controller = (*ControllerHTTP)(nil)
+
+// NewControllerHTTP creates a new instance of [ControllerHTTP].
+func NewControllerHTTP(manager Manager, lister Lister, authnMiddleware authn.MiddlewareHTTP,
+	idempotencyMiddleware idempotency.MiddlewareHTTP, idFactory identifier.Factory,
+	validator validation.Validator) ControllerHTTP {
+	return ControllerHTTP{
+		manager:     manager,
+		lister:      lister,
+		authn:       authnMiddleware,
+		idempotency: idempotencyMiddleware,
+		idFactory:   idFactory,
+		validator:   validator,
+	}
+}
+
+func (c ControllerHTTP) SetEndpoints(_ *echo.Echo) {
+}
+
+func (c ControllerHTTP) SetVersionedEndpoints(g *echo.Group) {
+	og := g.Group("/organizations/:organization_id/invitations", c.authn.Handle)
+	og.POST("", c.create, c.idempotency.Handle)
+	og.GET("", c.list)
+	og.DELETE("/:invitation_id", c.revoke)
+	// DEV-NOTE: Custom methods (e.g. POST /invitations/{id}:resend) are dispatched by customMethod, see
+	// organization.ControllerHTTP.
+	og.POST("/:invitation_id", c.customMethod, c.idempotency.Handle)
+
+	// DEV-NOTE: Recipients accept invitations with the token they received, regardless of the organization.
+	ig := g.Group("/invitations", c.authn.Handle)
+	ig.POST("\\:accept", c.accept, c.idempotency.Handle)
+}
+
+func (c ControllerHTTP) customMethod(e echo.Context) error {
+	id, method, _ := strings.Cut(e.Param("invitation_id"), ":")
+	switch method {
+	case "resend":
+		return c.resend(e, id)
+	default:
+		return echo.ErrNotFound
+	}
+}
+
+func (c ControllerHTTP) create(e echo.Context) error {
+	id, err := c.idFactory.NewID()
+	if err != nil {
+		return err
+	}
+
+	body := createRequestHTTP{}
+	if err = e.Bind(&body); err != nil {
+		return err
+	}
+
+	if err = c.validator.Validate(e.Request().Context(), body); err != nil {
+		return err
+	}
+
+	inv, err := c.manager.Create(e.Request().Context(), CreateArguments{
+		ID:             id,
+		OrganizationID: e.Param("organization_id"),
+		Email:          body.Email,
+		Role:           membership.Role(body.Role),
+	})
+	if err != nil {
+		return err
+	}
+	return e.JSON(http.StatusCreated, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(inv),
+	})
+}
+
+func (c ControllerHTTP) resend(e echo.Context, id string) error {
+	inv, err := c.manager.Resend(e.Request().Context(), e.Param("organization_id"), id)
+	if err != nil {
+		return newErrorHTTP(err)
+	}
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(inv),
+	})
+}
+
+func (c ControllerHTTP) revoke(e echo.Context) error {
+	err := c.manager.Revoke(e.Request().Context(), e.Param("organization_id"), e.Param("invitation_id"))
+	if err != nil {
+		return err
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+func (c ControllerHTTP) accept(e echo.Context) error {
+	body := acceptRequestHTTP{}
+	if err := e.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.validator.Validate(e.Request().Context(), body); err != nil {
+		return err
+	}
+
+	member, err := c.manager.Accept(e.Request().Context(), body.Token)
+	if err != nil {
+		return newErrorHTTP(err)
+	}
+	return e.JSON(http.StatusOK, transport.DataContainer[memberResponseHTTP]{
+		Data: memberResponseHTTP{
+			OrganizationID: member.OrganizationID(),
+			UserID:         member.UserID(),
+			Role:           string(member.Role()),
+		},
+	})
+}
+
+func (c ControllerHTTP) list(e echo.Context) error {
+	opts := []ListOption{
+		WithListPageOptions(geckhttp.NewPaginationOptions(e)...),
+	}
+	if status := Status(e.QueryParam("status")); status != "" {
+		if !status.IsValid() {
+			return echo.NewHTTPError(http.StatusBadRequest, "status must be one of pending accepted revoked expired")
+		}
+		opts = append(opts, WithListStatus(status))
+	}
+
+	page, err := c.lister.List(e.Request().Context(), e.Param("organization_id"), opts...)
+	if errors.Is(err, ErrPageTokenMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest, "page_token belongs to another organization").
+			SetInternal(err)
+	} else if err != nil {
+		return err
+	} else if len(page.Items) == 0 {
+		return e.NoContent(http.StatusNotFound)
+	}
+
+	return e.JSON(http.StatusOK, transport.DataContainer[transport.PageResponse[responseHTTP]]{
+		Data: transport.PageResponse[responseHTTP]{
+			TotalItems:        page.TotalItems,
+			PreviousPageToken: page.PreviousPageToken,
+			NextPageToken:     page.NextPageToken,
+			Items: lo.Map(page.Items, func(i Invitation, _ int) responseHTTP {
+				return newResponseHTTP(i)
+			}),
+		},
+	})
+}
+
+// newErrorHTTP translates invitation errors lacking a [syserr] counterpart into HTTP errors.
+func newErrorHTTP(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidToken):
+		return echo.NewHTTPError(http.StatusBadRequest, "invitation token is invalid").SetInternal(err)
+	case errors.Is(err, ErrExpired):
+		return echo.NewHTTPError(http.StatusGone, "invitation is expired").SetInternal(err)
+	case errors.Is(err, ErrResendThrottled):
+		return echo.NewHTTPError(http.StatusTooManyRequests, "invitation was sent too recently or too many times").
+			SetInternal(err)
+	default:
+		return err
+	}
+}
+
+// -- Models --
+
+type createRequestHTTP struct {
+	Email string `json:"email" validate:"required,email,lte=320"`
+	Role  string `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+type acceptRequestHTTP struct {
+	Token string `json:"token" validate:"required,lte=512"`
+}
+
+// DEV-NOTE: Responses never carry tokens, they are only delivered to recipients.
+
+type responseHTTP struct {
+	ID             string    `json:"invitation_id"`
+	OrganizationID string    `json:"organization_id"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	Status         string    `json:"status"`
+	ExpireTime     time.Time `json:"expire_time"`
+	SendCount      int       `json:"send_count"`
+}
+
+func newResponseHTTP(inv Invitation) responseHTTP {
+	return responseHTTP{
+		ID:             inv.ID(),
+		OrganizationID: inv.OrganizationID(),
+		Email:          inv.Email(),
+		Role:           string(inv.Role()),
+		Status:         string(inv.Status()),
+		ExpireTime:     inv.ExpireTime(),
+		SendCount:      inv.SendCount(),
+	}
+}
+
+type memberResponseHTTP struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+	Role           string `json:"role"`
+}
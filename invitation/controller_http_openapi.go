@@ -0,0 +1,105 @@
+package invitation
+
+import (
+	"net/http"
+
+	"github.com/hadroncorp/geck/transport"
+
+	"github.com/hadroncorp/service-template/openapi"
+)
+
+// compile-time assertion
+var _ openapi.Describer = (*ControllerHTTP)(nil)
+
+var (
+	_tagsOpenAPI = []string{"Invitations"}
+
+	_idempotencyKeyParamOpenAPI = openapi.Parameter{
+		Name:        "Idempotency-Key",
+		In:          "header",
+		Description: "Unique key making retries of the request safe.",
+	}
+)
+
+func (c ControllerHTTP) Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			ID:          "CreateInvitation",
+			Method:      http.MethodPost,
+			Handler:     c.create,
+			Summary:     "Invites someone to join an organization. The invitation is sent by email.",
+			Tags:        _tagsOpenAPI,
+			Parameters:  []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			RequestBody: createRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusCreated,
+					Description: "Invitation created.",
+					Body:        transport.DataContainer[responseHTTP]{},
+				},
+			},
+		},
+		{
+			ID:           "ResendInvitation",
+			Method:       http.MethodPost,
+			Handler:      c.customMethod,
+			CustomMethod: "resend",
+			Summary:      "Sends a pending invitation again, extending its validity.",
+			Tags:         _tagsOpenAPI,
+			Parameters:   []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Invitation sent again.",
+					Body:        transport.DataContainer[responseHTTP]{},
+				},
+			},
+		},
+		{
+			ID:      "RevokeInvitation",
+			Method:  http.MethodDelete,
+			Handler: c.revoke,
+			Summary: "Revokes a pending invitation.",
+			Tags:    _tagsOpenAPI,
+		},
+		{
+			ID:      "ListInvitations",
+			Method:  http.MethodGet,
+			Handler: c.list,
+			Summary: "Lists the invitations of an organization.",
+			Tags:    _tagsOpenAPI,
+			Parameters: []openapi.Parameter{
+				{Name: "status", In: "query", Description: "Status of the invitations to list."},
+				{Name: "page_size", In: "query", Description: "Maximum number of items per page.", Type: 0},
+				{Name: "page_token", In: "query", Description: "Token of the page to retrieve."},
+			},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Page of invitations.",
+					Body:        transport.DataContainer[transport.PageResponse[responseHTTP]]{},
+				},
+				{
+					StatusCode:  http.StatusNotFound,
+					Description: "No invitation matched.",
+				},
+			},
+		},
+		{
+			ID:          "AcceptInvitation",
+			Method:      http.MethodPost,
+			Handler:     c.accept,
+			Summary:     "Accepts an invitation, the caller becomes a member of the organization.",
+			Tags:        _tagsOpenAPI,
+			Parameters:  []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			RequestBody: acceptRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Invitation accepted.",
+					Body:        transport.DataContainer[memberResponseHTTP]{},
+				},
+			},
+		},
+	}
+}
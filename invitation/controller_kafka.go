@@ -0,0 +1,99 @@
+package invitation
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/hadroncorp/geck/transport/stream/kafka"
+	kinterceptor "github.com/hadroncorp/geck/transport/stream/kafka/interceptor"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+
+	"event-schema-registry/iampb"
+	"github.com/hadroncorp/service-template/notification"
+)
+
+// ControllerKafka is the Apache Kafka controller delivering invitations to their recipients.
+type ControllerKafka struct {
+	logger         *slog.Logger
+	sender         notification.Sender
+	repository     ReadRepository
+	signer         TokenSigner
+	producerClient *kgo.Client
+}
+
+// compile-time assertion
+var _ kafka.Controller = (*ControllerKafka)(nil)
+
+// NewControllerKafka creates a new instance of [ControllerKafka].
+func NewControllerKafka(logger *slog.Logger, sender notification.Sender, r ReadRepository, signer TokenSigner,
+	produceClient *kgo.Client) ControllerKafka {
+	return ControllerKafka{
+		logger:         logger,
+		sender:         sender,
+		repository:     r,
+		signer:         signer,
+		producerClient: produceClient,
+	}
+}
+
+func (c ControllerKafka) RegisterReaders(rm kafka.ReaderManager) {
+	rm.MustRegister(TopicCreated.String(), c.sendInvitation(func() sendEvent {
+		return &iampb.InvitationCreatedEvent{}
+	}),
+		kafka.WithReaderGroup(
+			kafka.MustConsumerGroup("iam", "invitation", "send_email",
+				kafka.WithConsumerGroupEvent("invitation_created")),
+		),
+		kafka.WithReaderInterceptors(
+			kinterceptor.UseDeadLetter(c.producerClient, ""),
+		),
+	)
+	rm.MustRegister(TopicResent.String(), c.sendInvitation(func() sendEvent {
+		return &iampb.InvitationResentEvent{}
+	}),
+		kafka.WithReaderGroup(
+			kafka.MustConsumerGroup("iam", "invitation", "send_email",
+				kafka.WithConsumerGroupEvent("invitation_resent")),
+		),
+		kafka.WithReaderInterceptors(
+			kinterceptor.UseDeadLetter(c.producerClient, ""),
+		),
+	)
+}
+
+// sendEvent is an invitation event requiring the invitation to be sent to its recipient.
+type sendEvent interface {
+	proto.Message
+	GetInvitationId() string
+}
+
+// sendInvitation returns a reader sending the invitation referenced by the events created with newEvent to its
+// recipient.
+func (c ControllerKafka) sendInvitation(newEvent func() sendEvent) kafka.ReaderHandlerFunc {
+	return func(ctx context.Context, record *kgo.Record) error {
+		ev := newEvent()
+		if err := proto.Unmarshal(record.Value, ev); err != nil {
+			return err
+		}
+
+		// DEV-NOTE: Tokens are not part of events (events are readable by other services), they are issued
+		// from the latest state of the invitation instead. Thus, late events deliver the latest token.
+		inv, err := c.repository.FindByKey(ctx, ev.GetInvitationId())
+		if err != nil {
+			return err
+		} else if inv == nil || inv.Status() != StatusPending || inv.IsExpired(time.Now().UTC()) {
+			c.logger.InfoContext(ctx, "skipping invitation no longer pending",
+				slog.String("invitation_id", ev.GetInvitationId()),
+			)
+			return nil
+		}
+
+		message := fmt.Sprintf("You have been invited to join an organization as %s. "+
+			"Accept the invitation before %s using the following token: %s",
+			inv.Role(), inv.ExpireTime().Format(time.RFC1123), c.signer.Sign(*inv))
+		return c.sender.Send([]byte(message), inv.Email())
+	}
+}
@@ -0,0 +1,143 @@
+package invitation
+
+import (
+	"context"
+	"time"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/persistence/audit"
+
+	"github.com/hadroncorp/service-template/membership"
+)
+
+// Status is the stage of the lifecycle an [Invitation] is in.
+type Status string
+
+const (
+	// StatusPending is the status of invitations waiting to be accepted.
+	StatusPending Status = "pending"
+	// StatusAccepted is the status of invitations accepted by their recipient.
+	StatusAccepted Status = "accepted"
+	// StatusRevoked is the status of invitations revoked before being accepted.
+	StatusRevoked Status = "revoked"
+	// StatusExpired is the status of invitations that expired before being accepted and were superseded by
+	// a new invitation of the same recipient.
+	StatusExpired Status = "expired"
+)
+
+// IsValid checks whether s is a supported status.
+func (s Status) IsValid() bool {
+	return s == StatusPending || s == StatusAccepted || s == StatusRevoked || s == StatusExpired
+}
+
+// Invitation is a proposal for someone, identified by email, to join an organization with a given role.
+//
+// Invitations are accepted once through a signed token delivered to their recipient (see [TokenSigner]).
+type Invitation struct {
+	audit.Auditable
+	event.AggregatorTemplate
+	id             string
+	organizationID string
+	email          string
+	role           membership.Role
+	status         Status
+	expireTime     time.Time
+	sendCount      int
+	lastSendTime   time.Time
+	// persistedVersion is the version the invitation is expected to have in the persistence store, used to
+	// detect concurrent modifications (optimistic concurrency control).
+	persistedVersion uint64
+}
+
+// New creates a new pending [Invitation] of email to the organization identified by organizationID.
+func New(ctx context.Context, id, organizationID, email string, role membership.Role, expireTime time.Time) Invitation {
+	inv := Invitation{
+		Auditable:      audit.NewWithDefaults(ctx),
+		id:             id,
+		organizationID: organizationID,
+		email:          email,
+		role:           role,
+		status:         StatusPending,
+		expireTime:     expireTime,
+		sendCount:      1,
+	}
+	inv.lastSendTime = inv.CreateTime()
+	inv.RegisterEvents(newCreatedEvent(inv))
+	return inv
+}
+
+// ID returns the unique identifier of the invitation.
+func (i Invitation) ID() string {
+	return i.id
+}
+
+// OrganizationID returns the unique identifier of the organization the recipient is invited to.
+func (i Invitation) OrganizationID() string {
+	return i.organizationID
+}
+
+// Email returns the email address of the recipient.
+func (i Invitation) Email() string {
+	return i.email
+}
+
+// Role returns the role the recipient gets once the invitation is accepted.
+func (i Invitation) Role() membership.Role {
+	return i.role
+}
+
+// Status returns the status of the invitation.
+func (i Invitation) Status() Status {
+	return i.status
+}
+
+// ExpireTime returns the time the invitation can no longer be accepted.
+func (i Invitation) ExpireTime() time.Time {
+	return i.expireTime
+}
+
+// SendCount returns the number of times the invitation was sent.
+func (i Invitation) SendCount() int {
+	return i.sendCount
+}
+
+// LastSendTime returns the time the invitation was last sent.
+func (i Invitation) LastSendTime() time.Time {
+	return i.lastSendTime
+}
+
+// IsExpired checks whether the invitation is expired at the given time.
+func (i Invitation) IsExpired(now time.Time) bool {
+	return !now.Before(i.expireTime)
+}
+
+// Resend sends the [Invitation] again, extending its validity until expireTime.
+//
+// Tokens of previous sends are no longer valid.
+func (i *Invitation) Resend(ctx context.Context, expireTime time.Time) {
+	audit.Update(ctx, &i.Auditable)
+	i.expireTime = expireTime
+	i.sendCount++
+	i.lastSendTime = i.LastUpdateTime()
+	i.RegisterEvents(newResentEvent(i))
+}
+
+// Accept accepts the [Invitation] on behalf of the user identified by userID.
+func (i *Invitation) Accept(ctx context.Context, userID string) {
+	audit.Update(ctx, &i.Auditable)
+	i.status = StatusAccepted
+	i.RegisterEvents(newAcceptedEvent(i, userID))
+}
+
+// Expire marks the expired [Invitation] as such, so a new invitation of the same recipient may be created.
+func (i *Invitation) Expire(ctx context.Context) {
+	audit.Update(ctx, &i.Auditable)
+	i.status = StatusExpired
+}
+
+// Revoke revokes the [Invitation], so it can no longer be accepted.
+func (i *Invitation) Revoke(ctx context.Context) {
+	audit.Update(ctx, &i.Auditable)
+	i.status = StatusRevoked
+	i.RegisterEvents(newRevokedEvent(i))
+}
@@ -0,0 +1,252 @@
+package invitation
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"event-schema-registry/iampb"
+)
+
+const (
+	_eventSource = "/invitations"
+)
+
+var (
+	// TopicCreated is the event topic for invitation creation.
+	TopicCreated = event.NewTopic("hadron", "invitation", "created",
+		event.WithPlatform("iam"))
+	// TopicResent is the event topic for invitation resend.
+	TopicResent = event.NewTopic("hadron", "invitation", "resent",
+		event.WithPlatform("iam"))
+	// TopicAccepted is the event topic for invitation acceptance.
+	TopicAccepted = event.NewTopic("hadron", "invitation", "accepted",
+		event.WithPlatform("iam"))
+	// TopicRevoked is the event topic for invitation revocation.
+	TopicRevoked = event.NewTopic("hadron", "invitation", "revoked",
+		event.WithPlatform("iam"))
+)
+
+// CreatedEvent is an event that is emitted when someone is invited to join an organization.
+type CreatedEvent struct {
+	src   Invitation
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*CreatedEvent)(nil)
+
+func newCreatedEvent(src Invitation) CreatedEvent {
+	return CreatedEvent{
+		src:   src,
+		topic: TopicCreated,
+	}
+}
+
+func (e CreatedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e CreatedEvent) Key() string {
+	return e.src.id
+}
+
+func (e CreatedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.InvitationCreatedEvent{
+		InvitationId:   e.src.id,
+		OrganizationId: e.src.organizationID,
+		Email:          e.src.email,
+		Role:           string(e.src.role),
+		ExpireTime:     timestamppb.New(e.src.expireTime),
+		CreateTime:     timestamppb.New(e.src.CreateTime()),
+		CreateBy:       e.src.CreateBy(),
+	})
+}
+
+func (e CreatedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e CreatedEvent) Source() string {
+	return _eventSource
+}
+
+func (e CreatedEvent) Subject() string {
+	return e.src.id
+}
+
+func (e CreatedEvent) OccurrenceTime() time.Time {
+	return e.src.CreateTime()
+}
+
+func (e CreatedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.InvitationCreatedEvent]().PkgPath()
+}
+
+// ResentEvent is an event that is emitted when an invitation is sent again.
+type ResentEvent struct {
+	src   *Invitation
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*ResentEvent)(nil)
+
+func newResentEvent(src *Invitation) ResentEvent {
+	return ResentEvent{
+		src:   src,
+		topic: TopicResent,
+	}
+}
+
+func (e ResentEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e ResentEvent) Key() string {
+	return e.src.id
+}
+
+func (e ResentEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.InvitationResentEvent{
+		InvitationId:   e.src.id,
+		OrganizationId: e.src.organizationID,
+		Email:          e.src.email,
+		ExpireTime:     timestamppb.New(e.src.expireTime),
+		SendCount:      int32(e.src.sendCount),
+		ResendTime:     timestamppb.New(e.src.LastUpdateTime()),
+		ResendBy:       e.src.LastUpdateBy(),
+	})
+}
+
+func (e ResentEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e ResentEvent) Source() string {
+	return _eventSource
+}
+
+func (e ResentEvent) Subject() string {
+	return e.src.id
+}
+
+func (e ResentEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e ResentEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.InvitationResentEvent]().PkgPath()
+}
+
+// AcceptedEvent is an event that is emitted when an invitation is accepted.
+type AcceptedEvent struct {
+	src    *Invitation
+	userID string
+	topic  event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*AcceptedEvent)(nil)
+
+func newAcceptedEvent(src *Invitation, userID string) AcceptedEvent {
+	return AcceptedEvent{
+		src:    src,
+		userID: userID,
+		topic:  TopicAccepted,
+	}
+}
+
+func (e AcceptedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e AcceptedEvent) Key() string {
+	return e.src.id
+}
+
+func (e AcceptedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.InvitationAcceptedEvent{
+		InvitationId:   e.src.id,
+		OrganizationId: e.src.organizationID,
+		UserId:         e.userID,
+		AcceptTime:     timestamppb.New(e.src.LastUpdateTime()),
+	})
+}
+
+func (e AcceptedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e AcceptedEvent) Source() string {
+	return _eventSource
+}
+
+func (e AcceptedEvent) Subject() string {
+	return e.src.id
+}
+
+func (e AcceptedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e AcceptedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.InvitationAcceptedEvent]().PkgPath()
+}
+
+// RevokedEvent is an event that is emitted when an invitation is revoked.
+type RevokedEvent struct {
+	src   *Invitation
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*RevokedEvent)(nil)
+
+func newRevokedEvent(src *Invitation) RevokedEvent {
+	return RevokedEvent{
+		src:   src,
+		topic: TopicRevoked,
+	}
+}
+
+func (e RevokedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e RevokedEvent) Key() string {
+	return e.src.id
+}
+
+func (e RevokedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.InvitationRevokedEvent{
+		InvitationId:   e.src.id,
+		OrganizationId: e.src.organizationID,
+		RevokeTime:     timestamppb.New(e.src.LastUpdateTime()),
+		RevokeBy:       e.src.LastUpdateBy(),
+	})
+}
+
+func (e RevokedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e RevokedEvent) Source() string {
+	return _eventSource
+}
+
+func (e RevokedEvent) Subject() string {
+	return e.src.id
+}
+
+func (e RevokedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e RevokedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.InvitationRevokedEvent]().PkgPath()
+}
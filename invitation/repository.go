@@ -0,0 +1,26 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/persistence"
+	"github.com/hadroncorp/geck/persistence/paging"
+)
+
+// Repository offers a set of routines to manage [Invitation] persistence store operations.
+//
+// Invitations are never removed, they get revoked instead. Delete and DeleteByKey revoke them.
+type Repository interface {
+	persistence.WriteRepository[string, Invitation]
+	persistence.ReadRepository[string, Invitation]
+	// FindPending retrieves the pending invitation of email to the organization identified by organizationID,
+	// expired or not.
+	FindPending(ctx context.Context, organizationID, email string) (*Invitation, error)
+}
+
+// ReadRepository offers a set of routines to manage [Invitation] read operations.
+type ReadRepository interface {
+	persistence.ReadRepository[string, Invitation]
+	// FindAll retrieves the invitations of the organization identified by organizationID.
+	FindAll(ctx context.Context, organizationID string, opts ...ListOption) (*paging.Page[Invitation], error)
+}
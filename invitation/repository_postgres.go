@@ -0,0 +1,257 @@
+package invitation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/hadroncorp/geck/persistence/audit"
+	"github.com/hadroncorp/geck/persistence/paging"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/samber/lo"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/organization"
+)
+
+// - Write Repository(s) -
+
+// PostgresRepository is the concrete implementation of the [Repository] interface for Postgres.
+type PostgresRepository struct {
+	db *postgresgen.Queries
+}
+
+// compile-time assertion(s)
+var (
+	_ Repository = (*PostgresRepository)(nil)
+)
+
+// NewPostgresRepository creates a new [PostgresRepository] instance.
+//
+// Operations take part of the transaction carried by the context, if any (see [sqltx.Runner]).
+func NewPostgresRepository(db gecksql.DB) PostgresRepository {
+	return PostgresRepository{
+		db: postgresgen.New(sqltx.NewConn(db)),
+	}
+}
+
+func (p PostgresRepository) Save(ctx context.Context, entity Invitation) error {
+	// DEV-NOTE: Uniqueness of pending invitations is enforced by the database (partial unique index).
+	if entity.IsNew() {
+		err := p.db.CreateInvitation(ctx, postgresgen.CreateInvitationParams{
+			InvitationID:   entity.id,
+			OrganizationID: entity.organizationID,
+			Email:          entity.email,
+			Role:           string(entity.role),
+			Status:         string(entity.status),
+			ExpireTime:     entity.expireTime,
+			SendCount:      int32(entity.sendCount),
+			LastSendTime:   entity.lastSendTime,
+			CreateTime:     entity.CreateTime(),
+			CreateBy:       entity.CreateBy(),
+			LastUpdateTime: entity.LastUpdateTime(),
+			LastUpdateBy:   entity.LastUpdateBy(),
+			RowVersion:     int64(entity.Version()),
+		})
+		return translatePostgresError(err)
+	}
+
+	affected, err := p.db.UpdateInvitation(ctx, postgresgen.UpdateInvitationParams{
+		InvitationID:       entity.id,
+		Status:             string(entity.status),
+		ExpireTime:         entity.expireTime,
+		SendCount:          int32(entity.sendCount),
+		LastSendTime:       entity.lastSendTime,
+		LastUpdateTime:     entity.LastUpdateTime(),
+		LastUpdateBy:       entity.LastUpdateBy(),
+		RowVersion:         int64(entity.Version()),
+		ExpectedRowVersion: int64(entity.persistedVersion),
+	})
+	if err != nil {
+		return translatePostgresError(err)
+	} else if affected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (p PostgresRepository) DeleteByKey(ctx context.Context, key string) error {
+	entity, err := p.FindByKey(ctx, key)
+	if err != nil || entity == nil || entity.Status() != StatusPending {
+		return err
+	}
+	entity.Revoke(ctx)
+	return p.Save(ctx, *entity)
+}
+
+func (p PostgresRepository) Delete(ctx context.Context, entity Invitation) error {
+	// DEV-NOTE: Invitations are revoked rather than removed, so the entity must be revoked already.
+	return p.Save(ctx, entity)
+}
+
+func (p PostgresRepository) FindByKey(ctx context.Context, key string) (*Invitation, error) {
+	return findByKey(ctx, p.db, key)
+}
+
+func (p PostgresRepository) FindPending(ctx context.Context, organizationID, email string) (*Invitation, error) {
+	model, err := p.db.GetPendingInvitation(ctx, postgresgen.GetPendingInvitationParams{
+		OrganizationID: organizationID,
+		Email:          email,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(newFromPostgres(model)), nil
+}
+
+// - Read Repository(s) -
+
+// _defaultPageSize is the number of items per page used when no page size was specified.
+const _defaultPageSize = 100
+
+// PostgresReadRepository is the concrete implementation of the [ReadRepository] interface for Postgres.
+type PostgresReadRepository struct {
+	db                 *postgresgen.Queries
+	pageTokenCipherKey []byte
+}
+
+// compile-time assertion(s)
+var (
+	_ ReadRepository = (*PostgresReadRepository)(nil)
+)
+
+// NewPostgresReadRepository creates a new [PostgresReadRepository] instance.
+func NewPostgresReadRepository(db gecksql.DB, tokenConfig paging.TokenConfig) PostgresReadRepository {
+	return PostgresReadRepository{
+		db:                 postgresgen.New(db),
+		pageTokenCipherKey: tokenConfig.CipherKeyBytes,
+	}
+}
+
+func (p PostgresReadRepository) FindByKey(ctx context.Context, key string) (*Invitation, error) {
+	return findByKey(ctx, p.db, key)
+}
+
+func (p PostgresReadRepository) FindAll(ctx context.Context, organizationID string,
+	opts ...ListOption) (*paging.Page[Invitation], error) {
+	listOpts := listOptions{}
+	for _, opt := range opts {
+		opt(&listOpts)
+	}
+
+	// DEV-NOTE: Keyset pagination. Pages are delimited by the invitation_id of their last row, only forward
+	// pagination is supported. Page tokens carry the whole query so every page applies the same filters.
+	query := postgresgen.ListInvitationsParams{
+		OrganizationID: organizationID,
+		Status: sql.NullString{
+			String: string(listOpts.status),
+			Valid:  listOpts.status != "",
+		},
+		PageSize: _defaultPageSize,
+	}
+	if limit := listOpts.pageOpts.Limit(); limit > 0 {
+		query.PageSize = int32(limit)
+	}
+	if listOpts.pageOpts.HasPageToken() {
+		if err := paging.ParseToken(p.pageTokenCipherKey, listOpts.pageOpts.PageToken(), &query); err != nil {
+			return nil, err
+		} else if query.OrganizationID != organizationID {
+			return nil, ErrPageTokenMismatch
+		}
+	}
+
+	// fetch an extra row to know whether more rows follow
+	pageQuery := query
+	pageQuery.PageSize++
+	models, err := p.db.ListInvitations(ctx, pageQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextToken string
+	if len(models) > int(query.PageSize) {
+		models = models[:query.PageSize]
+		query.CursorInvitationID = sql.NullString{
+			String: models[len(models)-1].InvitationID,
+			Valid:  true,
+		}
+		nextToken, err = paging.NewToken(p.pageTokenCipherKey, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &paging.Page[Invitation]{
+		TotalItems:    len(models),
+		NextPageToken: nextToken,
+		Items: lo.Map(models, func(item postgresgen.Invitation, _ int) Invitation {
+			return newFromPostgres(item)
+		}),
+	}, nil
+}
+
+// findByKey retrieves an [Invitation] by its unique identifier, it returns nil if the invitation does not exist.
+func findByKey(ctx context.Context, db *postgresgen.Queries, key string) (*Invitation, error) {
+	model, err := db.GetInvitationByID(ctx, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(newFromPostgres(model)), nil
+}
+
+// - Error(s) -
+
+const (
+	// _pgUniqueViolation is the Postgres error code (SQLSTATE) raised when a unique constraint is violated.
+	_pgUniqueViolation = "23505"
+	// _pgForeignKeyViolation is the Postgres error code (SQLSTATE) raised when a foreign key constraint is
+	// violated.
+	_pgForeignKeyViolation = "23503"
+)
+
+// translatePostgresError converts Postgres errors into domain errors.
+func translatePostgresError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case _pgUniqueViolation:
+		return ErrAlreadyExists
+	case _pgForeignKeyViolation:
+		return organization.ErrNotFound
+	default:
+		return err
+	}
+}
+
+// - Mapper(s) -
+
+// newFromPostgres builds an [Invitation] from its Postgres model.
+func newFromPostgres(model postgresgen.Invitation) Invitation {
+	return Invitation{
+		id:               model.InvitationID,
+		organizationID:   model.OrganizationID,
+		email:            model.Email,
+		role:             membership.Role(model.Role),
+		status:           Status(model.Status),
+		expireTime:       model.ExpireTime,
+		sendCount:        int(model.SendCount),
+		lastSendTime:     model.LastSendTime,
+		persistedVersion: uint64(model.RowVersion),
+		Auditable: audit.New(audit.NewArgs{
+			CreateTime:     model.CreateTime,
+			CreateBy:       model.CreateBy,
+			LastUpdateTime: model.LastUpdateTime,
+			LastUpdateBy:   model.LastUpdateBy,
+			Version:        uint64(model.RowVersion),
+		}),
+	}
+}
@@ -0,0 +1,378 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/hadroncorp/geck/syserr"
+
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/organization"
+)
+
+// - Error(s) -
+
+var (
+	// ErrNotFound is returned when the invitation is not found.
+	ErrNotFound = syserr.NewResourceNotFound[Invitation]()
+	// ErrAlreadyExists is returned when a pending invitation of the same email to the same organization exists.
+	ErrAlreadyExists = syserr.NewResourceAlreadyExists[Invitation]()
+	// ErrVersionConflict is returned when the invitation was modified by someone else (e.g. accepted twice
+	// concurrently).
+	ErrVersionConflict = syserr.NewResourceConflict[Invitation]()
+	// ErrNotPending is returned when an operation requires a pending invitation but the invitation was already
+	// accepted or revoked.
+	ErrNotPending = fmt.Errorf("invitation: invitation is no longer pending: %w", syserr.ErrResourceConflict)
+	// ErrExpired is returned when an expired invitation is accepted.
+	ErrExpired = errors.New("invitation: invitation is expired")
+	// ErrResendThrottled is returned when an invitation is sent again too soon or too many times.
+	ErrResendThrottled = errors.New("invitation: invitation was sent too recently or too many times")
+	// ErrUnauthenticated is returned when an invitation is accepted by an anonymous caller.
+	ErrUnauthenticated = fmt.Errorf("invitation: caller is not authenticated: %w", syserr.ErrForbidden)
+	// ErrRecipientMismatch is returned when an invitation is accepted by a caller whose verified email address is
+	// not the one the invitation was sent to.
+	ErrRecipientMismatch = fmt.Errorf("invitation: invitation was sent to another recipient: %w",
+		syserr.ErrForbidden)
+	// ErrPageTokenMismatch is returned when a page token is used to list the invitations of another organization.
+	ErrPageTokenMismatch = errors.New("invitation: list options do not match page token")
+)
+
+// - Domain Service(s) -
+
+// getByID retrieves an [Invitation] of the organization identified by organizationID by its unique identifier.
+func getByID(ctx context.Context, r Repository, organizationID, id string) (Invitation, error) {
+	inv, err := r.FindByKey(ctx, id)
+	if err != nil {
+		return Invitation{}, err
+	} else if inv == nil || inv.OrganizationID() != organizationID {
+		return Invitation{}, ErrNotFound
+	}
+	return *inv, nil
+}
+
+// - Application Service(s) -
+
+// -- Manager --
+
+// A Manager is the service that manages the [Invitation] lifecycle: creation, resend, revocation and acceptance.
+type Manager interface {
+	// Create invites someone to join an organization. The invitation is sent to its recipient asynchronously.
+	Create(ctx context.Context, args CreateArguments) (Invitation, error)
+	// Resend sends a pending [Invitation] again by its unique identifier, extending its validity.
+	//
+	// It fails with [ErrResendThrottled] if the invitation was sent too recently or too many times.
+	Resend(ctx context.Context, organizationID, id string) (Invitation, error)
+	// Revoke revokes a pending [Invitation] by its unique identifier.
+	Revoke(ctx context.Context, organizationID, id string) error
+	// Accept accepts the [Invitation] token was issued for, the caller becomes a member of the organization.
+	//
+	// It fails with [ErrRecipientMismatch] if the verified email address of the caller is not the one the
+	// invitation was sent to.
+	Accept(ctx context.Context, token string) (membership.Member, error)
+}
+
+// CreateArguments is the arguments required to create a new [Invitation].
+type CreateArguments struct {
+	ID             string
+	OrganizationID string
+	Email          string
+	Role           membership.Role
+}
+
+// --- Implementation(s) ---
+
+// LocalManager is a concrete implementation of the [Manager] interface that uses local resources (from the service
+// perspective).
+type LocalManager struct {
+	config         Config
+	repository     Repository
+	orgRepository  organization.Repository
+	memberManager  membership.Manager
+	signer         TokenSigner
+	eventPublisher event.Publisher
+}
+
+// compile-time assertion
+var _ Manager = (*LocalManager)(nil)
+
+// NewLocalManager creates a new [LocalManager] instance.
+//
+// The member manager must not authorize operations, recipients are not members of the organization yet.
+func NewLocalManager(config Config, r Repository, orgRepository organization.Repository,
+	memberManager membership.Manager, signer TokenSigner, p event.Publisher) LocalManager {
+	return LocalManager{
+		config:         config,
+		repository:     r,
+		orgRepository:  orgRepository,
+		memberManager:  memberManager,
+		signer:         signer,
+		eventPublisher: p,
+	}
+}
+
+// Create invites someone to join an organization.
+func (l LocalManager) Create(ctx context.Context, args CreateArguments) (Invitation, error) {
+	if !args.Role.IsValid() {
+		return Invitation{}, membership.ErrInvalidRole
+	}
+	if err := organization.EnsureActive(ctx, l.orgRepository, args.OrganizationID); err != nil {
+		return Invitation{}, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(args.Email))
+	now := time.Now().UTC()
+	// DEV-NOTE: At most one pending invitation per recipient is enforced by the database, which cannot tell
+	// expired invitations apart. They are marked as expired first so the recipient can be invited again.
+	pending, err := l.repository.FindPending(ctx, args.OrganizationID, email)
+	if err != nil {
+		return Invitation{}, err
+	} else if pending != nil && !pending.IsExpired(now) {
+		return Invitation{}, ErrAlreadyExists
+	} else if pending != nil {
+		pending.Expire(ctx)
+		if err = l.repository.Save(ctx, *pending); err != nil {
+			return Invitation{}, err
+		}
+	}
+
+	inv := New(ctx, args.ID, args.OrganizationID, email, args.Role, now.Add(l.config.TTL))
+	if err = l.repository.Save(ctx, inv); err != nil {
+		return Invitation{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, inv.PullEvents()); err != nil {
+		return Invitation{}, err
+	}
+	return inv, nil
+}
+
+// Resend sends a pending [Invitation] again by its unique identifier.
+func (l LocalManager) Resend(ctx context.Context, organizationID, id string) (Invitation, error) {
+	inv, err := getByID(ctx, l.repository, organizationID, id)
+	if err != nil {
+		return Invitation{}, err
+	} else if inv.Status() != StatusPending {
+		return Invitation{}, ErrNotPending
+	} else if err = organization.EnsureActive(ctx, l.orgRepository, organizationID); err != nil {
+		return Invitation{}, err
+	}
+
+	now := time.Now().UTC()
+	if inv.SendCount() >= l.config.MaxSends || now.Sub(inv.LastSendTime()) < l.config.ResendInterval {
+		return Invitation{}, ErrResendThrottled
+	}
+	inv.Resend(ctx, now.Add(l.config.TTL))
+	if err = l.repository.Save(ctx, inv); err != nil {
+		return Invitation{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, inv.PullEvents()); err != nil {
+		return Invitation{}, err
+	}
+	return inv, nil
+}
+
+// Revoke revokes a pending [Invitation] by its unique identifier.
+func (l LocalManager) Revoke(ctx context.Context, organizationID, id string) error {
+	inv, err := getByID(ctx, l.repository, organizationID, id)
+	if errors.Is(err, syserr.ErrResourceNotFound) {
+		return nil // no-op
+	} else if err != nil {
+		return err
+	}
+
+	switch inv.Status() {
+	case StatusRevoked:
+		return nil // no-op
+	case StatusAccepted:
+		return ErrNotPending
+	}
+	if err = organization.EnsureActive(ctx, l.orgRepository, organizationID); err != nil {
+		return err
+	}
+	inv.Revoke(ctx)
+	if err = l.repository.Delete(ctx, inv); err != nil {
+		return err
+	}
+	return l.eventPublisher.Publish(ctx, inv.PullEvents())
+}
+
+// Accept accepts the [Invitation] token was issued for.
+func (l LocalManager) Accept(ctx context.Context, token string) (membership.Member, error) {
+	principal, ok := identity.GetPrincipal(ctx)
+	if !ok {
+		return membership.Member{}, ErrUnauthenticated
+	}
+
+	// DEV-NOTE: Unknown invitations and forged tokens are reported alike, so tokens cannot be used to probe
+	// invitation identifiers.
+	id, err := l.signer.ParseID(token)
+	if err != nil {
+		return membership.Member{}, err
+	}
+	inv, err := l.repository.FindByKey(ctx, id)
+	if err != nil {
+		return membership.Member{}, err
+	} else if inv == nil || !l.signer.Verify(*inv, token) {
+		return membership.Member{}, ErrInvalidToken
+	} else if !isRecipient(principal, *inv) {
+		return membership.Member{}, ErrRecipientMismatch
+	} else if inv.Status() != StatusPending {
+		return membership.Member{}, ErrNotPending
+	} else if inv.IsExpired(time.Now().UTC()) {
+		return membership.Member{}, ErrExpired
+	}
+
+	// DEV-NOTE: Tokens are single-use. Once accepted, the invitation is no longer pending; concurrent
+	// acceptances are detected through optimistic concurrency control.
+	inv.Accept(ctx, principal.ID())
+	if err = l.repository.Save(ctx, *inv); err != nil {
+		return membership.Member{}, err
+	}
+	member, err := l.memberManager.Add(ctx, membership.AddArguments{
+		OrganizationID: inv.OrganizationID(),
+		UserID:         principal.ID(),
+		Role:           inv.Role(),
+	})
+	if err != nil {
+		return membership.Member{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, inv.PullEvents()); err != nil {
+		return membership.Member{}, err
+	}
+	return member, nil
+}
+
+// isRecipient checks whether principal is the recipient of inv, that is, whether its verified email address
+// (i.e. the one vouched for by the identity provider) is the one inv was sent to.
+func isRecipient(principal identity.Principal, inv Invitation) bool {
+	holder, ok := principal.(interface{ Email() string })
+	if !ok {
+		return false
+	}
+	email := strings.TrimSpace(holder.Email())
+	return email != "" && strings.EqualFold(email, inv.Email())
+}
+
+// TransactionalManager is a [Manager] decorator executing every operation of the underlying [Manager] within
+// a single transaction.
+//
+// Use it along a transactional outbox [event.Publisher] to make entity writes and event writes atomic.
+type TransactionalManager struct {
+	next     Manager
+	txRunner sqltx.Runner
+}
+
+// compile-time assertion
+var _ Manager = (*TransactionalManager)(nil)
+
+// NewTransactionalManager creates a new [TransactionalManager] instance.
+func NewTransactionalManager(next Manager, r sqltx.Runner) TransactionalManager {
+	return TransactionalManager{next: next, txRunner: r}
+}
+
+// Create invites someone to join an organization.
+func (t TransactionalManager) Create(ctx context.Context, args CreateArguments) (Invitation, error) {
+	var inv Invitation
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		inv, err = t.next.Create(scopedCtx, args)
+		return err
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+	return inv, nil
+}
+
+// Resend sends a pending [Invitation] again by its unique identifier.
+func (t TransactionalManager) Resend(ctx context.Context, organizationID, id string) (Invitation, error) {
+	var inv Invitation
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		inv, err = t.next.Resend(scopedCtx, organizationID, id)
+		return err
+	})
+	if err != nil {
+		return Invitation{}, err
+	}
+	return inv, nil
+}
+
+// Revoke revokes a pending [Invitation] by its unique identifier.
+func (t TransactionalManager) Revoke(ctx context.Context, organizationID, id string) error {
+	return t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) error {
+		return t.next.Revoke(scopedCtx, organizationID, id)
+	})
+}
+
+// Accept accepts the [Invitation] token was issued for.
+func (t TransactionalManager) Accept(ctx context.Context, token string) (membership.Member, error) {
+	var member membership.Member
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		member, err = t.next.Accept(scopedCtx, token)
+		return err
+	})
+	if err != nil {
+		return membership.Member{}, err
+	}
+	return member, nil
+}
+
+// -- Lister --
+
+// A Lister is the service that lists [Invitation] information.
+type Lister interface {
+	// List retrieves the invitations of the organization identified by organizationID.
+	List(ctx context.Context, organizationID string, opts ...ListOption) (*paging.Page[Invitation], error)
+}
+
+// --- Option(s) ---
+type listOptions struct {
+	pageOpts paging.Options
+	status   Status
+}
+
+// ListOption represents an option for listing [Invitation] entities.
+type ListOption func(*listOptions)
+
+// WithListPageOptions sets the pagination options ([paging.Option]) for the list operation.
+func WithListPageOptions(opts ...paging.Option) ListOption {
+	return func(o *listOptions) {
+		for _, opt := range opts {
+			opt(&o.pageOpts)
+		}
+	}
+}
+
+// WithListStatus sets the option to find only invitations with the given status.
+func WithListStatus(status Status) ListOption {
+	return func(o *listOptions) {
+		o.status = status
+	}
+}
+
+// --- Implementation(s) ---
+
+// LocalLister is a concrete implementation of the [Lister] interface that uses local resources (from the service
+// perspective).
+type LocalLister struct {
+	repository ReadRepository
+}
+
+// compile-time assertion
+var _ Lister = (*LocalLister)(nil)
+
+// NewLocalLister creates a new [LocalLister] instance.
+func NewLocalLister(r ReadRepository) LocalLister {
+	return LocalLister{repository: r}
+}
+
+// List retrieves the invitations of the organization identified by organizationID.
+func (l LocalLister) List(ctx context.Context, organizationID string, opts ...ListOption) (
+	*paging.Page[Invitation], error) {
+	return l.repository.FindAll(ctx, organizationID, opts...)
+}
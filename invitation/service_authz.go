@@ -0,0 +1,114 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/persistence/paging"
+
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/membership"
+)
+
+const (
+	// PermissionCreate is the permission to invite people to an organization and to send invitations again.
+	PermissionCreate authz.Permission = "invitation:create"
+	// PermissionList is the permission to list the invitations of an organization.
+	PermissionList authz.Permission = "invitation:list"
+	// PermissionRevoke is the permission to revoke invitations of an organization.
+	PermissionRevoke authz.Permission = "invitation:revoke"
+	// PermissionAccept is the permission to accept invitations. Tokens remain required.
+	PermissionAccept authz.Permission = "invitation:accept"
+)
+
+// DefaultPolicy returns the roles granting the invitation permissions unless configured otherwise (see
+// [authz.Config]).
+func DefaultPolicy() authz.Policy {
+	return authz.Policy{
+		PermissionCreate: {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+		PermissionList:   {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+		PermissionRevoke: {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+		PermissionAccept: {authz.RoleAny},
+	}
+}
+
+// AuthorizedManager is a [Manager] decorator allowing callers to perform an operation of the underlying
+// [Manager] only if the [authz.Authorizer] grants them its permission on the organization.
+type AuthorizedManager struct {
+	next       Manager
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Manager = (*AuthorizedManager)(nil)
+
+// NewAuthorizedManager creates a new [AuthorizedManager] instance.
+func NewAuthorizedManager(next Manager, a authz.Authorizer) AuthorizedManager {
+	return AuthorizedManager{next: next, authorizer: a}
+}
+
+// Create invites someone to join an organization.
+//
+// Inviting owners also requires [membership.PermissionUpdate], so callers cannot grant roles they are not allowed
+// to manage.
+func (a AuthorizedManager) Create(ctx context.Context, args CreateArguments) (Invitation, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionCreate, args.OrganizationID); err != nil {
+		return Invitation{}, err
+	}
+	if args.Role == membership.RoleOwner {
+		if err := a.authorizer.Authorize(ctx, membership.PermissionUpdate, args.OrganizationID); err != nil {
+			return Invitation{}, err
+		}
+	}
+	return a.next.Create(ctx, args)
+}
+
+// Resend sends a pending [Invitation] again by its unique identifier.
+func (a AuthorizedManager) Resend(ctx context.Context, organizationID, id string) (Invitation, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionCreate, organizationID); err != nil {
+		return Invitation{}, err
+	}
+	return a.next.Resend(ctx, organizationID, id)
+}
+
+// Revoke revokes a pending [Invitation] by its unique identifier.
+func (a AuthorizedManager) Revoke(ctx context.Context, organizationID, id string) error {
+	if err := a.authorizer.Authorize(ctx, PermissionRevoke, organizationID); err != nil {
+		return err
+	}
+	return a.next.Revoke(ctx, organizationID, id)
+}
+
+// Accept accepts the [Invitation] token was issued for.
+//
+// Recipients are not members of the organization yet, so the permission is checked against platform-wide roles
+// only.
+func (a AuthorizedManager) Accept(ctx context.Context, token string) (membership.Member, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionAccept, ""); err != nil {
+		return membership.Member{}, err
+	}
+	return a.next.Accept(ctx, token)
+}
+
+// AuthorizedLister is a [Lister] decorator allowing callers to list the invitations of an organization only if
+// the [authz.Authorizer] grants them [PermissionList] on the organization.
+type AuthorizedLister struct {
+	next       Lister
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Lister = (*AuthorizedLister)(nil)
+
+// NewAuthorizedLister creates a new [AuthorizedLister] instance.
+func NewAuthorizedLister(next Lister, a authz.Authorizer) AuthorizedLister {
+	return AuthorizedLister{next: next, authorizer: a}
+}
+
+// List retrieves the invitations of the organization identified by organizationID.
+func (a AuthorizedLister) List(ctx context.Context, organizationID string, opts ...ListOption) (
+	*paging.Page[Invitation], error) {
+	if err := a.authorizer.Authorize(ctx, PermissionList, organizationID); err != nil {
+		return nil, err
+	}
+	return a.next.List(ctx, organizationID, opts...)
+}
@@ -0,0 +1,315 @@
+package invitation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/eventmock"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/invitation"
+	"github.com/hadroncorp/service-template/invitationmock"
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/membershipmock"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+type localManagerSuite struct {
+	suite.Suite
+
+	repository     *invitationmock.MockRepository
+	orgRepository  *organizationmock.MockRepository
+	memberManager  *membershipmock.MockManager
+	eventPublisher *eventmock.MockPublisher
+	signer         invitation.TokenSigner
+	manager        invitation.LocalManager
+	baseCtx        context.Context
+}
+
+func TestLocalManagerSuite(t *testing.T) {
+	suite.Run(t, new(localManagerSuite))
+}
+
+func (s *localManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	config := invitation.Config{
+		TokenSigningKey: _signingKey,
+		TTL:             time.Hour,
+		ResendInterval:  time.Minute,
+		MaxSends:        2,
+	}
+	signer, err := invitation.NewTokenSigner(config)
+	s.Require().NoError(err)
+	s.repository = invitationmock.NewMockRepository(ctrl)
+	s.orgRepository = organizationmock.NewMockRepository(ctrl)
+	s.memberManager = membershipmock.NewMockManager(ctrl)
+	s.eventPublisher = eventmock.NewMockPublisher(ctrl)
+	s.signer = signer
+	s.manager = invitation.NewLocalManager(config, s.repository, s.orgRepository, s.memberManager, signer,
+		s.eventPublisher)
+	s.baseCtx = identity.WithPrincipal(context.Background(),
+		authn.NewPrincipal("some-user").WithEmail("Bar@example.com"))
+}
+
+func (s *localManagerSuite) TestLocalManager_Accept() {
+	// arrange
+	inv := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleAdmin, time.Now().Add(time.Hour))
+	_ = inv.PullEvents()
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&inv, error(nil))
+	s.repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	s.memberManager.EXPECT().
+		Add(s.baseCtx, membership.AddArguments{
+			OrganizationID: "2",
+			UserID:         "some-user",
+			Role:           membership.RoleAdmin,
+		}).
+		Times(1).
+		Return(membership.New(s.baseCtx, "2", "some-user", membership.RoleAdmin), error(nil))
+	s.eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	out, err := s.manager.Accept(s.baseCtx, s.signer.Sign(inv))
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal(membership.RoleAdmin, out.Role())
+}
+
+func (s *localManagerSuite) TestLocalManager_Accept_Recipient_Mismatch() {
+	tests := []struct {
+		name        string
+		inPrincipal identity.Principal
+	}{
+		{
+			name:        "other email",
+			inPrincipal: authn.NewPrincipal("some-user").WithEmail("baz@example.com"),
+		},
+		{
+			name:        "no verified email",
+			inPrincipal: authn.NewPrincipal("some-user"),
+		},
+		{
+			name:        "no email claims",
+			inPrincipal: identity.NewBasicPrincipal("some-user"),
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			ctx := identity.WithPrincipal(context.Background(), tt.inPrincipal)
+			inv := invitation.New(ctx, "1", "2", "bar@example.com", membership.RoleAdmin,
+				time.Now().Add(time.Hour))
+			s.repository.EXPECT().
+				FindByKey(ctx, "1").
+				Times(1).
+				Return(&inv, error(nil))
+
+			// act
+			_, err := s.manager.Accept(ctx, s.signer.Sign(inv))
+
+			// assert
+			s.Assert().ErrorIs(err, invitation.ErrRecipientMismatch)
+		})
+	}
+}
+
+func (s *localManagerSuite) TestLocalManager_Accept_Expired() {
+	// arrange
+	inv := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleAdmin, time.Now().Add(-time.Second))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&inv, error(nil))
+
+	// act
+	_, err := s.manager.Accept(s.baseCtx, s.signer.Sign(inv))
+
+	// assert
+	s.Assert().ErrorIs(err, invitation.ErrExpired)
+}
+
+func (s *localManagerSuite) TestLocalManager_Accept_Already_Accepted() {
+	// arrange
+	inv := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleAdmin, time.Now().Add(time.Hour))
+	token := s.signer.Sign(inv)
+	inv.Accept(s.baseCtx, "some-user")
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&inv, error(nil))
+
+	// act
+	_, err := s.manager.Accept(s.baseCtx, token)
+
+	// assert
+	s.Assert().ErrorIs(err, invitation.ErrNotPending)
+}
+
+func (s *localManagerSuite) TestLocalManager_Accept_Invalid_Token() {
+	// arrange
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return((*invitation.Invitation)(nil), error(nil))
+
+	// act
+	_, err := s.manager.Accept(s.baseCtx, "MQ.Zm9v")
+
+	// assert
+	s.Assert().ErrorIs(err, invitation.ErrInvalidToken)
+}
+
+func (s *localManagerSuite) TestLocalManager_Accept_Anonymous() {
+	// act
+	_, err := s.manager.Accept(context.Background(), "MQ.Zm9v")
+
+	// assert
+	s.Assert().ErrorIs(err, invitation.ErrUnauthenticated)
+}
+
+func (s *localManagerSuite) TestLocalManager_Create_Pending_Exists() {
+	// arrange
+	org := organization.New(s.baseCtx, "2", "foo")
+	pending := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleMember,
+		time.Now().Add(time.Hour))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		FindPending(s.baseCtx, "2", "bar@example.com").
+		Times(1).
+		Return(&pending, error(nil))
+
+	// act
+	_, err := s.manager.Create(s.baseCtx, invitation.CreateArguments{
+		ID:             "3",
+		OrganizationID: "2",
+		Email:          "Bar@example.com",
+		Role:           membership.RoleAdmin,
+	})
+
+	// assert
+	s.Assert().ErrorIs(err, invitation.ErrAlreadyExists)
+}
+
+func (s *localManagerSuite) TestLocalManager_Create_Pending_Expired() {
+	// arrange
+	org := organization.New(s.baseCtx, "2", "foo")
+	pending := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleMember,
+		time.Now().Add(-time.Second))
+	gomock.InOrder(
+		s.repository.EXPECT().
+			Save(s.baseCtx, gomock.Cond(func(inv invitation.Invitation) bool {
+				return inv.ID() == "1" && inv.Status() == invitation.StatusExpired
+			})).
+			Times(1).
+			Return(error(nil)),
+		s.repository.EXPECT().
+			Save(s.baseCtx, gomock.Cond(func(inv invitation.Invitation) bool {
+				return inv.ID() == "3" && inv.Status() == invitation.StatusPending
+			})).
+			Times(1).
+			Return(error(nil)),
+	)
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		FindPending(s.baseCtx, "2", "bar@example.com").
+		Times(1).
+		Return(&pending, error(nil))
+	s.eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	out, err := s.manager.Create(s.baseCtx, invitation.CreateArguments{
+		ID:             "3",
+		OrganizationID: "2",
+		Email:          "Bar@example.com",
+		Role:           membership.RoleAdmin,
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal("3", out.ID())
+}
+
+func (s *localManagerSuite) TestLocalManager_Resend_Throttled() {
+	// arrange
+	org := organization.New(s.baseCtx, "2", "foo")
+	inv := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleAdmin, time.Now().Add(time.Hour))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&inv, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&org, error(nil))
+
+	// act
+	_, err := s.manager.Resend(s.baseCtx, "2", "1")
+
+	// assert
+	s.Assert().ErrorIs(err, invitation.ErrResendThrottled)
+}
+
+func (s *localManagerSuite) TestLocalManager_Resend_Organization_Not_Active() {
+	// arrange
+	org := organization.New(s.baseCtx, "2", "foo")
+	s.Require().NoError(org.Suspend(s.baseCtx, organization.ReasonPolicyViolation))
+	inv := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleAdmin, time.Now().Add(time.Hour))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&inv, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&org, error(nil))
+
+	// act
+	_, err := s.manager.Resend(s.baseCtx, "2", "1")
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
+}
+
+func (s *localManagerSuite) TestLocalManager_Revoke_Organization_Not_Active() {
+	// arrange
+	org := organization.New(s.baseCtx, "2", "foo")
+	s.Require().NoError(org.Archive(s.baseCtx, organization.ReasonPolicyViolation))
+	inv := invitation.New(s.baseCtx, "1", "2", "bar@example.com", membership.RoleAdmin, time.Now().Add(time.Hour))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&inv, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&org, error(nil))
+
+	// act
+	err := s.manager.Revoke(s.baseCtx, "2", "1")
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
+}
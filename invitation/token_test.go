@@ -0,0 +1,97 @@
+package invitation_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hadroncorp/service-template/invitation"
+	"github.com/hadroncorp/service-template/membership"
+)
+
+const _signingKey = "0123456789abcdef0123456789abcdef"
+
+func TestNewTokenSigner(t *testing.T) {
+	// act
+	_, err := invitation.NewTokenSigner(invitation.Config{TokenSigningKey: "short"})
+
+	// assert
+	assert.ErrorIs(t, err, invitation.ErrWeakSigningKey)
+}
+
+func TestTokenSigner_Verify(t *testing.T) {
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	signer, err := invitation.NewTokenSigner(invitation.Config{TokenSigningKey: _signingKey})
+	require.NoError(t, err)
+	otherSigner, err := invitation.NewTokenSigner(invitation.Config{TokenSigningKey: strings.Repeat("x", 32)})
+	require.NoError(t, err)
+
+	inv := invitation.New(ctx, "1", "2", "bar@example.com", membership.RoleMember, time.Now().Add(time.Hour))
+	resent := inv
+	resent.Resend(ctx, time.Now().Add(2*time.Hour))
+	token := signer.Sign(inv)
+
+	tests := []struct {
+		name  string
+		inInv invitation.Invitation
+		inTok string
+		exp   bool
+	}{
+		{
+			name:  "valid",
+			inInv: inv,
+			inTok: token,
+			exp:   true,
+		},
+		{
+			name:  "tampered signature",
+			inInv: inv,
+			inTok: token[:len(token)-2] + "AA",
+		},
+		{
+			name:  "other key",
+			inInv: inv,
+			inTok: otherSigner.Sign(inv),
+		},
+		{
+			name:  "sent again",
+			inInv: resent,
+			inTok: token,
+		},
+		{
+			name:  "malformed",
+			inInv: inv,
+			inTok: "foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// act
+			out := signer.Verify(tt.inInv, tt.inTok)
+
+			// assert
+			assert.Equal(t, tt.exp, out)
+		})
+	}
+}
+
+func TestTokenSigner_ParseID(t *testing.T) {
+	// arrange
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	signer, err := invitation.NewTokenSigner(invitation.Config{TokenSigningKey: _signingKey})
+	require.NoError(t, err)
+	inv := invitation.New(ctx, "1", "2", "bar@example.com", membership.RoleMember, time.Now().Add(time.Hour))
+
+	// act
+	out, err := signer.ParseID(signer.Sign(inv))
+	_, errMalformed := signer.ParseID("foo")
+
+	// assert
+	assert.NoError(t, err)
+	assert.Equal(t, "1", out)
+	assert.ErrorIs(t, errMalformed, invitation.ErrInvalidToken)
+}
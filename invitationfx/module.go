@@ -0,0 +1,60 @@
+package invitationfx
+
+import (
+	"github.com/hadroncorp/enclave/kafka/kafkafx"
+	"github.com/hadroncorp/geck/transportfx/httpfx"
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/authzfx"
+	"github.com/hadroncorp/service-template/invitation"
+	"github.com/hadroncorp/service-template/openapifx"
+	"github.com/hadroncorp/service-template/outboxfx"
+)
+
+var Module = fx.Module("hadron/iam/invitation",
+	fx.Provide(
+		invitation.NewConfig,
+		invitation.NewTokenSigner,
+		fx.Annotate(
+			invitation.NewPostgresRepository,
+			fx.As(new(invitation.Repository)),
+		),
+		fx.Annotate(
+			invitation.NewPostgresReadRepository,
+			fx.As(new(invitation.ReadRepository)),
+		),
+		// recipients are not members yet, so memberships are added without authorization
+		fx.Annotate(
+			invitation.NewLocalManager,
+			fx.ParamTags(``, ``, ``, `name:"membership_transactional_manager"`, ``,
+				`name:"`+outboxfx.PublisherName+`"`),
+			fx.ResultTags(`name:"invitation_local_manager"`),
+			fx.As(new(invitation.Manager)),
+		),
+		fx.Annotate(
+			invitation.NewTransactionalManager,
+			fx.ParamTags(`name:"invitation_local_manager"`),
+			fx.ResultTags(`name:"invitation_transactional_manager"`),
+			fx.As(new(invitation.Manager)),
+		),
+		fx.Annotate(
+			invitation.NewAuthorizedManager,
+			fx.ParamTags(`name:"invitation_transactional_manager"`),
+			fx.As(new(invitation.Manager)),
+		),
+		fx.Annotate(
+			invitation.NewLocalLister,
+			fx.ResultTags(`name:"invitation_local_lister"`),
+			fx.As(new(invitation.Lister)),
+		),
+		fx.Annotate(
+			invitation.NewAuthorizedLister,
+			fx.ParamTags(`name:"invitation_local_lister"`),
+			fx.As(new(invitation.Lister)),
+		),
+		authzfx.AsPolicy(invitation.DefaultPolicy),
+		httpfx.AsController(invitation.NewControllerHTTP),
+		openapifx.AsDescriber(invitation.NewControllerHTTP),
+		kafkafx.AsController(invitation.NewControllerKafka),
+	),
+)
@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: invitation/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=invitation/repository.go -destination=invitationmock/repository.go -package=invitationmock
+//
+
+// Package invitationmock is a generated GoMock package.
+package invitationmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	paging "github.com/hadroncorp/geck/persistence/paging"
+	invitation "github.com/hadroncorp/service-template/invitation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, entity invitation.Invitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, entity)
+}
+
+// DeleteByKey mocks base method.
+func (m *MockRepository) DeleteByKey(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockRepositoryMockRecorder) DeleteByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockRepository)(nil).DeleteByKey), ctx, key)
+}
+
+// FindByKey mocks base method.
+func (m *MockRepository) FindByKey(ctx context.Context, key string) (*invitation.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", ctx, key)
+	ret0, _ := ret[0].(*invitation.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockRepositoryMockRecorder) FindByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockRepository)(nil).FindByKey), ctx, key)
+}
+
+// FindPending mocks base method.
+func (m *MockRepository) FindPending(ctx context.Context, organizationID, email string) (*invitation.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, organizationID, email)
+	ret0, _ := ret[0].(*invitation.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockRepositoryMockRecorder) FindPending(ctx, organizationID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockRepository)(nil).FindPending), ctx, organizationID, email)
+}
+
+// Save mocks base method.
+func (m *MockRepository) Save(ctx context.Context, entity invitation.Invitation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRepositoryMockRecorder) Save(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRepository)(nil).Save), ctx, entity)
+}
+
+// MockReadRepository is a mock of ReadRepository interface.
+type MockReadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReadRepositoryMockRecorder is the mock recorder for MockReadRepository.
+type MockReadRepositoryMockRecorder struct {
+	mock *MockReadRepository
+}
+
+// NewMockReadRepository creates a new mock instance.
+func NewMockReadRepository(ctrl *gomock.Controller) *MockReadRepository {
+	mock := &MockReadRepository{ctrl: ctrl}
+	mock.recorder = &MockReadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadRepository) EXPECT() *MockReadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockReadRepository) FindAll(ctx context.Context, organizationID string, opts ...invitation.ListOption) (*paging.Page[invitation.Invitation], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, organizationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAll", varargs...)
+	ret0, _ := ret[0].(*paging.Page[invitation.Invitation])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReadRepositoryMockRecorder) FindAll(ctx, organizationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, organizationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReadRepository)(nil).FindAll), varargs...)
+}
+
+// FindByKey mocks base method.
+func (m *MockReadRepository) FindByKey(ctx context.Context, key string) (*invitation.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", ctx, key)
+	ret0, _ := ret[0].(*invitation.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockReadRepositoryMockRecorder) FindByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockReadRepository)(nil).FindByKey), ctx, key)
+}
@@ -0,0 +1,163 @@
+package membership
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/hadroncorp/geck/transport"
+	geckhttp "github.com/hadroncorp/geck/transport/http"
+	"github.com/hadroncorp/geck/validation"
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/idempotency"
+)
+
+type ControllerHTTP struct {
+	manager     Manager
+	lister      Lister
+	authn       authn.MiddlewareHTTP
+	idempotency idempotency.MiddlewareHTTP
+	validator   validation.Validator
+}
+
+// compile-time assertion
+var _ geckhttp.Controller = (*ControllerHTTP)(nil)
+
+// NewControllerHTTP creates a new instance of [ControllerHTTP].
+func NewControllerHTTP(manager Manager, lister Lister, authnMiddleware authn.MiddlewareHTTP,
+	idempotencyMiddleware idempotency.MiddlewareHTTP, validator validation.Validator) ControllerHTTP {
+	return ControllerHTTP{
+		manager:     manager,
+		lister:      lister,
+		authn:       authnMiddleware,
+		idempotency: idempotencyMiddleware,
+		validator:   validator,
+	}
+}
+
+func (c ControllerHTTP) SetEndpoints(_ *echo.Echo) {
+}
+
+func (c ControllerHTTP) SetVersionedEndpoints(g *echo.Group) {
+	mg := g.Group("/organizations/:organization_id/members", c.authn.Handle)
+	mg.POST("", c.add, c.idempotency.Handle)
+	mg.GET("", c.list)
+	mg.PATCH("/:user_id", c.changeRole)
+	mg.DELETE("/:user_id", c.remove)
+}
+
+func (c ControllerHTTP) add(e echo.Context) error {
+	body := addRequestHTTP{}
+	if err := e.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.validator.Validate(e.Request().Context(), body); err != nil {
+		return err
+	}
+
+	member, err := c.manager.Add(e.Request().Context(), AddArguments{
+		OrganizationID: e.Param("organization_id"),
+		UserID:         body.UserID,
+		Role:           Role(body.Role),
+	})
+	if err != nil {
+		return err
+	}
+	return e.JSON(http.StatusCreated, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(member),
+	})
+}
+
+func (c ControllerHTTP) changeRole(e echo.Context) error {
+	body := changeRoleRequestHTTP{}
+	if err := e.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.validator.Validate(e.Request().Context(), body); err != nil {
+		return err
+	}
+
+	member, err := c.manager.ChangeRole(e.Request().Context(), Key{
+		OrganizationID: e.Param("organization_id"),
+		UserID:         e.Param("user_id"),
+	}, Role(body.Role))
+	if err != nil {
+		return err
+	}
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(member),
+	})
+}
+
+func (c ControllerHTTP) remove(e echo.Context) error {
+	err := c.manager.Remove(e.Request().Context(), Key{
+		OrganizationID: e.Param("organization_id"),
+		UserID:         e.Param("user_id"),
+	})
+	if err != nil {
+		return err
+	}
+	return e.NoContent(http.StatusNoContent)
+}
+
+func (c ControllerHTTP) list(e echo.Context) error {
+	opts := []ListOption{
+		WithListPageOptions(geckhttp.NewPaginationOptions(e)...),
+	}
+	if role := Role(e.QueryParam("role")); role != "" {
+		if !role.IsValid() {
+			return echo.NewHTTPError(http.StatusBadRequest, "role must be one of owner admin member")
+		}
+		opts = append(opts, WithListRole(role))
+	}
+
+	page, err := c.lister.List(e.Request().Context(), e.Param("organization_id"), opts...)
+	if errors.Is(err, ErrPageTokenMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest,
+			"page_token belongs to another organization or role must not change between pages").SetInternal(err)
+	} else if err != nil {
+		return err
+	} else if len(page.Items) == 0 {
+		return e.NoContent(http.StatusNotFound)
+	}
+
+	return e.JSON(http.StatusOK, transport.DataContainer[transport.PageResponse[responseHTTP]]{
+		Data: transport.PageResponse[responseHTTP]{
+			TotalItems:        page.TotalItems,
+			PreviousPageToken: page.PreviousPageToken,
+			NextPageToken:     page.NextPageToken,
+			Items: lo.Map(page.Items, func(m Member, _ int) responseHTTP {
+				return newResponseHTTP(m)
+			}),
+		},
+	})
+}
+
+// -- Models --
+
+type addRequestHTTP struct {
+	UserID string `json:"user_id" validate:"required,lte=96"`
+	Role   string `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+type changeRoleRequestHTTP struct {
+	Role string `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+type responseHTTP struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+	Role           string `json:"role"`
+}
+
+func newResponseHTTP(m Member) responseHTTP {
+	return responseHTTP{
+		OrganizationID: m.OrganizationID(),
+		UserID:         m.UserID(),
+		Role:           string(m.Role()),
+	}
+}
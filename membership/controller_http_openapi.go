@@ -0,0 +1,86 @@
+package membership
+
+import (
+	"net/http"
+
+	"github.com/hadroncorp/geck/transport"
+
+	"github.com/hadroncorp/service-template/openapi"
+)
+
+// compile-time assertion
+var _ openapi.Describer = (*ControllerHTTP)(nil)
+
+var _tagsOpenAPI = []string{"Members"}
+
+func (c ControllerHTTP) Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			ID:      "AddMember",
+			Method:  http.MethodPost,
+			Handler: c.add,
+			Summary: "Adds a user to an organization.",
+			Tags:    _tagsOpenAPI,
+			Parameters: []openapi.Parameter{
+				{
+					Name:        "Idempotency-Key",
+					In:          "header",
+					Description: "Unique key making retries of the request safe.",
+				},
+			},
+			RequestBody: addRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusCreated,
+					Description: "Member added.",
+					Body:        transport.DataContainer[responseHTTP]{},
+				},
+			},
+		},
+		{
+			ID:          "ChangeMemberRole",
+			Method:      http.MethodPatch,
+			Handler:     c.changeRole,
+			Summary:     "Changes the role of a member. The last owner of an organization cannot be demoted.",
+			Tags:        _tagsOpenAPI,
+			RequestBody: changeRoleRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Member role changed.",
+					Body:        transport.DataContainer[responseHTTP]{},
+				},
+			},
+		},
+		{
+			ID:      "RemoveMember",
+			Method:  http.MethodDelete,
+			Handler: c.remove,
+			Summary: "Removes a member from an organization. The last owner of an organization cannot be removed.",
+			Tags:    _tagsOpenAPI,
+		},
+		{
+			ID:      "ListMembers",
+			Method:  http.MethodGet,
+			Handler: c.list,
+			Summary: "Lists the members of an organization.",
+			Tags:    _tagsOpenAPI,
+			Parameters: []openapi.Parameter{
+				{Name: "role", In: "query", Description: "Role of the members to list."},
+				{Name: "page_size", In: "query", Description: "Maximum number of items per page.", Type: 0},
+				{Name: "page_token", In: "query", Description: "Token of the page to retrieve."},
+			},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Page of members.",
+					Body:        transport.DataContainer[transport.PageResponse[responseHTTP]]{},
+				},
+				{
+					StatusCode:  http.StatusNotFound,
+					Description: "No member matched.",
+				},
+			},
+		},
+	}
+}
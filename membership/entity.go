@@ -0,0 +1,101 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/persistence/audit"
+)
+
+// Role is the role a [Member] holds on an organization.
+type Role string
+
+const (
+	// RoleOwner is the role of members owning an organization. Every organization keeps at least one owner.
+	RoleOwner Role = "owner"
+	// RoleAdmin is the role of members administrating an organization.
+	RoleAdmin Role = "admin"
+	// RoleMember is the role of members belonging to an organization.
+	RoleMember Role = "member"
+)
+
+// IsValid checks whether r is a supported role.
+func (r Role) IsValid() bool {
+	return r == RoleOwner || r == RoleAdmin || r == RoleMember
+}
+
+// Key is the unique identifier of a [Member].
+type Key struct {
+	OrganizationID string
+	UserID         string
+}
+
+// Member is a user belonging to an organization.
+//
+// The role of the member determines the operations the user is allowed to perform on the organization.
+type Member struct {
+	audit.Auditable
+	event.AggregatorTemplate
+	organizationID string
+	userID         string
+	role           Role
+	// persistedVersion is the version the member is expected to have in the persistence store, used to
+	// detect concurrent modifications (optimistic concurrency control).
+	persistedVersion uint64
+}
+
+// New creates a new [Member] of the organization identified by organizationID.
+func New(ctx context.Context, organizationID, userID string, role Role) Member {
+	member := Member{
+		Auditable:      audit.NewWithDefaults(ctx),
+		organizationID: organizationID,
+		userID:         userID,
+		role:           role,
+	}
+	member.RegisterEvents(newAddedEvent(member))
+	return member
+}
+
+// Key returns the unique identifier of the member.
+func (m Member) Key() Key {
+	return Key{
+		OrganizationID: m.organizationID,
+		UserID:         m.userID,
+	}
+}
+
+// OrganizationID returns the unique identifier of the organization the member belongs to.
+func (m Member) OrganizationID() string {
+	return m.organizationID
+}
+
+// UserID returns the unique identifier of the user.
+func (m Member) UserID() string {
+	return m.userID
+}
+
+// Role returns the role of the member.
+func (m Member) Role() Role {
+	return m.role
+}
+
+// ChangeRole changes the role of the [Member].
+//
+// It returns true if the role changed, false otherwise.
+func (m *Member) ChangeRole(ctx context.Context, role Role) bool {
+	if m.role == role {
+		return false // no-op
+	}
+
+	prevRole := m.role
+	m.role = role
+	audit.Update(ctx, &m.Auditable)
+	m.RegisterEvents(newRoleChangedEvent(m, prevRole))
+	return true
+}
+
+// Remove removes the [Member] from its organization.
+func (m *Member) Remove(ctx context.Context) {
+	audit.Delete(ctx, &m.Auditable)
+	m.RegisterEvents(newRemovedEvent(m))
+}
@@ -0,0 +1,93 @@
+package membership_test
+
+import (
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"event-schema-registry/iampb"
+	"github.com/hadroncorp/service-template/membership"
+)
+
+func TestNew(t *testing.T) {
+	// arrange
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+
+	// act
+	out := membership.New(ctx, "1", "bar", membership.RoleAdmin)
+
+	// assert
+	assert.Equal(t, membership.Key{OrganizationID: "1", UserID: "bar"}, out.Key())
+	assert.Equal(t, membership.RoleAdmin, out.Role())
+	assert.Equal(t, "foo", out.CreateBy())
+	events := out.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "hadron.iam.member.added", events[0].Topic().String())
+	assert.Equal(t, "1", events[0].Key())
+}
+
+func TestMember_ChangeRole(t *testing.T) {
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	tests := []struct {
+		name       string
+		inRole     membership.Role
+		expChanged bool
+		expEvents  int
+	}{
+		{
+			name:       "promoted",
+			inRole:     membership.RoleOwner,
+			expChanged: true,
+			expEvents:  1,
+		},
+		{
+			name:   "same role",
+			inRole: membership.RoleMember,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			member := membership.New(ctx, "1", "bar", membership.RoleMember)
+			_ = member.PullEvents()
+
+			// act
+			changed := member.ChangeRole(ctx, tt.inRole)
+
+			// assert
+			assert.Equal(t, tt.expChanged, changed)
+			assert.Equal(t, tt.inRole, member.Role())
+			events := member.PullEvents()
+			require.Len(t, events, tt.expEvents)
+			if tt.expEvents == 0 {
+				return
+			}
+			assert.Equal(t, "hadron.iam.member.role_changed", events[0].Topic().String())
+			payload, err := events[0].Bytes()
+			require.NoError(t, err)
+			ev := &iampb.MemberRoleChangedEvent{}
+			require.NoError(t, proto.Unmarshal(payload, ev))
+			assert.Equal(t, "owner", ev.GetRole())
+			assert.Equal(t, "member", ev.GetPreviousRole())
+		})
+	}
+}
+
+func TestMember_Remove(t *testing.T) {
+	// arrange
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	member := membership.New(ctx, "1", "bar", membership.RoleMember)
+	_ = member.PullEvents()
+
+	// act
+	member.Remove(ctx)
+
+	// assert
+	events := member.PullEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, "hadron.iam.member.removed", events[0].Topic().String())
+	assert.Equal(t, "1/bar", events[0].Subject())
+}
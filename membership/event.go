@@ -0,0 +1,198 @@
+package membership
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"event-schema-registry/iampb"
+)
+
+const (
+	_eventSource = "/organizations/members"
+)
+
+var (
+	// TopicAdded is the event topic for member addition.
+	TopicAdded = event.NewTopic("hadron", "member", "added",
+		event.WithPlatform("iam"))
+	// TopicRemoved is the event topic for member removal.
+	TopicRemoved = event.NewTopic("hadron", "member", "removed",
+		event.WithPlatform("iam"))
+	// TopicRoleChanged is the event topic for member role change.
+	TopicRoleChanged = event.NewTopic("hadron", "member", "role_changed",
+		event.WithPlatform("iam"))
+)
+
+// subject returns the subject of the events of the given [Member].
+func subject(m *Member) string {
+	return m.organizationID + "/" + m.userID
+}
+
+// AddedEvent is an event that is emitted when a user joins an organization.
+type AddedEvent struct {
+	src   Member
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*AddedEvent)(nil)
+
+func newAddedEvent(src Member) AddedEvent {
+	return AddedEvent{
+		src:   src,
+		topic: TopicAdded,
+	}
+}
+
+func (e AddedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e AddedEvent) Key() string {
+	return e.src.organizationID
+}
+
+func (e AddedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.MemberAddedEvent{
+		OrganizationId: e.src.organizationID,
+		UserId:         e.src.userID,
+		Role:           string(e.src.role),
+		AddTime:        timestamppb.New(e.src.CreateTime()),
+		AddBy:          e.src.CreateBy(),
+	})
+}
+
+func (e AddedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e AddedEvent) Source() string {
+	return _eventSource
+}
+
+func (e AddedEvent) Subject() string {
+	return subject(&e.src)
+}
+
+func (e AddedEvent) OccurrenceTime() time.Time {
+	return e.src.CreateTime()
+}
+
+func (e AddedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.MemberAddedEvent]().PkgPath()
+}
+
+// RemovedEvent is an event that is emitted when a user leaves an organization.
+type RemovedEvent struct {
+	src   *Member
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*RemovedEvent)(nil)
+
+func newRemovedEvent(src *Member) RemovedEvent {
+	return RemovedEvent{
+		src:   src,
+		topic: TopicRemoved,
+	}
+}
+
+func (e RemovedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e RemovedEvent) Key() string {
+	return e.src.organizationID
+}
+
+func (e RemovedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.MemberRemovedEvent{
+		OrganizationId: e.src.organizationID,
+		UserId:         e.src.userID,
+		RemoveTime:     timestamppb.New(e.src.LastUpdateTime()),
+		RemoveBy:       e.src.LastUpdateBy(),
+	})
+}
+
+func (e RemovedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e RemovedEvent) Source() string {
+	return _eventSource
+}
+
+func (e RemovedEvent) Subject() string {
+	return subject(e.src)
+}
+
+func (e RemovedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e RemovedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.MemberRemovedEvent]().PkgPath()
+}
+
+// RoleChangedEvent is an event that is emitted when the role of a member of an organization changes.
+type RoleChangedEvent struct {
+	src      *Member
+	prevRole Role
+	topic    event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*RoleChangedEvent)(nil)
+
+func newRoleChangedEvent(src *Member, prevRole Role) RoleChangedEvent {
+	return RoleChangedEvent{
+		src:      src,
+		prevRole: prevRole,
+		topic:    TopicRoleChanged,
+	}
+}
+
+func (e RoleChangedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e RoleChangedEvent) Key() string {
+	return e.src.organizationID
+}
+
+func (e RoleChangedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.MemberRoleChangedEvent{
+		OrganizationId: e.src.organizationID,
+		UserId:         e.src.userID,
+		Role:           string(e.src.role),
+		PreviousRole:   string(e.prevRole),
+		ChangeTime:     timestamppb.New(e.src.LastUpdateTime()),
+		ChangeBy:       e.src.LastUpdateBy(),
+	})
+}
+
+func (e RoleChangedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e RoleChangedEvent) Source() string {
+	return _eventSource
+}
+
+func (e RoleChangedEvent) Subject() string {
+	return subject(e.src)
+}
+
+func (e RoleChangedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e RoleChangedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.MemberRoleChangedEvent]().PkgPath()
+}
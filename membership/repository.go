@@ -0,0 +1,28 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/persistence"
+	"github.com/hadroncorp/geck/persistence/paging"
+)
+
+// Repository offers a set of routines to manage [Member] persistence store operations.
+//
+// Members are removed permanently, Delete and DeleteByKey perform physical deletions.
+type Repository interface {
+	persistence.WriteRepository[Key, Member]
+	persistence.ReadRepository[Key, Member]
+	// CountByRole counts the members of the organization identified by organizationID holding role.
+	//
+	// Counted members are locked until the transaction carried by the context ends, so concurrent operations
+	// counting them wait for it (e.g. two owners demoting each other).
+	CountByRole(ctx context.Context, organizationID string, role Role) (int, error)
+}
+
+// ReadRepository offers a set of routines to manage [Member] read operations.
+type ReadRepository interface {
+	persistence.ReadRepository[Key, Member]
+	// FindAll retrieves the members of the organization identified by organizationID, sorted by user.
+	FindAll(ctx context.Context, organizationID string, opts ...ListOption) (*paging.Page[Member], error)
+}
@@ -0,0 +1,243 @@
+package membership
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/hadroncorp/geck/persistence/audit"
+	"github.com/hadroncorp/geck/persistence/paging"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/samber/lo"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/organization"
+)
+
+// - Write Repository(s) -
+
+// PostgresRepository is the concrete implementation of the [Repository] interface for Postgres.
+type PostgresRepository struct {
+	db *postgresgen.Queries
+}
+
+// compile-time assertion(s)
+var (
+	_ Repository = (*PostgresRepository)(nil)
+)
+
+// NewPostgresRepository creates a new [PostgresRepository] instance.
+//
+// Operations take part of the transaction carried by the context, if any (see [sqltx.Runner]).
+func NewPostgresRepository(db gecksql.DB) PostgresRepository {
+	return PostgresRepository{
+		db: postgresgen.New(sqltx.NewConn(db)),
+	}
+}
+
+func (p PostgresRepository) Save(ctx context.Context, entity Member) error {
+	if entity.IsNew() {
+		err := p.db.CreateOrganizationMember(ctx, postgresgen.CreateOrganizationMemberParams{
+			OrganizationID: entity.organizationID,
+			UserID:         entity.userID,
+			Role:           string(entity.role),
+			CreateTime:     entity.CreateTime(),
+			CreateBy:       entity.CreateBy(),
+			LastUpdateTime: entity.LastUpdateTime(),
+			LastUpdateBy:   entity.LastUpdateBy(),
+			RowVersion:     int64(entity.Version()),
+		})
+		return translatePostgresError(err)
+	}
+
+	affected, err := p.db.UpdateOrganizationMember(ctx, postgresgen.UpdateOrganizationMemberParams{
+		OrganizationID:     entity.organizationID,
+		UserID:             entity.userID,
+		Role:               string(entity.role),
+		LastUpdateTime:     entity.LastUpdateTime(),
+		LastUpdateBy:       entity.LastUpdateBy(),
+		RowVersion:         int64(entity.Version()),
+		ExpectedRowVersion: int64(entity.persistedVersion),
+	})
+	if err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (p PostgresRepository) DeleteByKey(ctx context.Context, key Key) error {
+	return p.db.DeleteOrganizationMember(ctx, postgresgen.DeleteOrganizationMemberParams{
+		OrganizationID: key.OrganizationID,
+		UserID:         key.UserID,
+	})
+}
+
+func (p PostgresRepository) Delete(ctx context.Context, entity Member) error {
+	return p.DeleteByKey(ctx, entity.Key())
+}
+
+func (p PostgresRepository) CountByRole(ctx context.Context, organizationID string, role Role) (int, error) {
+	// DEV-NOTE: Rows are locked (SELECT ... FOR UPDATE) instead of counted, so the count stays accurate until
+	// the transaction ends.
+	userIDs, err := p.db.LockOrganizationMembersByRole(ctx, postgresgen.LockOrganizationMembersByRoleParams{
+		OrganizationID: organizationID,
+		Role:           string(role),
+	})
+	return len(userIDs), err
+}
+
+func (p PostgresRepository) FindByKey(ctx context.Context, key Key) (*Member, error) {
+	return findByKey(ctx, p.db, key)
+}
+
+// - Read Repository(s) -
+
+// _defaultPageSize is the number of items per page used when no page size was specified.
+const _defaultPageSize = 100
+
+// PostgresReadRepository is the concrete implementation of the [ReadRepository] interface for Postgres.
+type PostgresReadRepository struct {
+	db                 *postgresgen.Queries
+	pageTokenCipherKey []byte
+}
+
+// compile-time assertion(s)
+var (
+	_ ReadRepository = (*PostgresReadRepository)(nil)
+)
+
+// NewPostgresReadRepository creates a new [PostgresReadRepository] instance.
+func NewPostgresReadRepository(db gecksql.DB, tokenConfig paging.TokenConfig) PostgresReadRepository {
+	return PostgresReadRepository{
+		db:                 postgresgen.New(db),
+		pageTokenCipherKey: tokenConfig.CipherKeyBytes,
+	}
+}
+
+func (p PostgresReadRepository) FindByKey(ctx context.Context, key Key) (*Member, error) {
+	return findByKey(ctx, p.db, key)
+}
+
+func (p PostgresReadRepository) FindAll(ctx context.Context, organizationID string,
+	opts ...ListOption) (*paging.Page[Member], error) {
+	listOpts := listOptions{}
+	for _, opt := range opts {
+		opt(&listOpts)
+	}
+
+	// DEV-NOTE: Keyset pagination. Pages are delimited by the user_id of their last row, only forward
+	// pagination is supported. Page tokens carry the whole query so every page applies the same filters.
+	query := postgresgen.ListOrganizationMembersParams{
+		OrganizationID: organizationID,
+		Role: sql.NullString{
+			String: string(listOpts.role),
+			Valid:  listOpts.role != "",
+		},
+		PageSize: _defaultPageSize,
+	}
+	if limit := listOpts.pageOpts.Limit(); limit > 0 {
+		query.PageSize = int32(limit)
+	}
+	if listOpts.pageOpts.HasPageToken() {
+		if err := paging.ParseToken(p.pageTokenCipherKey, listOpts.pageOpts.PageToken(), &query); err != nil {
+			return nil, err
+		} else if query.OrganizationID != organizationID {
+			return nil, ErrPageTokenMismatch
+		} else if listOpts.role != "" && string(listOpts.role) != query.Role.String {
+			// the role may be left unset on following pages, it is taken from the page token then
+			return nil, ErrPageTokenMismatch
+		}
+	}
+
+	// fetch an extra row to know whether more rows follow
+	pageQuery := query
+	pageQuery.PageSize++
+	models, err := p.db.ListOrganizationMembers(ctx, pageQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextToken string
+	if len(models) > int(query.PageSize) {
+		models = models[:query.PageSize]
+		query.CursorUserID = sql.NullString{
+			String: models[len(models)-1].UserID,
+			Valid:  true,
+		}
+		nextToken, err = paging.NewToken(p.pageTokenCipherKey, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &paging.Page[Member]{
+		TotalItems:    len(models),
+		NextPageToken: nextToken,
+		Items: lo.Map(models, func(item postgresgen.OrganizationMember, _ int) Member {
+			return newFromPostgres(item)
+		}),
+	}, nil
+}
+
+// findByKey retrieves a [Member] by its unique identifier, it returns nil if the member does not exist.
+func findByKey(ctx context.Context, db *postgresgen.Queries, key Key) (*Member, error) {
+	model, err := db.GetOrganizationMember(ctx, postgresgen.GetOrganizationMemberParams{
+		OrganizationID: key.OrganizationID,
+		UserID:         key.UserID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(newFromPostgres(model)), nil
+}
+
+// - Error(s) -
+
+const (
+	// _pgUniqueViolation is the Postgres error code (SQLSTATE) raised when a unique constraint is violated.
+	_pgUniqueViolation = "23505"
+	// _pgForeignKeyViolation is the Postgres error code (SQLSTATE) raised when a foreign key constraint is
+	// violated.
+	_pgForeignKeyViolation = "23503"
+)
+
+// translatePostgresError converts Postgres errors into domain errors.
+func translatePostgresError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case _pgUniqueViolation:
+		return ErrAlreadyExists
+	case _pgForeignKeyViolation:
+		return organization.ErrNotFound
+	default:
+		return err
+	}
+}
+
+// - Mapper(s) -
+
+// newFromPostgres builds a [Member] from its Postgres model.
+func newFromPostgres(model postgresgen.OrganizationMember) Member {
+	return Member{
+		organizationID:   model.OrganizationID,
+		userID:           model.UserID,
+		role:             Role(model.Role),
+		persistedVersion: uint64(model.RowVersion),
+		Auditable: audit.New(audit.NewArgs{
+			CreateTime:     model.CreateTime,
+			CreateBy:       model.CreateBy,
+			LastUpdateTime: model.LastUpdateTime,
+			LastUpdateBy:   model.LastUpdateBy,
+			Version:        uint64(model.RowVersion),
+		}),
+	}
+}
@@ -0,0 +1,116 @@
+//go:build integration
+
+package membership_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/persistence/postgres/postgrestest"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/membership"
+)
+
+type postgresReadRepositoryIntegrationSuite struct {
+	suite.Suite
+
+	baseCtx           context.Context
+	baseCtxCancelFunc context.CancelFunc
+	dbContainer       *postgrestest.Container
+	readRepository    membership.PostgresReadRepository
+}
+
+func TestPostgresReadRepositoryIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(postgresReadRepositoryIntegrationSuite))
+}
+
+func (s *postgresReadRepositoryIntegrationSuite) SetupSuite() {
+	// setup context
+	const testSuiteTimeout = time.Minute
+	s.baseCtx, s.baseCtxCancelFunc = context.WithTimeout(context.Background(), testSuiteTimeout)
+
+	// setup container
+	var err error
+	s.dbContainer, err = postgrestest.NewContainer(s.baseCtx, s.T())
+	s.Require().NoError(err)
+	sqlDB, err := postgrestest.StartContainer(s.baseCtx, s.T(), s.dbContainer, "./thirdparty/postgres/migrations")
+	s.Require().NoError(err)
+	db := gecksql.NewDB(sqlDB)
+	tokenConfig, err := paging.NewTokenConfig()
+	s.Require().NoError(err)
+	s.readRepository = membership.NewPostgresReadRepository(db, tokenConfig)
+
+	// setup seeds
+	now := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+	queryer := postgresgen.New(db)
+	err = queryer.CreateOrganization(s.baseCtx, postgresgen.CreateOrganizationParams{
+		OrganizationID: "1",
+		Name:           "foo",
+		Slug:           "foo",
+		CreateTime:     now,
+		CreateBy:       "some-user",
+		LastUpdateTime: now,
+		LastUpdateBy:   "some-user",
+	})
+	s.Require().NoError(err)
+	for userID, role := range map[string]membership.Role{
+		"alice": membership.RoleOwner,
+		"bob":   membership.RoleMember,
+		"carol": membership.RoleMember,
+	} {
+		err = queryer.CreateOrganizationMember(s.baseCtx, postgresgen.CreateOrganizationMemberParams{
+			OrganizationID: "1",
+			UserID:         userID,
+			Role:           string(role),
+			CreateTime:     now,
+			CreateBy:       "some-user",
+			LastUpdateTime: now,
+			LastUpdateBy:   "some-user",
+		})
+		s.Require().NoError(err)
+	}
+}
+
+func (s *postgresReadRepositoryIntegrationSuite) TearDownSuite() {
+	defer s.baseCtxCancelFunc()
+	shutdownCtx, cancelFunc := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFunc()
+	s.Assert().NoError(s.dbContainer.Instance.Terminate(shutdownCtx))
+}
+
+func (s *postgresReadRepositoryIntegrationSuite) TestPostgresReadRepository_FindAll_Role_Changed() {
+	// arrange
+	page, err := s.readRepository.FindAll(s.baseCtx, "1",
+		membership.WithListPageOptions(paging.WithLimit(1)),
+		membership.WithListRole(membership.RoleMember),
+	)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	// act
+	same, errSame := s.readRepository.FindAll(s.baseCtx, "1",
+		membership.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		membership.WithListRole(membership.RoleMember),
+	)
+	tokenOnly, errTokenOnly := s.readRepository.FindAll(s.baseCtx, "1",
+		membership.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+	)
+	_, errChanged := s.readRepository.FindAll(s.baseCtx, "1",
+		membership.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		membership.WithListRole(membership.RoleOwner),
+	)
+
+	// assert
+	s.Require().NoError(errSame)
+	s.Require().NoError(errTokenOnly)
+	s.Require().Len(same.Items, 1)
+	s.Require().Len(tokenOnly.Items, 1)
+	s.Assert().Equal("carol", same.Items[0].UserID())
+	s.Assert().Equal("carol", tokenOnly.Items[0].UserID())
+	s.Assert().ErrorIs(errChanged, membership.ErrPageTokenMismatch)
+}
@@ -0,0 +1,305 @@
+package membership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/syserr"
+
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/organization"
+)
+
+// - Error(s) -
+
+var (
+	// ErrNotFound is returned when the member is not found.
+	ErrNotFound = syserr.NewResourceNotFound[Member]()
+	// ErrAlreadyExists is returned when the user already is a member of the organization.
+	ErrAlreadyExists = syserr.NewResourceAlreadyExists[Member]()
+	// ErrVersionConflict is returned when the member was modified by someone else (i.e. its stored version
+	// differs from the expected one).
+	ErrVersionConflict = syserr.NewResourceConflict[Member]()
+	// ErrLastOwner is returned when an operation would leave an organization without owners.
+	ErrLastOwner = fmt.Errorf("membership: organization must keep at least one owner: %w",
+		syserr.ErrResourceConflict)
+	// ErrInvalidRole is returned when a role is not supported.
+	ErrInvalidRole = errors.New("membership: invalid role")
+	// ErrPageTokenMismatch is returned when a page token is used to list the members of another organization or
+	// with another role.
+	ErrPageTokenMismatch = errors.New("membership: list options do not match page token")
+)
+
+// - Domain Service(s) -
+
+// getByKey retrieves a [Member] by its unique identifier.
+func getByKey(ctx context.Context, r Repository, key Key) (Member, error) {
+	member, err := r.FindByKey(ctx, key)
+	if err != nil {
+		return Member{}, err
+	} else if member == nil {
+		return Member{}, ErrNotFound
+	}
+	return *member, nil
+}
+
+// ensureOwnerRemains returns [ErrLastOwner] if member is the last owner of its organization.
+func ensureOwnerRemains(ctx context.Context, r Repository, member Member) error {
+	if member.Role() != RoleOwner {
+		return nil
+	}
+	owners, err := r.CountByRole(ctx, member.OrganizationID(), RoleOwner)
+	if err != nil {
+		return err
+	} else if owners <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// - Application Service(s) -
+
+// -- Manager --
+
+// A Manager is the service that manages the [Member] administrative operations such as add, change role and
+// remove.
+//
+// Every organization keeps at least one owner, operations removing or demoting the last owner fail with
+// [ErrLastOwner].
+type Manager interface {
+	// Add adds a user to an organization.
+	Add(ctx context.Context, args AddArguments) (Member, error)
+	// ChangeRole changes the role of a [Member] by its unique identifier.
+	ChangeRole(ctx context.Context, key Key, role Role) (Member, error)
+	// Remove removes a [Member] from its organization by its unique identifier.
+	Remove(ctx context.Context, key Key) error
+}
+
+// AddArguments is the arguments required to add a user to an organization.
+type AddArguments struct {
+	OrganizationID string
+	UserID         string
+	Role           Role
+}
+
+// --- Implementation(s) ---
+
+// LocalManager is a concrete implementation of the [Manager] interface that uses local resources (from the service
+// perspective).
+type LocalManager struct {
+	repository     Repository
+	orgRepository  organization.Repository
+	eventPublisher event.Publisher
+}
+
+// compile-time assertion
+var _ Manager = (*LocalManager)(nil)
+
+// NewLocalManager creates a new [LocalManager] instance.
+func NewLocalManager(r Repository, orgRepository organization.Repository, p event.Publisher) LocalManager {
+	return LocalManager{repository: r, orgRepository: orgRepository, eventPublisher: p}
+}
+
+// Add adds a user to an organization.
+func (l LocalManager) Add(ctx context.Context, args AddArguments) (Member, error) {
+	if !args.Role.IsValid() {
+		return Member{}, ErrInvalidRole
+	}
+	if err := organization.EnsureActive(ctx, l.orgRepository, args.OrganizationID); err != nil {
+		return Member{}, err
+	}
+
+	member := New(ctx, args.OrganizationID, args.UserID, args.Role)
+	if err := l.repository.Save(ctx, member); err != nil {
+		return Member{}, err
+	}
+	if err := l.eventPublisher.Publish(ctx, member.PullEvents()); err != nil {
+		return Member{}, err
+	}
+	return member, nil
+}
+
+// ChangeRole changes the role of a [Member] by its unique identifier.
+func (l LocalManager) ChangeRole(ctx context.Context, key Key, role Role) (Member, error) {
+	if !role.IsValid() {
+		return Member{}, ErrInvalidRole
+	}
+	member, err := getByKey(ctx, l.repository, key)
+	if err != nil {
+		return Member{}, err
+	} else if member.Role() == role {
+		return member, nil // no-op
+	}
+
+	if err = organization.EnsureActive(ctx, l.orgRepository, key.OrganizationID); err != nil {
+		return Member{}, err
+	}
+	if err = ensureOwnerRemains(ctx, l.repository, member); err != nil {
+		return Member{}, err
+	}
+	member.ChangeRole(ctx, role)
+	if err = l.repository.Save(ctx, member); err != nil {
+		return Member{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, member.PullEvents()); err != nil {
+		return Member{}, err
+	}
+	return member, nil
+}
+
+// Remove removes a [Member] from its organization by its unique identifier.
+func (l LocalManager) Remove(ctx context.Context, key Key) error {
+	member, err := getByKey(ctx, l.repository, key)
+	if errors.Is(err, syserr.ErrResourceNotFound) {
+		return nil // no-op
+	} else if err != nil {
+		return err
+	}
+
+	if err = organization.EnsureActive(ctx, l.orgRepository, key.OrganizationID); err != nil {
+		return err
+	}
+	if err = ensureOwnerRemains(ctx, l.repository, member); err != nil {
+		return err
+	}
+	member.Remove(ctx)
+	if err = l.repository.Delete(ctx, member); err != nil {
+		return err
+	}
+	return l.eventPublisher.Publish(ctx, member.PullEvents())
+}
+
+// TransactionalManager is a [Manager] decorator executing every operation of the underlying [Manager] within
+// a single transaction.
+//
+// Use it along a transactional outbox [event.Publisher] to make entity writes and event writes atomic. It also
+// keeps the owners counted by the underlying [Manager] locked until the operation ends.
+type TransactionalManager struct {
+	next     Manager
+	txRunner sqltx.Runner
+}
+
+// compile-time assertion
+var _ Manager = (*TransactionalManager)(nil)
+
+// NewTransactionalManager creates a new [TransactionalManager] instance.
+func NewTransactionalManager(next Manager, r sqltx.Runner) TransactionalManager {
+	return TransactionalManager{next: next, txRunner: r}
+}
+
+// Add adds a user to an organization.
+func (t TransactionalManager) Add(ctx context.Context, args AddArguments) (Member, error) {
+	var member Member
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		member, err = t.next.Add(scopedCtx, args)
+		return err
+	})
+	if err != nil {
+		return Member{}, err
+	}
+	return member, nil
+}
+
+// ChangeRole changes the role of a [Member] by its unique identifier.
+func (t TransactionalManager) ChangeRole(ctx context.Context, key Key, role Role) (Member, error) {
+	var member Member
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		member, err = t.next.ChangeRole(scopedCtx, key, role)
+		return err
+	})
+	if err != nil {
+		return Member{}, err
+	}
+	return member, nil
+}
+
+// Remove removes a [Member] from its organization by its unique identifier.
+func (t TransactionalManager) Remove(ctx context.Context, key Key) error {
+	return t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) error {
+		return t.next.Remove(scopedCtx, key)
+	})
+}
+
+// -- Lister --
+
+// A Lister is the service that lists [Member] information.
+type Lister interface {
+	// List retrieves the members of the organization identified by organizationID.
+	List(ctx context.Context, organizationID string, opts ...ListOption) (*paging.Page[Member], error)
+}
+
+// --- Option(s) ---
+type listOptions struct {
+	pageOpts paging.Options
+	role     Role
+}
+
+// ListOption represents an option for listing [Member] entities.
+type ListOption func(*listOptions)
+
+// WithListPageOptions sets the pagination options ([paging.Option]) for the list operation.
+func WithListPageOptions(opts ...paging.Option) ListOption {
+	return func(o *listOptions) {
+		for _, opt := range opts {
+			opt(&o.pageOpts)
+		}
+	}
+}
+
+// WithListRole sets the option to find only members holding the given role.
+func WithListRole(role Role) ListOption {
+	return func(o *listOptions) {
+		o.role = role
+	}
+}
+
+// --- Implementation(s) ---
+
+// LocalLister is a concrete implementation of the [Lister] interface that uses local resources (from the service
+// perspective).
+type LocalLister struct {
+	repository ReadRepository
+}
+
+// compile-time assertion
+var _ Lister = (*LocalLister)(nil)
+
+// NewLocalLister creates a new [LocalLister] instance.
+func NewLocalLister(r ReadRepository) LocalLister {
+	return LocalLister{repository: r}
+}
+
+// List retrieves the members of the organization identified by organizationID.
+func (l LocalLister) List(ctx context.Context, organizationID string, opts ...ListOption) (*paging.Page[Member],
+	error) {
+	return l.repository.FindAll(ctx, organizationID, opts...)
+}
+
+// -- Role resolution --
+
+// RoleResolver is an [authz.RoleResolver] granting callers the role of their membership.
+type RoleResolver struct {
+	repository ReadRepository
+}
+
+// compile-time assertion
+var _ authz.RoleResolver = (*RoleResolver)(nil)
+
+// NewRoleResolver creates a new [RoleResolver] instance.
+func NewRoleResolver(r ReadRepository) RoleResolver {
+	return RoleResolver{repository: r}
+}
+
+// ResolveRoles returns the roles the principal holds on the organization.
+func (r RoleResolver) ResolveRoles(ctx context.Context, principalID, organizationID string) ([]authz.Role,
+	error) {
+	member, err := r.repository.FindByKey(ctx, Key{OrganizationID: organizationID, UserID: principalID})
+	if err != nil || member == nil {
+		return nil, err
+	}
+	return []authz.Role{authz.Role(member.Role())}, nil
+}
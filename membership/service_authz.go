@@ -0,0 +1,124 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/persistence/paging"
+
+	"github.com/hadroncorp/service-template/authz"
+)
+
+const (
+	// PermissionList is the permission to list the members of an organization.
+	PermissionList authz.Permission = "member:list"
+	// PermissionAdd is the permission to add users to an organization.
+	PermissionAdd authz.Permission = "member:add"
+	// PermissionUpdate is the permission to change the role of members of an organization.
+	PermissionUpdate authz.Permission = "member:update"
+	// PermissionRemove is the permission to remove members from an organization.
+	PermissionRemove authz.Permission = "member:remove"
+	// PermissionManageOwners is the permission to remove or demote the owners of an organization.
+	PermissionManageOwners authz.Permission = "member:manage_owners"
+)
+
+// DefaultPolicy returns the roles granting the membership permissions unless configured otherwise (see
+// [authz.Config]).
+func DefaultPolicy() authz.Policy {
+	return authz.Policy{
+		PermissionList:   {authz.RoleOwner, authz.RoleAdmin, authz.RoleMember, authz.RoleIAMAdmin},
+		PermissionAdd:    {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+		PermissionUpdate: {authz.RoleOwner, authz.RoleIAMAdmin},
+		PermissionRemove: {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+		// only owners remove or demote owners, admins could take over organizations otherwise
+		PermissionManageOwners: {authz.RoleOwner},
+	}
+}
+
+// AuthorizedManager is a [Manager] decorator allowing callers to perform an operation of the underlying
+// [Manager] only if the [authz.Authorizer] grants them its permission on the organization.
+type AuthorizedManager struct {
+	next       Manager
+	authorizer authz.Authorizer
+	repository ReadRepository
+}
+
+// compile-time assertion
+var _ Manager = (*AuthorizedManager)(nil)
+
+// NewAuthorizedManager creates a new [AuthorizedManager] instance.
+func NewAuthorizedManager(next Manager, a authz.Authorizer, r ReadRepository) AuthorizedManager {
+	return AuthorizedManager{next: next, authorizer: a, repository: r}
+}
+
+// authorizeOwnerManagement returns [authz.ErrPermissionDenied] if the member identified by key is an owner and
+// the caller is not granted [PermissionManageOwners] on its organization.
+func (a AuthorizedManager) authorizeOwnerManagement(ctx context.Context, key Key) error {
+	member, err := a.repository.FindByKey(ctx, key)
+	if err != nil || member == nil || member.Role() != RoleOwner {
+		return err
+	}
+	return a.authorizer.Authorize(ctx, PermissionManageOwners, key.OrganizationID)
+}
+
+// Add adds a user to an organization.
+//
+// Adding owners also requires [PermissionUpdate], so callers cannot grant roles they are not allowed to manage.
+func (a AuthorizedManager) Add(ctx context.Context, args AddArguments) (Member, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionAdd, args.OrganizationID); err != nil {
+		return Member{}, err
+	}
+	if args.Role == RoleOwner {
+		if err := a.authorizer.Authorize(ctx, PermissionUpdate, args.OrganizationID); err != nil {
+			return Member{}, err
+		}
+	}
+	return a.next.Add(ctx, args)
+}
+
+// ChangeRole changes the role of a [Member] by its unique identifier.
+//
+// Demoting owners also requires [PermissionManageOwners].
+func (a AuthorizedManager) ChangeRole(ctx context.Context, key Key, role Role) (Member, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionUpdate, key.OrganizationID); err != nil {
+		return Member{}, err
+	} else if err = a.authorizeOwnerManagement(ctx, key); err != nil {
+		return Member{}, err
+	}
+	return a.next.ChangeRole(ctx, key, role)
+}
+
+// Remove removes a [Member] from its organization by its unique identifier.
+//
+// Removing owners also requires [PermissionManageOwners].
+func (a AuthorizedManager) Remove(ctx context.Context, key Key) error {
+	if err := a.authorizer.Authorize(ctx, PermissionRemove, key.OrganizationID); err != nil {
+		return err
+	} else if err = a.authorizeOwnerManagement(ctx, key); err != nil {
+		return err
+	}
+	return a.next.Remove(ctx, key)
+}
+
+// AuthorizedLister is a [Lister] decorator allowing callers to list the members of an organization only if the
+// [authz.Authorizer] grants them [PermissionList] on the organization.
+type AuthorizedLister struct {
+	next       Lister
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Lister = (*AuthorizedLister)(nil)
+
+// NewAuthorizedLister creates a new [AuthorizedLister] instance.
+func NewAuthorizedLister(next Lister, a authz.Authorizer) AuthorizedLister {
+	return AuthorizedLister{next: next, authorizer: a}
+}
+
+// List retrieves the members of the organization identified by organizationID.
+func (a AuthorizedLister) List(ctx context.Context, organizationID string, opts ...ListOption) (
+	*paging.Page[Member], error) {
+	if err := a.authorizer.Authorize(ctx, PermissionList, organizationID); err != nil {
+		return nil, err
+	}
+	return a.next.List(ctx, organizationID, opts...)
+}
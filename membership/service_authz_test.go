@@ -0,0 +1,129 @@
+package membership_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/authzmock"
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/membershipmock"
+)
+
+type authorizedManagerSuite struct {
+	suite.Suite
+
+	next       *membershipmock.MockManager
+	repository *membershipmock.MockReadRepository
+	resolver   *authzmock.MockRoleResolver
+	manager    membership.AuthorizedManager
+	baseCtx    context.Context
+}
+
+func TestAuthorizedManagerSuite(t *testing.T) {
+	suite.Run(t, new(authorizedManagerSuite))
+}
+
+func (s *authorizedManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.next = membershipmock.NewMockManager(ctrl)
+	s.repository = membershipmock.NewMockReadRepository(ctrl)
+	s.resolver = authzmock.NewMockRoleResolver(ctrl)
+	authorizer := authz.NewAuthorizer(authz.Config{Enabled: true}, s.resolver, slog.Default(),
+		membership.DefaultPolicy())
+	s.manager = membership.NewAuthorizedManager(s.next, authorizer, s.repository)
+	s.baseCtx = identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_Remove_Member_By_Admin() {
+	// arrange
+	key := membership.Key{OrganizationID: "1", UserID: "bar"}
+	member := membership.New(s.baseCtx, "1", "bar", membership.RoleMember)
+	s.resolver.EXPECT().
+		ResolveRoles(s.baseCtx, "some-user", "1").
+		Times(1).
+		Return([]authz.Role{authz.RoleAdmin}, error(nil))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&member, error(nil))
+	s.next.EXPECT().
+		Remove(s.baseCtx, key).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	err := s.manager.Remove(s.baseCtx, key)
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_Remove_Owner_By_Admin() {
+	// arrange
+	key := membership.Key{OrganizationID: "1", UserID: "bar"}
+	owner := membership.New(s.baseCtx, "1", "bar", membership.RoleOwner)
+	s.resolver.EXPECT().
+		ResolveRoles(s.baseCtx, "some-user", "1").
+		Times(2).
+		Return([]authz.Role{authz.RoleAdmin}, error(nil))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&owner, error(nil))
+
+	// act
+	err := s.manager.Remove(s.baseCtx, key)
+
+	// assert
+	s.Assert().ErrorIs(err, authz.ErrPermissionDenied)
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_Remove_Owner_By_Owner() {
+	// arrange
+	key := membership.Key{OrganizationID: "1", UserID: "bar"}
+	owner := membership.New(s.baseCtx, "1", "bar", membership.RoleOwner)
+	s.resolver.EXPECT().
+		ResolveRoles(s.baseCtx, "some-user", "1").
+		Times(2).
+		Return([]authz.Role{authz.RoleOwner}, error(nil))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&owner, error(nil))
+	s.next.EXPECT().
+		Remove(s.baseCtx, key).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	err := s.manager.Remove(s.baseCtx, key)
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_ChangeRole_Owner_By_IAM_Admin() {
+	// arrange
+	key := membership.Key{OrganizationID: "1", UserID: "bar"}
+	owner := membership.New(s.baseCtx, "1", "bar", membership.RoleOwner)
+	s.resolver.EXPECT().
+		ResolveRoles(s.baseCtx, "some-user", "1").
+		Times(2).
+		Return([]authz.Role{authz.RoleIAMAdmin}, error(nil))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&owner, error(nil))
+
+	// act
+	_, err := s.manager.ChangeRole(s.baseCtx, key, membership.RoleMember)
+
+	// assert
+	s.Assert().ErrorIs(err, authz.ErrPermissionDenied)
+}
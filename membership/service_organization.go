@@ -0,0 +1,133 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/security/identity"
+
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/organization"
+)
+
+// DEV-NOTE: Organizations are born with a single owner, the principal who registered them. The owner is added
+// within the very same transaction as the organization, so creators reach their organization right away and an
+// organization never exists without owners.
+//
+// Deleting an organization keeps its members (restoring it brings them back), purging it removes them
+// (ON DELETE CASCADE).
+
+// OrganizationManager is an [organization.Manager] decorator making the caller registering an organization its
+// owner. Callers restoring an organization without owners (e.g. owners left before the deletion) become its
+// owner as well.
+//
+// Place it within an [organization.TransactionalManager], so owners are added in the same transaction as the
+// organizations they own.
+type OrganizationManager struct {
+	next       organization.Manager
+	config     authz.Config
+	repository Repository
+	manager    Manager
+}
+
+// compile-time assertion
+var _ organization.Manager = (*OrganizationManager)(nil)
+
+// NewOrganizationManager creates a new [OrganizationManager] instance.
+//
+// The member manager must neither authorize operations nor run its own transactions, the caller does not hold
+// permissions on an organization it is still registering.
+func NewOrganizationManager(next organization.Manager, config authz.Config, r Repository,
+	manager Manager) OrganizationManager {
+	return OrganizationManager{next: next, config: config, repository: r, manager: manager}
+}
+
+// Register creates a new [organization.Organization], owned by the caller.
+func (o OrganizationManager) Register(ctx context.Context, args organization.RegisterArguments) (
+	organization.Organization, error) {
+	org, err := o.next.Register(ctx, args)
+	if err != nil {
+		return organization.Organization{}, err
+	} else if err = o.addOwner(ctx, org.ID()); err != nil {
+		return organization.Organization{}, err
+	}
+	return org, nil
+}
+
+// ModifyByID modifies an [organization.Organization] by its unique identifier.
+func (o OrganizationManager) ModifyByID(ctx context.Context, id string, opts ...organization.UpdateOption) (
+	organization.Organization, error) {
+	return o.next.ModifyByID(ctx, id, opts...)
+}
+
+// DeleteByID deletes an [organization.Organization] by its unique identifier, its members are kept.
+func (o OrganizationManager) DeleteByID(ctx context.Context, id string, opts ...organization.DeleteOption) error {
+	return o.next.DeleteByID(ctx, id, opts...)
+}
+
+// RestoreByID restores a deleted [organization.Organization] by its unique identifier. The caller becomes its
+// owner if it has none.
+func (o OrganizationManager) RestoreByID(ctx context.Context, id string) (organization.Organization, error) {
+	org, err := o.next.RestoreByID(ctx, id)
+	if err != nil {
+		return organization.Organization{}, err
+	}
+	owners, err := o.repository.CountByRole(ctx, id, RoleOwner)
+	if err != nil {
+		return organization.Organization{}, err
+	} else if owners > 0 {
+		return org, nil
+	} else if err = o.addOwner(ctx, id); err != nil {
+		return organization.Organization{}, err
+	}
+	return org, nil
+}
+
+// PurgeByID permanently erases an [organization.Organization] by its unique identifier along its members.
+func (o OrganizationManager) PurgeByID(ctx context.Context, id string) error {
+	return o.next.PurgeByID(ctx, id)
+}
+
+// MoveUnder moves an [organization.Organization] by its unique identifier under the organization identified by
+// parentID.
+func (o OrganizationManager) MoveUnder(ctx context.Context, id, parentID string) (organization.Organization,
+	error) {
+	return o.next.MoveUnder(ctx, id, parentID)
+}
+
+// Suspend suspends an active [organization.Organization] by its unique identifier for the given reason.
+func (o OrganizationManager) Suspend(ctx context.Context, id string, reason organization.StatusReason) (
+	organization.Organization, error) {
+	return o.next.Suspend(ctx, id, reason)
+}
+
+// Reactivate reactivates a suspended [organization.Organization] by its unique identifier for the given reason.
+func (o OrganizationManager) Reactivate(ctx context.Context, id string, reason organization.StatusReason) (
+	organization.Organization, error) {
+	return o.next.Reactivate(ctx, id, reason)
+}
+
+// Archive permanently archives an active or suspended [organization.Organization] by its unique identifier for
+// the given reason.
+func (o OrganizationManager) Archive(ctx context.Context, id string, reason organization.StatusReason) (
+	organization.Organization, error) {
+	return o.next.Archive(ctx, id, reason)
+}
+
+// addOwner adds the caller as owner of the organization identified by organizationID.
+//
+// Callers must be authenticated, unless authorization is disabled (e.g. local development) as nobody needs
+// owning organizations then.
+func (o OrganizationManager) addOwner(ctx context.Context, organizationID string) error {
+	principal, ok := identity.GetPrincipal(ctx)
+	if !ok && !o.config.Enabled {
+		return nil
+	} else if !ok {
+		return authz.ErrPermissionDenied
+	}
+	_, err := o.manager.Add(ctx, AddArguments{
+		OrganizationID: organizationID,
+		UserID:         principal.ID(),
+		Role:           RoleOwner,
+	})
+	return err
+}
@@ -0,0 +1,94 @@
+//go:build integration
+
+package membership_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/persistence/postgres/postgrestest"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/outbox"
+)
+
+// DEV-NOTE: These suites use actual infrastructure components (e.g. databases, message brokers, etc., NO MOCKS).
+
+type organizationManagerIntegrationSuite struct {
+	suite.Suite
+
+	baseCtx           context.Context
+	baseCtxCancelFunc context.CancelFunc
+	dbContainer       *postgrestest.Container
+	repository        membership.PostgresRepository
+	manager           organization.Manager
+}
+
+func TestOrganizationManagerIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(organizationManagerIntegrationSuite))
+}
+
+func (s *organizationManagerIntegrationSuite) SetupSuite() {
+	// setup context
+	const testSuiteTimeout = time.Minute
+	s.baseCtx, s.baseCtxCancelFunc = context.WithTimeout(context.Background(), testSuiteTimeout)
+
+	// setup container
+	var err error
+	s.dbContainer, err = postgrestest.NewContainer(s.baseCtx, s.T())
+	s.Require().NoError(err)
+	sqlDB, err := postgrestest.StartContainer(s.baseCtx, s.T(), s.dbContainer, "./thirdparty/postgres/migrations")
+	s.Require().NoError(err)
+	db := gecksql.NewDB(sqlDB)
+
+	// setup the decorator chain of organizationfx and membershipfx, up to the transaction
+	publisher := outbox.NewPostgresPublisher(db, identifier.FactoryKSUID{})
+	orgRepository := organization.NewPostgresRepository(db)
+	tokenConfig, err := paging.NewTokenConfig()
+	s.Require().NoError(err)
+	historyRepository := organization.NewPostgresHistoryRepository(db, tokenConfig)
+	s.repository = membership.NewPostgresRepository(db)
+	var manager organization.Manager = organization.NewLocalManager(organization.HierarchyConfig{MaxDepth: 5},
+		orgRepository, publisher)
+	manager = organization.NewAuditedManager(manager, orgRepository, historyRepository)
+	manager = membership.NewOrganizationManager(manager, authz.Config{Enabled: true}, s.repository,
+		membership.NewLocalManager(s.repository, orgRepository, publisher))
+	s.manager = organization.NewTransactionalManager(manager, sqltx.NewDBRunner(db))
+}
+
+func (s *organizationManagerIntegrationSuite) TearDownSuite() {
+	defer s.baseCtxCancelFunc()
+	shutdownCtx, cancelFunc := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFunc()
+	s.Assert().NoError(s.dbContainer.Instance.Terminate(shutdownCtx))
+}
+
+func (s *organizationManagerIntegrationSuite) TestOrganizationManager_Register() {
+	// arrange
+	ctx := identity.WithPrincipal(s.baseCtx, identity.NewBasicPrincipal("some-user"))
+
+	// act
+	org, err := s.manager.Register(ctx, organization.RegisterArguments{
+		ID:   "1",
+		Name: "foo",
+	})
+
+	// assert
+	s.Require().NoError(err)
+	owner, err := s.repository.FindByKey(s.baseCtx, membership.Key{
+		OrganizationID: org.ID(),
+		UserID:         "some-user",
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(owner)
+	s.Assert().Equal(membership.RoleOwner, owner.Role())
+}
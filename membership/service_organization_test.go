@@ -0,0 +1,126 @@
+package membership_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/membershipmock"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+type organizationManagerSuite struct {
+	suite.Suite
+
+	next          *organizationmock.MockManager
+	repository    *membershipmock.MockRepository
+	memberManager *membershipmock.MockManager
+	manager       membership.OrganizationManager
+	baseCtx       context.Context
+}
+
+func TestOrganizationManagerSuite(t *testing.T) {
+	suite.Run(t, new(organizationManagerSuite))
+}
+
+func (s *organizationManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.next = organizationmock.NewMockManager(ctrl)
+	s.repository = membershipmock.NewMockRepository(ctrl)
+	s.memberManager = membershipmock.NewMockManager(ctrl)
+	s.manager = membership.NewOrganizationManager(s.next, authz.Config{Enabled: true}, s.repository,
+		s.memberManager)
+	s.baseCtx = identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+}
+
+func (s *organizationManagerSuite) TestOrganizationManager_Register() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.next.EXPECT().
+		Register(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(org, error(nil))
+	s.memberManager.EXPECT().
+		Add(s.baseCtx, membership.AddArguments{
+			OrganizationID: "1",
+			UserID:         "some-user",
+			Role:           membership.RoleOwner,
+		}).
+		Times(1).
+		Return(membership.Member{}, error(nil))
+
+	// act
+	out, err := s.manager.Register(s.baseCtx, organization.RegisterArguments{ID: "1", Name: "foo"})
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal("1", out.ID())
+}
+
+func (s *organizationManagerSuite) TestOrganizationManager_Register_Unauthenticated() {
+	// arrange
+	ctx := context.Background()
+	org := organization.New(ctx, "1", "foo")
+	s.next.EXPECT().
+		Register(ctx, gomock.Any()).
+		Times(1).
+		Return(org, error(nil))
+
+	// act
+	_, err := s.manager.Register(ctx, organization.RegisterArguments{ID: "1", Name: "foo"})
+
+	// assert
+	s.Assert().ErrorIs(err, authz.ErrPermissionDenied)
+}
+
+func (s *organizationManagerSuite) TestOrganizationManager_RestoreByID_Owners_Remain() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.next.EXPECT().
+		RestoreByID(s.baseCtx, "1").
+		Times(1).
+		Return(org, error(nil))
+	s.repository.EXPECT().
+		CountByRole(s.baseCtx, "1", membership.RoleOwner).
+		Times(1).
+		Return(1, error(nil))
+
+	// act
+	_, err := s.manager.RestoreByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *organizationManagerSuite) TestOrganizationManager_RestoreByID_No_Owners() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.next.EXPECT().
+		RestoreByID(s.baseCtx, "1").
+		Times(1).
+		Return(org, error(nil))
+	s.repository.EXPECT().
+		CountByRole(s.baseCtx, "1", membership.RoleOwner).
+		Times(1).
+		Return(0, error(nil))
+	s.memberManager.EXPECT().
+		Add(s.baseCtx, membership.AddArguments{
+			OrganizationID: "1",
+			UserID:         "some-user",
+			Role:           membership.RoleOwner,
+		}).
+		Times(1).
+		Return(membership.Member{}, error(nil))
+
+	// act
+	_, err := s.manager.RestoreByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+}
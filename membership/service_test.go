@@ -0,0 +1,259 @@
+package membership_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hadroncorp/geck/eventmock"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/membershipmock"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+type localManagerSuite struct {
+	suite.Suite
+
+	repository     *membershipmock.MockRepository
+	orgRepository  *organizationmock.MockRepository
+	eventPublisher *eventmock.MockPublisher
+	manager        membership.LocalManager
+	baseCtx        context.Context
+}
+
+func TestLocalManagerSuite(t *testing.T) {
+	suite.Run(t, new(localManagerSuite))
+}
+
+func (s *localManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.repository = membershipmock.NewMockRepository(ctrl)
+	s.orgRepository = organizationmock.NewMockRepository(ctrl)
+	s.eventPublisher = eventmock.NewMockPublisher(ctrl)
+	s.manager = membership.NewLocalManager(s.repository, s.orgRepository, s.eventPublisher)
+	s.baseCtx = identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+}
+
+func (s *localManagerSuite) TestLocalManager_Add() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	s.eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	out, err := s.manager.Add(s.baseCtx, membership.AddArguments{
+		OrganizationID: "1",
+		UserID:         "bar",
+		Role:           membership.RoleMember,
+	})
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal("bar", out.UserID())
+	s.Assert().Equal("some-user", out.CreateBy())
+}
+
+func (s *localManagerSuite) TestLocalManager_Add_Organization_Not_Found() {
+	// arrange
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return((*organization.Organization)(nil), error(nil))
+
+	// act
+	_, err := s.manager.Add(s.baseCtx, membership.AddArguments{
+		OrganizationID: "1",
+		UserID:         "bar",
+		Role:           membership.RoleMember,
+	})
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotFound)
+}
+
+func (s *localManagerSuite) TestLocalManager_ChangeRole_Last_Owner() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	owner := membership.New(s.baseCtx, "1", "bar", membership.RoleOwner)
+	key := owner.Key()
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&owner, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		CountByRole(s.baseCtx, "1", membership.RoleOwner).
+		Times(1).
+		Return(1, error(nil))
+
+	// act
+	_, err := s.manager.ChangeRole(s.baseCtx, key, membership.RoleAdmin)
+
+	// assert
+	s.Assert().ErrorIs(err, membership.ErrLastOwner)
+}
+
+func (s *localManagerSuite) TestLocalManager_ChangeRole_Owner_Remains() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	owner := membership.New(s.baseCtx, "1", "bar", membership.RoleOwner)
+	_ = owner.PullEvents()
+	key := owner.Key()
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&owner, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		CountByRole(s.baseCtx, "1", membership.RoleOwner).
+		Times(1).
+		Return(2, error(nil))
+	s.repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	s.eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	out, err := s.manager.ChangeRole(s.baseCtx, key, membership.RoleAdmin)
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal(membership.RoleAdmin, out.Role())
+}
+
+func (s *localManagerSuite) TestLocalManager_Remove_Last_Owner() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	owner := membership.New(s.baseCtx, "1", "bar", membership.RoleOwner)
+	key := owner.Key()
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&owner, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		CountByRole(s.baseCtx, "1", membership.RoleOwner).
+		Times(1).
+		Return(1, error(nil))
+
+	// act
+	err := s.manager.Remove(s.baseCtx, key)
+
+	// assert
+	s.Assert().ErrorIs(err, membership.ErrLastOwner)
+}
+
+func (s *localManagerSuite) TestLocalManager_Remove_Member() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	member := membership.New(s.baseCtx, "1", "bar", membership.RoleMember)
+	_ = member.PullEvents()
+	key := member.Key()
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&member, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		Delete(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	s.eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	err := s.manager.Remove(s.baseCtx, key)
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *localManagerSuite) TestLocalManager_Remove_Not_Found() {
+	// arrange
+	key := membership.Key{OrganizationID: "1", UserID: "bar"}
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return((*membership.Member)(nil), error(nil))
+
+	// act
+	err := s.manager.Remove(s.baseCtx, key)
+
+	// assert
+	s.Assert().NoError(err)
+}
+func (s *localManagerSuite) TestLocalManager_ChangeRole_Organization_Not_Active() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.Require().NoError(org.Suspend(s.baseCtx, organization.ReasonPolicyViolation))
+	member := membership.New(s.baseCtx, "1", "bar", membership.RoleMember)
+	key := member.Key()
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&member, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+
+	// act
+	_, err := s.manager.ChangeRole(s.baseCtx, key, membership.RoleAdmin)
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
+}
+
+func (s *localManagerSuite) TestLocalManager_Remove_Organization_Not_Active() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.Require().NoError(org.Archive(s.baseCtx, organization.ReasonPolicyViolation))
+	member := membership.New(s.baseCtx, "1", "bar", membership.RoleMember)
+	key := member.Key()
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, key).
+		Times(1).
+		Return(&member, error(nil))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+
+	// act
+	err := s.manager.Remove(s.baseCtx, key)
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
+}
@@ -0,0 +1,68 @@
+package membershipfx
+
+import (
+	"github.com/hadroncorp/geck/transportfx/httpfx"
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/authzfx"
+	"github.com/hadroncorp/service-template/membership"
+	"github.com/hadroncorp/service-template/openapifx"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/outboxfx"
+)
+
+var Module = fx.Module("hadron/iam/membership",
+	fx.Provide(
+		fx.Annotate(
+			membership.NewPostgresRepository,
+			fx.As(new(membership.Repository)),
+		),
+		fx.Annotate(
+			membership.NewPostgresReadRepository,
+			fx.As(new(membership.ReadRepository)),
+		),
+		fx.Annotate(
+			membership.NewLocalManager,
+			fx.ParamTags(``, ``, `name:"`+outboxfx.PublisherName+`"`),
+			fx.ResultTags(`name:"membership_local_manager"`),
+			fx.As(new(membership.Manager)),
+		),
+		fx.Annotate(
+			membership.NewTransactionalManager,
+			fx.ParamTags(`name:"membership_local_manager"`),
+			fx.ResultTags(`name:"membership_transactional_manager"`),
+			fx.As(new(membership.Manager)),
+		),
+		fx.Annotate(
+			membership.NewAuthorizedManager,
+			fx.ParamTags(`name:"membership_transactional_manager"`),
+			fx.As(new(membership.Manager)),
+		),
+		fx.Annotate(
+			membership.NewLocalLister,
+			fx.ResultTags(`name:"membership_local_lister"`),
+			fx.As(new(membership.Lister)),
+		),
+		fx.Annotate(
+			membership.NewAuthorizedLister,
+			fx.ParamTags(`name:"membership_local_lister"`),
+			fx.As(new(membership.Lister)),
+		),
+		fx.Annotate(
+			membership.NewRoleResolver,
+			fx.As(new(authz.RoleResolver)),
+		),
+		// owners are added within the transaction of the organization registration (see organizationfx), the
+		// caller holds no permissions on the organization yet
+		fx.Annotate(
+			membership.NewOrganizationManager,
+			fx.ParamTags(`name:"organization_audited_manager"`, ``, ``, `name:"membership_local_manager"`),
+			fx.ResultTags(`name:"organization_owned_manager"`),
+			fx.As(new(organization.Manager)),
+		),
+		authzfx.AsPolicy(membership.DefaultPolicy),
+		httpfx.AsController(membership.NewControllerHTTP),
+		openapifx.AsDescriber(membership.NewControllerHTTP),
+	),
+)
@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: membership/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=membership/repository.go -destination=membershipmock/repository.go -package=membershipmock
+//
+
+// Package membershipmock is a generated GoMock package.
+package membershipmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	paging "github.com/hadroncorp/geck/persistence/paging"
+	membership "github.com/hadroncorp/service-template/membership"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByRole mocks base method.
+func (m *MockRepository) CountByRole(ctx context.Context, organizationID string, role membership.Role) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByRole", ctx, organizationID, role)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByRole indicates an expected call of CountByRole.
+func (mr *MockRepositoryMockRecorder) CountByRole(ctx, organizationID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByRole", reflect.TypeOf((*MockRepository)(nil).CountByRole), ctx, organizationID, role)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, entity membership.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, entity)
+}
+
+// DeleteByKey mocks base method.
+func (m *MockRepository) DeleteByKey(ctx context.Context, key membership.Key) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockRepositoryMockRecorder) DeleteByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockRepository)(nil).DeleteByKey), ctx, key)
+}
+
+// FindByKey mocks base method.
+func (m *MockRepository) FindByKey(ctx context.Context, key membership.Key) (*membership.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", ctx, key)
+	ret0, _ := ret[0].(*membership.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockRepositoryMockRecorder) FindByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockRepository)(nil).FindByKey), ctx, key)
+}
+
+// Save mocks base method.
+func (m *MockRepository) Save(ctx context.Context, entity membership.Member) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, entity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRepositoryMockRecorder) Save(ctx, entity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRepository)(nil).Save), ctx, entity)
+}
+
+// MockReadRepository is a mock of ReadRepository interface.
+type MockReadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReadRepositoryMockRecorder is the mock recorder for MockReadRepository.
+type MockReadRepositoryMockRecorder struct {
+	mock *MockReadRepository
+}
+
+// NewMockReadRepository creates a new mock instance.
+func NewMockReadRepository(ctrl *gomock.Controller) *MockReadRepository {
+	mock := &MockReadRepository{ctrl: ctrl}
+	mock.recorder = &MockReadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadRepository) EXPECT() *MockReadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockReadRepository) FindAll(ctx context.Context, organizationID string, opts ...membership.ListOption) (*paging.Page[membership.Member], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, organizationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAll", varargs...)
+	ret0, _ := ret[0].(*paging.Page[membership.Member])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReadRepositoryMockRecorder) FindAll(ctx, organizationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, organizationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReadRepository)(nil).FindAll), varargs...)
+}
+
+// FindByKey mocks base method.
+func (m *MockReadRepository) FindByKey(ctx context.Context, key membership.Key) (*membership.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", ctx, key)
+	ret0, _ := ret[0].(*membership.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockReadRepositoryMockRecorder) FindByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockReadRepository)(nil).FindByKey), ctx, key)
+}
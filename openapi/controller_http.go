@@ -0,0 +1,53 @@
+package openapi
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	geckhttp "github.com/hadroncorp/geck/transport/http"
+	"github.com/labstack/echo/v4"
+)
+
+// ControllerHTTP is the HTTP controller serving the OpenAPI document of the service.
+type ControllerHTTP struct {
+	config     Config
+	describers []Describer
+	logger     *slog.Logger
+}
+
+// compile-time assertion
+var _ geckhttp.Controller = (*ControllerHTTP)(nil)
+
+// NewControllerHTTP creates a new instance of [ControllerHTTP].
+func NewControllerHTTP(config Config, describers []Describer, logger *slog.Logger) ControllerHTTP {
+	return ControllerHTTP{
+		config:     config,
+		describers: describers,
+		logger:     logger,
+	}
+}
+
+func (c ControllerHTTP) SetEndpoints(e *echo.Echo) {
+	// DEV-NOTE: The document is generated on first request, once every controller registered its routes.
+	loadDocument := sync.OnceValues(func() (*openapi3.T, error) {
+		var operations []Operation
+		for _, describer := range c.describers {
+			operations = append(operations, describer.Operations()...)
+		}
+		return NewDocument(c.config, e.Routes(), operations)
+	})
+	e.GET(DocumentPath, func(ec echo.Context) error {
+		doc, err := loadDocument()
+		if err != nil {
+			c.logger.ErrorContext(ec.Request().Context(), "failed to generate openapi document",
+				slog.String("error", err.Error()))
+			return err
+		}
+		return ec.JSON(http.StatusOK, doc)
+	})
+}
+
+func (c ControllerHTTP) SetVersionedEndpoints(_ *echo.Group) {
+}
@@ -0,0 +1,221 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hadroncorp/service-template/problem"
+)
+
+const (
+	// Version is the version of the OpenAPI specification documents comply with.
+	Version = "3.1.0"
+	// DocumentPath is the path the OpenAPI document of the service is served at.
+	DocumentPath = "/openapi.json"
+
+	// _validationVersion is the version of the OpenAPI specification documents are validated against.
+	_validationVersion = "3.0.3"
+)
+
+// Config is the configuration of generated OpenAPI documents.
+type Config struct {
+	// Title is the title of the API.
+	Title string `env:"APP_NAME" envDefault:"service"`
+	// Version is the version of the API.
+	Version string `env:"APP_VERSION" envDefault:"v0.0.0"`
+	// UndocumentedPaths lists the paths of the routes left out of the document on purpose (e.g. health
+	// checks). The document route is always left out.
+	UndocumentedPaths []string `env:"OPENAPI_UNDOCUMENTED_PATHS"`
+}
+
+// NewConfig creates a new [Config] instance from environment variables.
+func NewConfig() (Config, error) {
+	return env.ParseAs[Config]()
+}
+
+// _pathParamRegexp matches echo path parameters (e.g. `:organization_id`), skipping escaped colons.
+var _pathParamRegexp = regexp.MustCompile(`(^|/):([A-Za-z0-9_]+)`)
+
+// NewDocument generates an OpenAPI document from the given registered routes and the operations
+// described for them.
+//
+// It returns an error if an operation has no matching route, or if a route has no matching operation and
+// its path is not listed in [Config.UndocumentedPaths].
+func NewDocument(config Config, routes []*echo.Route, operations []Operation) (*openapi3.T, error) {
+	routesByHandler := make(map[string]*echo.Route, len(routes))
+	for _, route := range routes {
+		if !isUndocumented(config, route) {
+			routesByHandler[route.Method+" "+route.Name] = route
+		}
+	}
+	documented := make(map[*echo.Route]struct{}, len(routes))
+
+	doc := &openapi3.T{
+		OpenAPI: Version,
+		Info: &openapi3.Info{
+			Title:   config.Title,
+			Version: config.Version,
+		},
+		Paths: openapi3.NewPaths(),
+	}
+	for _, op := range operations {
+		route, ok := routesByHandler[op.Method+" "+handlerName(op.Handler)]
+		if !ok {
+			return nil, fmt.Errorf("openapi: no route registered for operation %s", op.ID)
+		}
+		documented[route] = struct{}{}
+
+		path := _pathParamRegexp.ReplaceAllString(route.Path, "$1{$2}")
+		path = strings.ReplaceAll(path, `\:`, ":")
+		if op.CustomMethod != "" {
+			path += ":" + op.CustomMethod
+		}
+		item := doc.Paths.Value(path)
+		if item == nil {
+			item = &openapi3.PathItem{}
+			doc.Paths.Set(path, item)
+		}
+		item.SetOperation(op.Method, newOperation(route, op))
+	}
+	for _, route := range routes {
+		if _, ok := documented[route]; !ok && !isUndocumented(config, route) {
+			return nil, fmt.Errorf("openapi: no operation described for route %s %s", route.Method, route.Path)
+		}
+	}
+
+	if err := validate(doc); err != nil {
+		return nil, fmt.Errorf("openapi: invalid document: %w", err)
+	}
+	return doc, nil
+}
+
+// validate checks whether doc complies with the OpenAPI specification.
+//
+// DEV-NOTE: kin-openapi validates OpenAPI 3.0 documents only, so doc is validated as its OpenAPI 3.0 equivalent,
+// where values which might be null use the `nullable` keyword instead of the `null` type.
+func validate(doc *openapi3.T) error {
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	var tree map[string]any
+	if err = json.Unmarshal(raw, &tree); err != nil {
+		return err
+	}
+	tree["openapi"] = _validationVersion
+	replaceNullTypes(tree)
+	if raw, err = json.Marshal(tree); err != nil {
+		return err
+	}
+	equivalent, err := openapi3.NewLoader().LoadFromData(raw)
+	if err != nil {
+		return err
+	}
+	return equivalent.Validate(context.Background())
+}
+
+// replaceNullTypes replaces the `null` types found within node by the `nullable` keyword.
+func replaceNullTypes(node any) {
+	switch node := node.(type) {
+	case map[string]any:
+		if types, ok := node["type"].([]any); ok && slices.Contains(types, any(openapi3.TypeNull)) {
+			types = slices.DeleteFunc(types, func(t any) bool {
+				return t == openapi3.TypeNull
+			})
+			node["nullable"] = true
+			if len(types) == 1 {
+				node["type"] = types[0]
+			} else {
+				// OpenAPI 3.0 has no equivalent, left to the validation to reject
+				node["type"] = types
+			}
+		}
+		for _, child := range node {
+			replaceNullTypes(child)
+		}
+	case []any:
+		for _, child := range node {
+			replaceNullTypes(child)
+		}
+	}
+}
+
+// isUndocumented checks whether route is left out of the document on purpose.
+func isUndocumented(config Config, route *echo.Route) bool {
+	// DEV-NOTE: Groups with middlewares register catch-all routes answering 404 (Not Found), they are no
+	// operations.
+	return route.Method == echo.RouteNotFound || route.Path == DocumentPath ||
+		slices.Contains(config.UndocumentedPaths, route.Path)
+}
+
+func newOperation(route *echo.Route, op Operation) *openapi3.Operation {
+	operation := openapi3.NewOperation()
+	operation.OperationID = op.ID
+	operation.Summary = op.Summary
+	operation.Tags = op.Tags
+
+	for _, match := range _pathParamRegexp.FindAllStringSubmatch(route.Path, -1) {
+		param := openapi3.NewPathParameter(match[2]).WithSchema(openapi3.NewStringSchema())
+		operation.AddParameter(param)
+	}
+	for _, p := range op.Parameters {
+		param := &openapi3.Parameter{
+			Name:        p.Name,
+			In:          p.In,
+			Description: p.Description,
+			Required:    p.Required,
+			Schema:      openapi3.NewSchemaRef("", openapi3.NewStringSchema()),
+		}
+		if p.Type != nil {
+			param.Schema = openapi3.NewSchemaRef("", newSchema(reflect.TypeOf(p.Type)))
+		}
+		operation.AddParameter(param)
+	}
+
+	if op.RequestBody != nil {
+		operation.RequestBody = &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithRequired(true).
+				WithJSONSchema(NewSchema(op.RequestBody)),
+		}
+	}
+
+	operation.Responses = openapi3.NewResponses(
+		openapi3.WithName("default", openapi3.NewResponse().
+			WithDescription("Error, described as problem details (RFC 7807).").
+			WithContent(openapi3.NewContentWithSchema(NewSchema(problem.Details{}),
+				[]string{problem.MIMEApplicationProblemJSON})),
+		),
+	)
+	for _, res := range op.Responses {
+		response := openapi3.NewResponse().WithDescription(res.Description)
+		if res.Body != nil {
+			response = response.WithJSONSchema(NewSchema(res.Body))
+		}
+		if len(res.Headers) > 0 {
+			response.Headers = make(openapi3.Headers, len(res.Headers))
+			for _, header := range res.Headers {
+				response.Headers[header] = &openapi3.HeaderRef{
+					Value: &openapi3.Header{Parameter: openapi3.Parameter{
+						Schema: openapi3.NewSchemaRef("", openapi3.NewStringSchema()),
+					}},
+				}
+			}
+		}
+		operation.AddResponse(res.StatusCode, response)
+	}
+	if len(op.Responses) == 0 {
+		operation.AddResponse(http.StatusNoContent, openapi3.NewResponse().WithDescription("No content."))
+	}
+	return operation
+}
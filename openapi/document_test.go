@@ -0,0 +1,71 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hadroncorp/service-template/openapi"
+)
+
+func getFoo(e echo.Context) error {
+	return e.NoContent(http.StatusOK)
+}
+
+func getBar(e echo.Context) error {
+	return e.NoContent(http.StatusOK)
+}
+
+func TestNewDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		inConfig openapi.Config
+		expErr   bool
+	}{
+		{
+			name:     "undocumented route",
+			inConfig: openapi.Config{Title: "test", Version: "v1"},
+			expErr:   true,
+		},
+		{
+			name:     "undocumented route on purpose",
+			inConfig: openapi.Config{Title: "test", Version: "v1", UndocumentedPaths: []string{"/bar"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			e := echo.New()
+			g := e.Group("", func(next echo.HandlerFunc) echo.HandlerFunc {
+				return next
+			})
+			g.GET("/foo", getFoo)
+			g.GET("/bar", getBar)
+			e.GET(openapi.DocumentPath, getFoo)
+			operations := []openapi.Operation{
+				{ID: "GetFoo", Method: http.MethodGet, Handler: getFoo, RequestBody: fooRequest{}},
+			}
+
+			// act
+			doc, err := openapi.NewDocument(tt.inConfig, e.Routes(), operations)
+
+			// assert
+			if tt.expErr {
+				assert.ErrorContains(t, err, "GET /bar")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "3.1.0", doc.OpenAPI)
+			raw, err := json.Marshal(doc)
+			require.NoError(t, err)
+			assert.Contains(t, string(raw), `"nickname":{"minLength":2,"type":["string","null"]}`)
+			assert.NotContains(t, string(raw), "nullable")
+			assert.NotNil(t, doc.Paths.Value("/foo"))
+			assert.Nil(t, doc.Paths.Value("/bar"))
+		})
+	}
+}
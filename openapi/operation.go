@@ -0,0 +1,63 @@
+package openapi
+
+import (
+	"reflect"
+	"runtime"
+
+	"github.com/labstack/echo/v4"
+)
+
+// A Describer describes the HTTP operations it exposes, so they get documented.
+type Describer interface {
+	// Operations returns the description of the exposed HTTP operations.
+	Operations() []Operation
+}
+
+// Operation describes an HTTP operation.
+//
+// Method and path are not part of the description, they are taken from the route registered with Handler.
+type Operation struct {
+	// ID is the unique identifier of the operation (e.g. `CreateOrganization`).
+	ID string
+	// Method is the HTTP method of the route.
+	Method string
+	// Handler is the handler of the route.
+	Handler echo.HandlerFunc
+	// CustomMethod is the AIP-136 custom method name (e.g. `undelete`) dispatched by Handler, appended to
+	// the route path.
+	CustomMethod string
+	Summary      string
+	Tags         []string
+	// Parameters lists the query and header parameters of the operation. Path parameters are taken from
+	// the route path.
+	Parameters []Parameter
+	// RequestBody is a value of the JSON request body type, nil if the operation has no body.
+	RequestBody any
+	Responses   []Response
+}
+
+// Parameter describes a query or header parameter.
+type Parameter struct {
+	Name string
+	// In is the location of the parameter, either `query` or `header`.
+	In          string
+	Description string
+	Required    bool
+	// Type is a value of the parameter type, string is used if nil.
+	Type any
+}
+
+// Response describes a successful response. Error responses are documented as problem details.
+type Response struct {
+	StatusCode  int
+	Description string
+	// Body is a value of the JSON response body type, nil if the response has no body.
+	Body any
+	// Headers lists the response headers (e.g. ETag).
+	Headers []string
+}
+
+// handlerName returns the name of h, as given by echo to routes.
+func handlerName(h echo.HandlerFunc) string {
+	return runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name()
+}
@@ -0,0 +1,153 @@
+package openapi
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// DEV-NOTE: Schemas are generated from `json` and `validate` struct tags. A field is required if its
+// validation rules say so or, for fields without validation rules, if it is always serialized
+// (i.e. neither a pointer nor tagged as omitempty). Schemas follow OpenAPI 3.1, values which might be null list
+// `null` among their types instead of using the `nullable` keyword of OpenAPI 3.0.
+
+var _timeType = reflect.TypeOf(time.Time{})
+
+// NewSchema generates the JSON schema of the type of v.
+func NewSchema(v any) *openapi3.Schema {
+	return newSchema(reflect.TypeOf(v))
+}
+
+func newSchema(t reflect.Type) *openapi3.Schema {
+	switch {
+	case t.Kind() == reflect.Pointer:
+		// nil pointers are written as null, and null is read as nil
+		return newNullableSchema(newSchema(t.Elem()))
+	case t == _timeType:
+		return openapi3.NewDateTimeSchema()
+	case t.Kind() == reflect.String:
+		return openapi3.NewStringSchema()
+	case t.Kind() == reflect.Bool:
+		return openapi3.NewBoolSchema()
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		return openapi3.NewIntegerSchema()
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		return openapi3.NewFloat64Schema()
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return openapi3.NewBytesSchema()
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		return openapi3.NewArraySchema().WithItems(newSchema(t.Elem()))
+	case t.Kind() == reflect.Map:
+		return openapi3.NewObjectSchema().WithAdditionalProperties(newSchema(t.Elem()))
+	case t.Kind() == reflect.Struct:
+		return newStructSchema(t)
+	default:
+		return &openapi3.Schema{}
+	}
+}
+
+// newNullableSchema lists `null` among the types of schema.
+func newNullableSchema(schema *openapi3.Schema) *openapi3.Schema {
+	if schema.Type == nil || schema.Type.Includes(openapi3.TypeNull) {
+		// untyped schemas accept null already
+		return schema
+	}
+	types := openapi3.Types(append(slices.Clone(schema.Type.Slice()), openapi3.TypeNull))
+	schema.Type = &types
+	return schema
+}
+
+func newStructSchema(t reflect.Type) *openapi3.Schema {
+	schema := openapi3.NewObjectSchema().WithoutAdditionalProperties()
+	schema.Properties = make(openapi3.Schemas)
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		} else if name == "" {
+			name = field.Name
+		}
+
+		fieldSchema := newSchema(field.Type)
+		rules, hasRules := field.Tag.Lookup("validate")
+		isRequired := applyValidationRules(fieldSchema, rules)
+		if !hasRules {
+			isRequired = field.Type.Kind() != reflect.Pointer && !strings.Contains(opts, "omitempty")
+		}
+		schema.Properties[name] = openapi3.NewSchemaRef("", fieldSchema)
+		if isRequired {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// applyValidationRules sets the constraints of the given validator rules (e.g. `required,lte=48`) into
+// schema. It returns true if the rules make the field required.
+func applyValidationRules(schema *openapi3.Schema, rules string) bool {
+	isRequired := false
+	for _, rule := range strings.Split(rules, ",") {
+		tag, param, _ := strings.Cut(rule, "=")
+		switch tag {
+		case "required":
+			isRequired = true
+		case "lte", "max":
+			setMax(schema, param)
+		case "gte", "min":
+			setMin(schema, param)
+		case "oneof":
+			for _, value := range strings.Fields(param) {
+				schema.Enum = append(schema.Enum, value)
+			}
+			if schema.Type.Includes(openapi3.TypeNull) {
+				schema.Enum = append(schema.Enum, nil)
+			}
+		case "dive":
+			// rules apply to items from here on
+			return isRequired
+		}
+	}
+	return isRequired
+}
+
+func setMax(schema *openapi3.Schema, param string) {
+	switch {
+	case schema.Type.Includes(openapi3.TypeString):
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil {
+			schema.MaxLength = &n
+		}
+	case schema.Type.Includes(openapi3.TypeArray):
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil {
+			schema.MaxItems = &n
+		}
+	case schema.Type.Includes(openapi3.TypeInteger) || schema.Type.Includes(openapi3.TypeNumber):
+		if n, err := strconv.ParseFloat(param, 64); err == nil {
+			schema.Max = &n
+		}
+	}
+}
+
+func setMin(schema *openapi3.Schema, param string) {
+	switch {
+	case schema.Type.Includes(openapi3.TypeString):
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil {
+			schema.MinLength = n
+		}
+	case schema.Type.Includes(openapi3.TypeArray):
+		if n, err := strconv.ParseUint(param, 10, 64); err == nil {
+			schema.MinItems = n
+		}
+	case schema.Type.Includes(openapi3.TypeInteger) || schema.Type.Includes(openapi3.TypeNumber):
+		if n, err := strconv.ParseFloat(param, 64); err == nil {
+			schema.Min = &n
+		}
+	}
+}
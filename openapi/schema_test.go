@@ -0,0 +1,54 @@
+package openapi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hadroncorp/service-template/openapi"
+)
+
+type fooRequest struct {
+	Name       string    `json:"name" validate:"required,lte=48"`
+	Nickname   *string   `json:"nickname" validate:"omitempty,gte=2"`
+	Kind       string    `json:"kind" validate:"oneof=personal business"`
+	Tags       []string  `json:"tags,omitempty" validate:"max=5,dive,lte=16"`
+	Size       int       `json:"size" validate:"min=1,max=100"`
+	CreateTime time.Time `json:"create_time"`
+	Internal   string    `json:"-"`
+	unexported string
+}
+
+func TestNewSchema(t *testing.T) {
+	// act
+	schema := openapi.NewSchema(fooRequest{})
+
+	// assert
+	assert.True(t, schema.Type.Is(openapi3.TypeObject))
+	assert.ElementsMatch(t, []string{"name", "create_time"}, schema.Required)
+	assert.Len(t, schema.Properties, 6)
+
+	name := schema.Properties["name"].Value
+	assert.True(t, name.Type.Is(openapi3.TypeString))
+	assert.Equal(t, uint64(48), *name.MaxLength)
+
+	nickname := schema.Properties["nickname"].Value
+	assert.Equal(t, []string{openapi3.TypeString, openapi3.TypeNull}, nickname.Type.Slice())
+	assert.Equal(t, uint64(2), nickname.MinLength)
+
+	assert.Equal(t, []any{"personal", "business"}, schema.Properties["kind"].Value.Enum)
+
+	tags := schema.Properties["tags"].Value
+	assert.True(t, tags.Type.Is(openapi3.TypeArray))
+	assert.Equal(t, uint64(5), *tags.MaxItems)
+
+	size := schema.Properties["size"].Value
+	assert.True(t, size.Type.Is(openapi3.TypeInteger))
+	assert.Equal(t, 1.0, *size.Min)
+	assert.Equal(t, 100.0, *size.Max)
+
+	assert.Equal(t, "date-time", schema.Properties["create_time"].Value.Format)
+	assert.False(t, *schema.AdditionalProperties.Has)
+}
@@ -0,0 +1,33 @@
+package openapifx
+
+import (
+	"github.com/hadroncorp/geck/transportfx/httpfx"
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/openapi"
+)
+
+// _describerGroup is the value group holding every [openapi.Describer] in the dependency graph.
+const _describerGroup = "openapi_describers"
+
+var Module = fx.Module("hadron/iam/openapi",
+	fx.Provide(
+		openapi.NewConfig,
+		httpfx.AsController(
+			fx.Annotate(
+				openapi.NewControllerHTTP,
+				fx.ParamTags(``, `group:"`+_describerGroup+`"`),
+			),
+		),
+	),
+)
+
+// AsDescriber annotates the given constructor so its result documents its operations in the OpenAPI
+// document of the service.
+func AsDescriber(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(openapi.Describer)),
+		fx.ResultTags(`group:"`+_describerGroup+`"`),
+	)
+}
@@ -0,0 +1,106 @@
+package organization
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+
+	"event-schema-registry/iampb"
+)
+
+// _cacheEvents are the organization events invalidating the cached copy of the organization, by topic.
+var _cacheEvents = map[string]func() cacheEvent{
+	TopicUpdated.String():     func() cacheEvent { return &iampb.OrganizationUpdatedEvent{} },
+	TopicDeleted.String():     func() cacheEvent { return &iampb.OrganizationDeletedEvent{} },
+	TopicRestored.String():    func() cacheEvent { return &iampb.OrganizationRestoredEvent{} },
+	TopicMoved.String():       func() cacheEvent { return &iampb.OrganizationMovedEvent{} },
+	TopicSuspended.String():   func() cacheEvent { return &iampb.OrganizationSuspendedEvent{} },
+	TopicReactivated.String(): func() cacheEvent { return &iampb.OrganizationReactivatedEvent{} },
+	TopicArchived.String():    func() cacheEvent { return &iampb.OrganizationArchivedEvent{} },
+}
+
+// cacheEvent is an organization event invalidating the cached copy of the organization.
+type cacheEvent interface {
+	proto.Message
+	GetOrganizationId() string
+}
+
+// CacheReaderKafka is the Apache Kafka reader evicting organizations from the [CachedFetcher] once other instances
+// modify them.
+//
+// Every instance holds its own cache, thus every instance must read every event. The reader consumes every
+// partition directly (i.e. without consumer group) from the latest offset, so instances do not leave consumer
+// groups behind once they are gone. Events sent before the instance started are skipped, as the cache starts empty.
+type CacheReaderKafka struct {
+	client *kgo.Client
+	cache  CachedFetcher
+	logger *slog.Logger
+}
+
+// NewCacheReaderKafka creates a new [CacheReaderKafka] instance.
+//
+// Call [CacheReaderKafka.Close] to release the underlying client.
+func NewCacheReaderKafka(config CacheConfig, cache CachedFetcher, logger *slog.Logger) (CacheReaderKafka, error) {
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(config.SeedBrokers...),
+		kgo.ConsumeTopics(slices.Sorted(maps.Keys(_cacheEvents))...),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtEnd()),
+	)
+	if err != nil {
+		return CacheReaderKafka{}, err
+	}
+	return CacheReaderKafka{
+		client: client,
+		cache:  cache,
+		logger: logger,
+	}, nil
+}
+
+// Run reads organization events until ctx is done or the reader is closed.
+func (r CacheReaderKafka) Run(ctx context.Context) {
+	for {
+		fetches := r.client.PollFetches(ctx)
+		if fetches.IsClientClosed() || ctx.Err() != nil {
+			return
+		}
+		fetches.EachError(func(topic string, partition int32, err error) {
+			r.logger.ErrorContext(ctx, "failed to fetch organization events",
+				slog.String("topic", topic),
+				slog.Int("partition", int(partition)),
+				slog.String("error", err.Error()),
+			)
+		})
+		fetches.EachRecord(func(record *kgo.Record) {
+			if err := r.Read(ctx, record); err != nil {
+				r.logger.ErrorContext(ctx, "failed to read organization event",
+					slog.String("topic", record.Topic),
+					slog.Int64("offset", record.Offset),
+					slog.String("error", err.Error()),
+				)
+			}
+		})
+	}
+}
+
+// Read evicts the organization referenced by the event of record from the [CachedFetcher].
+func (r CacheReaderKafka) Read(ctx context.Context, record *kgo.Record) error {
+	newEvent, ok := _cacheEvents[record.Topic]
+	if !ok {
+		return nil
+	}
+	ev := newEvent()
+	if err := proto.Unmarshal(record.Value, ev); err != nil {
+		return err
+	}
+	r.cache.Invalidate(ctx, ev.GetOrganizationId())
+	return nil
+}
+
+// Close closes the underlying client, stopping [CacheReaderKafka.Run].
+func (r CacheReaderKafka) Close() {
+	r.client.Close()
+}
@@ -0,0 +1,109 @@
+package organization_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+
+	"event-schema-registry/iampb"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+type cacheReaderKafkaSuite struct {
+	suite.Suite
+}
+
+func TestCacheReaderKafkaSuite(t *testing.T) {
+	suite.Run(t, new(cacheReaderKafkaSuite))
+}
+
+func (s *cacheReaderKafkaSuite) TestCacheReaderKafka_Read() {
+	tests := []struct {
+		name        string
+		inTopic     string
+		inValue     []byte
+		expErr      bool
+		expFetchers int // number of times the organization is fetched from next
+	}{
+		{
+			name:        "updated",
+			inTopic:     organization.TopicUpdated.String(),
+			inValue:     s.marshal(&iampb.OrganizationUpdatedEvent{OrganizationId: "1"}),
+			expFetchers: 2,
+		},
+		{
+			name:        "moved",
+			inTopic:     organization.TopicMoved.String(),
+			inValue:     s.marshal(&iampb.OrganizationMovedEvent{OrganizationId: "1"}),
+			expFetchers: 2,
+		},
+		{
+			name:        "other organization",
+			inTopic:     organization.TopicUpdated.String(),
+			inValue:     s.marshal(&iampb.OrganizationUpdatedEvent{OrganizationId: "2"}),
+			expFetchers: 1,
+		},
+		{
+			name:        "unknown topic",
+			inTopic:     organization.TopicCreated.String(),
+			inValue:     s.marshal(&iampb.OrganizationCreatedEvent{OrganizationId: "1"}),
+			expFetchers: 1,
+		},
+		{
+			name:        "invalid event",
+			inTopic:     organization.TopicUpdated.String(),
+			inValue:     []byte{0xff},
+			expErr:      true,
+			expFetchers: 1,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			next := organizationmock.NewMockFetcher(gomock.NewController(s.T()))
+			config := organization.CacheConfig{
+				Size:        10,
+				TTL:         time.Minute,
+				NegativeTTL: time.Minute,
+				SeedBrokers: []string{"localhost:9092"},
+			}
+			cache, err := organization.NewCachedFetcher(config, next)
+			s.Require().NoError(err)
+			reader, err := organization.NewCacheReaderKafka(config, cache, slog.Default())
+			s.Require().NoError(err)
+			defer reader.Close()
+			ctx := context.Background()
+			next.EXPECT().
+				GetByID(gomock.Any(), "1").
+				Times(tt.expFetchers).
+				Return(organization.New(ctx, "1", "foo"), error(nil))
+			_, err = cache.GetByID(ctx, "1")
+			s.Require().NoError(err)
+
+			// act
+			err = reader.Read(ctx, &kgo.Record{Topic: tt.inTopic, Value: tt.inValue})
+
+			// assert
+			if tt.expErr {
+				s.Assert().Error(err)
+			} else {
+				s.Assert().NoError(err)
+			}
+			_, err = cache.GetByID(ctx, "1")
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *cacheReaderKafkaSuite) marshal(ev proto.Message) []byte {
+	value, err := proto.Marshal(ev)
+	s.Require().NoError(err)
+	return value
+}
@@ -0,0 +1,392 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/validation"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"event-schema-registry/iampb"
+	"github.com/hadroncorp/service-template/grpcserver"
+)
+
+// ControllerGRPC is the gRPC controller exposing the organization service ([iampb.OrganizationServiceServer]).
+//
+// Errors returned by application services are translated into gRPC status codes by the server
+// (see [grpcserver.NewStatusError]).
+type ControllerGRPC struct {
+	iampb.UnimplementedOrganizationServiceServer
+
+	manager   Manager
+	fetcher   Fetcher
+	lister    Lister
+	idFactory identifier.Factory
+	validator validation.Validator
+	logger    *slog.Logger
+}
+
+// compile-time assertion
+var (
+	_ grpcserver.Controller           = (*ControllerGRPC)(nil)
+	_ iampb.OrganizationServiceServer = (*ControllerGRPC)(nil)
+)
+
+// NewControllerGRPC creates a new instance of [ControllerGRPC].
+func NewControllerGRPC(manager Manager, fetcher Fetcher, lister Lister, idFactory identifier.Factory,
+	validator validation.Validator, logger *slog.Logger) ControllerGRPC {
+	return ControllerGRPC{
+		manager:   manager,
+		fetcher:   fetcher,
+		lister:    lister,
+		idFactory: idFactory,
+		validator: validator,
+		logger:    logger,
+	}
+}
+
+func (c ControllerGRPC) RegisterServices(s grpc.ServiceRegistrar) {
+	iampb.RegisterOrganizationServiceServer(s, c)
+}
+
+func (c ControllerGRPC) CreateOrganization(ctx context.Context, req *iampb.CreateOrganizationRequest) (
+	*iampb.Organization, error) {
+	body := createRequestGRPC{
+		Name:        req.GetName(),
+		ParentID:    req.GetParentId(),
+		Description: req.GetDescription(),
+		WebsiteURL:  req.GetWebsiteUrl(),
+		LogoURL:     req.GetLogoUrl(),
+		Country:     req.GetCountry(),
+		Locale:      req.GetLocale(),
+		TimeZone:    req.GetTimeZone(),
+		LegalID:     req.GetLegalId(),
+		TaxID:       req.GetTaxId(),
+	}
+	if err := c.validator.Validate(ctx, body); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := c.idFactory.NewID()
+	if err != nil {
+		return nil, err
+	}
+	org, err := c.manager.Register(ctx, RegisterArguments{
+		ID:       id,
+		Name:     body.Name,
+		ParentID: body.ParentID,
+		Profile: Profile{
+			Description: body.Description,
+			WebsiteURL:  body.WebsiteURL,
+			LogoURL:     body.LogoURL,
+			Country:     body.Country,
+			Locale:      body.Locale,
+			TimeZone:    body.TimeZone,
+			LegalID:     body.LegalID,
+			TaxID:       body.TaxID,
+		},
+		Labels: req.GetLabels(),
+	})
+	if errors.Is(err, ErrInvalidProfile) || errors.Is(err, ErrInvalidLabels) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, newStatusErrorGRPC(err)
+	}
+	return newOrganizationProto(org), nil
+}
+
+func (c ControllerGRPC) GetOrganization(ctx context.Context, req *iampb.GetOrganizationRequest) (
+	*iampb.Organization, error) {
+	var opts []FetchOption
+	if req.GetShowDeleted() {
+		opts = append(opts, WithFetchDeleted())
+	}
+
+	org, err := c.fetcher.GetByID(ctx, req.GetOrganizationId(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newOrganizationProto(org), nil
+}
+
+func (c ControllerGRPC) ListOrganizations(ctx context.Context, req *iampb.ListOrganizationsRequest) (
+	*iampb.ListOrganizationsResponse, error) {
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	var pageOpts []paging.Option
+	if req.GetPageSize() > 0 {
+		pageOpts = append(pageOpts, paging.WithLimit(int(req.GetPageSize())))
+	}
+	if req.GetPageToken() != "" {
+		pageOpts = append(pageOpts, paging.WithPageToken(req.GetPageToken()))
+	}
+	opts := []ListOption{
+		WithListPageOptions(pageOpts...),
+	}
+	if !req.GetShowDeleted() {
+		opts = append(opts, WithListNonDeletedOnly())
+	}
+	filterOpts, err := newListFilterOptions(req.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	opts = append(opts, filterOpts...)
+	if req.GetLabelSelector() != "" {
+		selector, errSelector := ParseLabelSelector(req.GetLabelSelector())
+		if errSelector != nil {
+			return nil, status.Error(codes.InvalidArgument, errSelector.Error())
+		}
+		opts = append(opts, WithListLabelSelector(selector))
+	}
+	if req.GetOrderBy() != "" {
+		sortOpt, errSort := newListSortOption(req.GetOrderBy())
+		if errSort != nil {
+			return nil, status.Error(codes.InvalidArgument, errSort.Error())
+		}
+		opts = append(opts, sortOpt)
+	}
+
+	page, err := c.lister.List(ctx, opts...)
+	if errors.Is(err, ErrPageTokenMismatch) {
+		return nil, status.Error(codes.InvalidArgument,
+			"filter, label_selector, order_by and show_deleted must not change between pages")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &iampb.ListOrganizationsResponse{
+		Organizations: lo.Map(page.Items, func(o Organization, _ int) *iampb.Organization {
+			return newOrganizationProto(o)
+		}),
+		NextPageToken:     page.NextPageToken,
+		PreviousPageToken: page.PreviousPageToken,
+		TotalSize:         int32(page.TotalItems),
+	}, nil
+}
+
+func (c ControllerGRPC) UpdateOrganization(ctx context.Context, req *iampb.UpdateOrganizationRequest) (
+	*iampb.Organization, error) {
+	body := updateRequestGRPC{
+		Name:        req.Name,
+		Slug:        req.Slug,
+		Description: req.Description,
+		WebsiteURL:  req.WebsiteUrl,
+		LogoURL:     req.LogoUrl,
+		Country:     req.Country,
+		Locale:      req.Locale,
+		TimeZone:    req.TimeZone,
+		LegalID:     req.LegalId,
+		TaxID:       req.TaxId,
+	}
+	if err := c.validator.Validate(ctx, body); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opts := []UpdateOption{
+		WithUpdatedName(body.Name),
+		WithUpdatedSlug(body.Slug),
+		WithUpdatedDescription(body.Description),
+		WithUpdatedWebsiteURL(body.WebsiteURL),
+		WithUpdatedLogoURL(body.LogoURL),
+		WithUpdatedCountry(body.Country),
+		WithUpdatedLocale(body.Locale),
+		WithUpdatedTimeZone(body.TimeZone),
+		WithUpdatedLegalID(body.LegalID),
+		WithUpdatedTaxID(body.TaxID),
+		WithUpdatedLabels(newLabelPatchGRPC(req.GetLabels(), req.GetRemoveLabels())),
+	}
+	expectedVersion, err := parseETagGRPC(req.GetEtag())
+	if err != nil {
+		return nil, err
+	} else if expectedVersion != nil {
+		opts = append(opts, WithExpectedVersion(*expectedVersion))
+	}
+
+	org, err := c.manager.ModifyByID(ctx, req.GetOrganizationId(), opts...)
+	if errors.Is(err, ErrInvalidSlug) || errors.Is(err, ErrInvalidProfile) || errors.Is(err, ErrInvalidLabels) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, newStatusErrorGRPC(err)
+	}
+	return newOrganizationProto(org), nil
+}
+
+func (c ControllerGRPC) DeleteOrganization(ctx context.Context, req *iampb.DeleteOrganizationRequest) (
+	*emptypb.Empty, error) {
+	var opts []DeleteOption
+	expectedVersion, err := parseETagGRPC(req.GetEtag())
+	if err != nil {
+		return nil, err
+	} else if expectedVersion != nil {
+		opts = append(opts, WithDeleteExpectedVersion(*expectedVersion))
+	}
+
+	if err = c.manager.DeleteByID(ctx, req.GetOrganizationId(), opts...); err != nil {
+		return nil, newStatusErrorGRPC(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (c ControllerGRPC) UndeleteOrganization(ctx context.Context, req *iampb.UndeleteOrganizationRequest) (
+	*iampb.Organization, error) {
+	org, err := c.manager.RestoreByID(ctx, req.GetOrganizationId())
+	if err != nil {
+		return nil, newStatusErrorGRPC(err)
+	}
+	return newOrganizationProto(org), nil
+}
+
+func (c ControllerGRPC) MoveOrganization(ctx context.Context, req *iampb.MoveOrganizationRequest) (
+	*iampb.Organization, error) {
+	if err := c.validator.Validate(ctx, moveRequestGRPC{ParentID: req.GetParentId()}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	org, err := c.manager.MoveUnder(ctx, req.GetOrganizationId(), req.GetParentId())
+	if err != nil {
+		return nil, newStatusErrorGRPC(err)
+	}
+	return newOrganizationProto(org), nil
+}
+
+func (c ControllerGRPC) SuspendOrganization(ctx context.Context, req *iampb.TransitionOrganizationRequest) (
+	*iampb.Organization, error) {
+	return c.transition(ctx, req, c.manager.Suspend)
+}
+
+func (c ControllerGRPC) ReactivateOrganization(ctx context.Context, req *iampb.TransitionOrganizationRequest) (
+	*iampb.Organization, error) {
+	return c.transition(ctx, req, c.manager.Reactivate)
+}
+
+func (c ControllerGRPC) ArchiveOrganization(ctx context.Context, req *iampb.TransitionOrganizationRequest) (
+	*iampb.Organization, error) {
+	return c.transition(ctx, req, c.manager.Archive)
+}
+
+// transition handles the status transitions (suspend, reactivate and archive) using the given [Manager]
+// operation.
+func (c ControllerGRPC) transition(ctx context.Context, req *iampb.TransitionOrganizationRequest,
+	apply func(ctx context.Context, id string, reason StatusReason) (Organization, error)) (*iampb.Organization, error) {
+	if err := c.validator.Validate(ctx, transitionRequestGRPC{Reason: req.GetReason()}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	org, err := apply(ctx, req.GetOrganizationId(), StatusReason(req.GetReason()))
+	if errors.Is(err, ErrInvalidStatusReason) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported reason %q", req.GetReason())
+	} else if err != nil {
+		return nil, newStatusErrorGRPC(err)
+	}
+	return newOrganizationProto(org), nil
+}
+
+// newStatusErrorGRPC translates [ErrVersionConflict] into an Aborted status error (AIP-154), as clients may read
+// the organization again and retry. Other errors are translated by [grpcserver.NewStatusError].
+func newStatusErrorGRPC(err error) error {
+	if errors.Is(err, ErrVersionConflict) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
+
+// newLabelPatchGRPC returns the label patch (see [WithUpdatedLabels]) setting the labels of set and removing
+// the labels keyed by remove.
+func newLabelPatchGRPC(set map[string]string, remove []string) map[string]*string {
+	if len(set) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	patch := make(map[string]*string, len(set)+len(remove))
+	for _, key := range remove {
+		patch[key] = nil
+	}
+	for key, value := range set {
+		patch[key] = &value
+	}
+	return patch
+}
+
+// parseETagGRPC returns the organization version expected by the client through the etag field (AIP-154).
+//
+// It returns nil if etag is empty.
+func parseETagGRPC(etag string) (*uint64, error) {
+	if etag == "" {
+		return nil, nil
+	}
+
+	version, err := strconv.ParseUint(strings.Trim(etag, `"`), 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid etag %q", etag)
+	}
+	return &version, nil
+}
+
+// -- Models --
+
+type createRequestGRPC struct {
+	Name        string `validate:"required,lte=48"`
+	ParentID    string `validate:"omitempty,lte=48"`
+	Description string `validate:"omitempty,lte=1024"`
+	WebsiteURL  string `validate:"omitempty,lte=2048"`
+	LogoURL     string `validate:"omitempty,lte=2048"`
+	Country     string `validate:"omitempty,len=2"`
+	Locale      string `validate:"omitempty,lte=35"`
+	TimeZone    string `validate:"omitempty,lte=64"`
+	LegalID     string `validate:"omitempty,lte=32"`
+	TaxID       string `validate:"omitempty,lte=32"`
+}
+
+type updateRequestGRPC struct {
+	Name        *string `validate:"omitempty,lte=48"`
+	Slug        *string `validate:"omitempty,lte=64"`
+	Description *string `validate:"omitempty,lte=1024"`
+	WebsiteURL  *string `validate:"omitempty,lte=2048"`
+	LogoURL     *string `validate:"omitempty,lte=2048"`
+	Country     *string `validate:"omitempty,lte=2"`
+	Locale      *string `validate:"omitempty,lte=35"`
+	TimeZone    *string `validate:"omitempty,lte=64"`
+	LegalID     *string `validate:"omitempty,lte=32"`
+	TaxID       *string `validate:"omitempty,lte=32"`
+}
+
+type moveRequestGRPC struct {
+	ParentID string `validate:"omitempty,lte=48"`
+}
+
+type transitionRequestGRPC struct {
+	Reason string `validate:"required,lte=32"`
+}
+
+func newOrganizationProto(org Organization) *iampb.Organization {
+	profile := org.Profile()
+	return &iampb.Organization{
+		OrganizationId: org.ID(),
+		Name:           org.Name(),
+		Etag:           newETag(org),
+		ParentId:       org.ParentID(),
+		Slug:           org.Slug(),
+		Status:         string(org.Status()),
+		StatusReason:   string(org.StatusReason()),
+		Description:    profile.Description,
+		WebsiteUrl:     profile.WebsiteURL,
+		LogoUrl:        profile.LogoURL,
+		Country:        profile.Country,
+		Locale:         profile.Locale,
+		TimeZone:       profile.TimeZone,
+		LegalId:        profile.LegalID,
+		TaxId:          profile.TaxID,
+		Labels:         org.Labels(),
+	}
+}
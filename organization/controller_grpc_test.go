@@ -0,0 +1,423 @@
+package organization_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"event-schema-registry/iampb"
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/grpcserver"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+// validatorFunc is a validation.Validator backed by a function.
+type validatorFunc func(ctx context.Context, v any) error
+
+func (f validatorFunc) Validate(ctx context.Context, v any) error {
+	return f(ctx, v)
+}
+
+type controllerGRPCSuite struct {
+	suite.Suite
+
+	ctrl      *gomock.Controller
+	manager   *organizationmock.MockManager
+	fetcher   *organizationmock.MockFetcher
+	lister    *organizationmock.MockLister
+	validator validatorFunc
+	server    grpcserver.Server
+	conn      *grpc.ClientConn
+	client    iampb.OrganizationServiceClient
+}
+
+func TestControllerGRPCSuite(t *testing.T) {
+	suite.Run(t, new(controllerGRPCSuite))
+}
+
+func (s *controllerGRPCSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.manager = organizationmock.NewMockManager(s.ctrl)
+	s.fetcher = organizationmock.NewMockFetcher(s.ctrl)
+	s.lister = organizationmock.NewMockLister(s.ctrl)
+	s.validator = func(_ context.Context, _ any) error {
+		return nil
+	}
+
+	controller := organization.NewControllerGRPC(s.manager, s.fetcher, s.lister, identifier.FactoryKSUID{},
+		validatorFunc(func(ctx context.Context, v any) error {
+			return s.validator(ctx, v)
+		}), slog.Default())
+	s.server = grpcserver.NewServer(grpcserver.Config{EnableReflection: true}, slog.Default(),
+		authn.NewInterceptorGRPC(authn.Config{}, authn.KeySet{}), []grpcserver.Controller{controller})
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+
+	var err error
+	s.conn, err = grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	s.client = iampb.NewOrganizationServiceClient(s.conn)
+}
+
+func (s *controllerGRPCSuite) TearDownTest() {
+	s.Assert().NoError(s.conn.Close())
+	s.server.Stop()
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_Health() {
+	// act
+	res, err := healthpb.NewHealthClient(s.conn).Check(context.Background(), &healthpb.HealthCheckRequest{
+		Service: iampb.OrganizationService_ServiceDesc.ServiceName,
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal(healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+	s.Assert().Contains(s.server.GetServiceInfo(), "grpc.reflection.v1.ServerReflection")
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_CreateOrganization() {
+	// arrange
+	s.manager.EXPECT().
+		Register(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, args organization.RegisterArguments) (organization.Organization, error) {
+			s.Assert().NotEmpty(args.ID)
+			s.Assert().Equal("foo", args.Name)
+			return organization.New(ctx, args.ID, args.Name), nil
+		})
+
+	// act
+	res, err := s.client.CreateOrganization(context.Background(), &iampb.CreateOrganizationRequest{
+		Name: "foo",
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().NotEmpty(res.GetOrganizationId())
+	s.Assert().Equal("foo", res.GetName())
+	s.Assert().NotEmpty(res.GetEtag())
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_CreateOrganization_Profile() {
+	// arrange
+	s.manager.EXPECT().
+		Register(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, args organization.RegisterArguments) (organization.Organization, error) {
+			s.Assert().Equal("parent", args.ParentID)
+			s.Assert().Equal(organization.Profile{Description: "Acme Corp.", Country: "MX"}, args.Profile)
+			s.Assert().Equal(organization.Labels{"env": "prod"}, args.Labels)
+			return organization.New(ctx, args.ID, args.Name, organization.WithParent(args.ParentID),
+				organization.WithProfile(args.Profile), organization.WithLabels(args.Labels)), nil
+		})
+
+	// act
+	res, err := s.client.CreateOrganization(context.Background(), &iampb.CreateOrganizationRequest{
+		Name:        "foo",
+		ParentId:    "parent",
+		Description: "Acme Corp.",
+		Country:     "MX",
+		Labels:      map[string]string{"env": "prod"},
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal("parent", res.GetParentId())
+	s.Assert().Equal("Acme Corp.", res.GetDescription())
+	s.Assert().Equal("MX", res.GetCountry())
+	s.Assert().Equal(string(organization.StatusActive), res.GetStatus())
+	s.Assert().Equal(map[string]string{"env": "prod"}, res.GetLabels())
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_CreateOrganization_Invalid() {
+	// arrange
+	s.validator = func(_ context.Context, _ any) error {
+		return errors.New("name is required")
+	}
+
+	// act
+	_, err := s.client.CreateOrganization(context.Background(), &iampb.CreateOrganizationRequest{})
+
+	// assert
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_Errors() {
+	tests := []struct {
+		name    string
+		inErr   error
+		expCode codes.Code
+	}{
+		{
+			name:    "not found",
+			inErr:   organization.ErrNotFound,
+			expCode: codes.NotFound,
+		},
+		{
+			name:    "already exists",
+			inErr:   organization.ErrAlreadyExists,
+			expCode: codes.AlreadyExists,
+		},
+		{
+			name:    "version conflict",
+			inErr:   organization.ErrVersionConflict,
+			expCode: codes.Aborted,
+		},
+		{
+			name:    "not active",
+			inErr:   organization.ErrNotActive,
+			expCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "has children",
+			inErr:   organization.ErrHasChildren,
+			expCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "unexpected",
+			inErr:   errors.New("unexpected failure"),
+			expCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			s.manager.EXPECT().
+				RestoreByID(gomock.Any(), "1").
+				Times(1).
+				Return(organization.Organization{}, tt.inErr)
+
+			// act
+			_, err := s.client.UndeleteOrganization(context.Background(), &iampb.UndeleteOrganizationRequest{
+				OrganizationId: "1",
+			})
+
+			// assert
+			s.Assert().Equal(tt.expCode, status.Code(err))
+		})
+	}
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_UpdateOrganization_ETag() {
+	// arrange
+	s.manager.EXPECT().
+		ModifyByID(gomock.Any(), "1", gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, _ string, opts ...organization.UpdateOption) (
+			organization.Organization, error) {
+			s.Assert().Len(opts, 12) // every updatable field and expected version
+			return organization.Organization{}, organization.ErrVersionConflict
+		})
+	name := "bar"
+
+	// act
+	_, err := s.client.UpdateOrganization(context.Background(), &iampb.UpdateOrganizationRequest{
+		OrganizationId: "1",
+		Name:           &name,
+		Etag:           `"3"`,
+	})
+	_, errInvalid := s.client.UpdateOrganization(context.Background(), &iampb.UpdateOrganizationRequest{
+		OrganizationId: "1",
+		Etag:           "W/foo",
+	})
+
+	// assert
+	s.Assert().Equal(codes.Aborted, status.Code(err))
+	s.Assert().Equal(codes.InvalidArgument, status.Code(errInvalid))
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_ListOrganizations_Invalid() {
+	tests := []struct {
+		name  string
+		inReq *iampb.ListOrganizationsRequest
+	}{
+		{
+			name:  "negative page size",
+			inReq: &iampb.ListOrganizationsRequest{PageSize: -1},
+		},
+		{
+			name:  "unsupported filter",
+			inReq: &iampb.ListOrganizationsRequest{Filter: "row_version = 1"},
+		},
+		{
+			name:  "unsupported order",
+			inReq: &iampb.ListOrganizationsRequest{OrderBy: "row_version"},
+		},
+		{
+			name:  "invalid label selector",
+			inReq: &iampb.ListOrganizationsRequest{LabelSelector: "env in (prod"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// act
+			_, err := s.client.ListOrganizations(context.Background(), tt.inReq)
+
+			// assert
+			s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_UpdateOrganization_Labels() {
+	// arrange
+	s.manager.EXPECT().
+		ModifyByID(gomock.Any(), "1", gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, _ string, opts ...organization.UpdateOption) (
+			organization.Organization, error) {
+			org := organization.New(ctx, "1", "foo",
+				organization.WithLabels(organization.Labels{"env": "dev", "legacy": "true", "tier": "gold"}))
+			org.Update(ctx, opts...)
+			return org, nil
+		})
+
+	// act
+	res, err := s.client.UpdateOrganization(context.Background(), &iampb.UpdateOrganizationRequest{
+		OrganizationId: "1",
+		Labels:         map[string]string{"env": "prod"},
+		RemoveLabels:   []string{"legacy"},
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal(map[string]string{"env": "prod", "tier": "gold"}, res.GetLabels())
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_MoveOrganization() {
+	// arrange
+	s.manager.EXPECT().
+		MoveUnder(gomock.Any(), "1", "parent").
+		Times(1).
+		DoAndReturn(func(ctx context.Context, id, parentID string) (organization.Organization, error) {
+			return organization.New(ctx, id, "foo", organization.WithParent(parentID)), nil
+		})
+
+	// act
+	res, err := s.client.MoveOrganization(context.Background(), &iampb.MoveOrganizationRequest{
+		OrganizationId: "1",
+		ParentId:       "parent",
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal("parent", res.GetParentId())
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_SuspendOrganization() {
+	// arrange
+	s.manager.EXPECT().
+		Suspend(gomock.Any(), "1", organization.ReasonNonPayment).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, id string, reason organization.StatusReason) (
+			organization.Organization, error) {
+			org := organization.New(ctx, id, "foo")
+			s.Require().NoError(org.Suspend(ctx, reason))
+			return org, nil
+		})
+	s.manager.EXPECT().
+		Suspend(gomock.Any(), "1", organization.StatusReason("bored")).
+		Times(1).
+		Return(organization.Organization{}, organization.ErrInvalidStatusReason)
+
+	// act
+	res, err := s.client.SuspendOrganization(context.Background(), &iampb.TransitionOrganizationRequest{
+		OrganizationId: "1",
+		Reason:         string(organization.ReasonNonPayment),
+	})
+	_, errInvalid := s.client.SuspendOrganization(context.Background(), &iampb.TransitionOrganizationRequest{
+		OrganizationId: "1",
+		Reason:         "bored",
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal(string(organization.StatusSuspended), res.GetStatus())
+	s.Assert().Equal(string(organization.ReasonNonPayment), res.GetStatusReason())
+	s.Assert().Equal(codes.InvalidArgument, status.Code(errInvalid))
+}
+
+func (s *controllerGRPCSuite) TestControllerGRPC_Authentication() {
+	// arrange
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "test-key",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	})
+	s.Require().NoError(err)
+	jwksFile := filepath.Join(s.T().TempDir(), "jwks.json")
+	s.Require().NoError(os.WriteFile(jwksFile, jwks, 0o600))
+	config := authn.Config{
+		Enabled:    true,
+		JWKSFile:   jwksFile,
+		Algorithms: []string{"RS256"},
+	}
+	keySet, err := authn.NewKeySet(config, slog.Default())
+	s.Require().NoError(err)
+	defer keySet.Close()
+
+	controller := organization.NewControllerGRPC(s.manager, s.fetcher, s.lister, identifier.FactoryKSUID{},
+		s.validator, slog.Default())
+	server := grpcserver.NewServer(grpcserver.Config{}, slog.Default(), authn.NewInterceptorGRPC(config, keySet),
+		[]grpcserver.Controller{controller})
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	// act
+	_, err = iampb.NewOrganizationServiceClient(conn).GetOrganization(context.Background(),
+		&iampb.GetOrganizationRequest{OrganizationId: "1"})
+	health, errHealth := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	// assert
+	s.Assert().Equal(codes.Unauthenticated, status.Code(err))
+	s.Require().NoError(errHealth, "health checks must not require a token")
+	s.Assert().Equal(healthpb.HealthCheckResponse_SERVING, health.GetStatus())
+}
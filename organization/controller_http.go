@@ -1,39 +1,69 @@
 package organization
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/persistence/paging"
 	"github.com/hadroncorp/geck/transport"
 	geckhttp "github.com/hadroncorp/geck/transport/http"
 	"github.com/hadroncorp/geck/validation"
 	"github.com/labstack/echo/v4"
 	"github.com/samber/lo"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/idempotency"
+	"github.com/hadroncorp/service-template/internal/requestid"
+)
+
+const (
+	_headerETag        = "ETag"
+	_headerIfMatch     = "If-Match"
+	_headerIfNoneMatch = "If-None-Match"
 )
 
 type ControllerHTTP struct {
-	manager   Manager
-	fetcher   Fetcher
-	lister    Lister
-	idFactory identifier.Factory
-	validator validation.Validator
-	logger    *slog.Logger
+	manager     Manager
+	fetcher     Fetcher
+	lister      Lister
+	searcher    Searcher
+	history     HistoryLister
+	authn       authn.MiddlewareHTTP
+	idempotency idempotency.MiddlewareHTTP
+	idFactory   identifier.Factory
+	validator   validation.Validator
+	logger      *slog.Logger
 }
 
 // compile-time assertion
 var _ geckhttp.Controller = (*ControllerHTTP)(nil)
 
 // NewControllerHTTP creates a new instance of [ControllerHTTP].
-func NewControllerHTTP(manager Manager, fetcher Fetcher, lister Lister, idFactory identifier.Factory,
-	validator validation.Validator, logger *slog.Logger) ControllerHTTP {
+func NewControllerHTTP(manager Manager, fetcher Fetcher, lister Lister, searcher Searcher, history HistoryLister,
+	authnMiddleware authn.MiddlewareHTTP, idempotencyMiddleware idempotency.MiddlewareHTTP,
+	idFactory identifier.Factory, validator validation.Validator, logger *slog.Logger) ControllerHTTP {
 	return ControllerHTTP{
-		manager:   manager,
-		fetcher:   fetcher,
-		lister:    lister,
-		idFactory: idFactory,
-		validator: validator,
-		logger:    logger,
+		manager:     manager,
+		fetcher:     fetcher,
+		lister:      lister,
+		searcher:    searcher,
+		history:     history,
+		authn:       authnMiddleware,
+		idempotency: idempotencyMiddleware,
+		idFactory:   idFactory,
+		validator:   validator,
+		logger:      logger,
 	}
 }
 
@@ -41,11 +71,42 @@ func (c ControllerHTTP) SetEndpoints(_ *echo.Echo) {
 }
 
 func (c ControllerHTTP) SetVersionedEndpoints(g *echo.Group) {
-	g.POST("/organizations", c.register)
-	g.GET("/organizations/:organization_id", c.get)
-	g.PATCH("/organizations/:organization_id", c.update)
-	g.DELETE("/organizations/:organization_id", c.delete)
-	g.GET("/organizations", c.list)
+	// DEV-NOTE: Errors returned by handlers and middlewares (e.g. authn, idempotency) are rendered as problem
+	// details (RFC 7807) by the error handler of the server, see problem.ControllerHTTP.
+	og := g.Group("/organizations", requestid.HandleHTTP, c.authn.Handle)
+	// DEV-NOTE: POST endpoints honor the Idempotency-Key header, so client retries (e.g. after a timeout) do not
+	// create duplicate organizations.
+	og.POST("", c.register, c.idempotency.Handle)
+	og.GET("/:organization_id", c.get)
+	og.GET("/by-slug/:slug", c.getBySlug)
+	og.PATCH("/:organization_id", c.update)
+	og.DELETE("/:organization_id", c.delete)
+	og.GET("", c.list)
+	og.GET("\\:search", c.search)
+	og.GET("/:organization_id/children", c.listChildren)
+	og.GET("/:organization_id/ancestors", c.listAncestors)
+	og.GET("/:organization_id/history", c.listHistory)
+	// DEV-NOTE: Custom methods (e.g. POST /organizations/{id}:undelete) cannot be registered as routes as
+	// path parameters span up to the next slash, so they get dispatched by customMethod.
+	og.POST("/:organization_id", c.customMethod, c.idempotency.Handle)
+}
+
+func (c ControllerHTTP) customMethod(e echo.Context) error {
+	id, method, _ := strings.Cut(e.Param("organization_id"), ":")
+	switch method {
+	case "undelete":
+		return c.restore(e, id)
+	case "move":
+		return c.move(e, id)
+	case "suspend":
+		return c.transition(e, id, c.manager.Suspend)
+	case "reactivate":
+		return c.transition(e, id, c.manager.Reactivate)
+	case "archive":
+		return c.transition(e, id, c.manager.Archive)
+	default:
+		return echo.ErrNotFound
+	}
 }
 
 func (c ControllerHTTP) register(e echo.Context) error {
@@ -64,12 +125,27 @@ func (c ControllerHTTP) register(e echo.Context) error {
 	}
 
 	org, err := c.manager.Register(e.Request().Context(), RegisterArguments{
-		ID:   id,
-		Name: body.Name,
+		ID:       id,
+		Name:     body.Name,
+		ParentID: body.ParentID,
+		Profile: Profile{
+			Description: body.Description,
+			WebsiteURL:  body.WebsiteURL,
+			LogoURL:     body.LogoURL,
+			Country:     body.Country,
+			Locale:      body.Locale,
+			TimeZone:    body.TimeZone,
+			LegalID:     body.LegalID,
+			TaxID:       body.TaxID,
+		},
+		Labels: body.Labels,
 	})
-	if err != nil {
+	if errors.Is(err, ErrInvalidProfile) || errors.Is(err, ErrInvalidLabels) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	} else if err != nil {
 		return err
 	}
+	setETag(e, org)
 	return e.JSON(http.StatusCreated, transport.DataContainer[responseHTTP]{
 		Data: newResponseHTTP(org),
 	})
@@ -77,10 +153,53 @@ func (c ControllerHTTP) register(e echo.Context) error {
 
 func (c ControllerHTTP) get(e echo.Context) error {
 	id := e.Param("organization_id")
-	org, err := c.fetcher.GetByID(e.Request().Context(), id)
+	var opts []FetchOption
+	if showDeleted, _ := strconv.ParseBool(e.QueryParam("show_deleted")); showDeleted {
+		opts = append(opts, WithFetchDeleted())
+	}
+
+	org, err := c.fetcher.GetByID(e.Request().Context(), id, opts...)
 	if err != nil {
 		return err
 	}
+	setETag(e, org)
+	lastModified := newLastModified(org)
+	e.Response().Header().Set(echo.HeaderLastModified, lastModified.Format(http.TimeFormat))
+	if isNotModified(e.Request(), newETag(org), lastModified) {
+		return e.NoContent(http.StatusNotModified)
+	}
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(org),
+	})
+}
+
+func (c ControllerHTTP) getBySlug(e echo.Context) error {
+	slug := e.Param("slug")
+	var opts []FetchOption
+	if showDeleted, _ := strconv.ParseBool(e.QueryParam("show_deleted")); showDeleted {
+		opts = append(opts, WithFetchDeleted())
+	}
+
+	org, err := c.fetcher.GetBySlug(e.Request().Context(), slug, opts...)
+	if err != nil {
+		return err
+	}
+	// DEV-NOTE: Previous slugs permanently redirect to the current one, so clients (and search engines) update
+	// their links.
+	if !strings.EqualFold(slug, org.Slug()) {
+		location := strings.TrimSuffix(e.Request().URL.Path, slug) + url.PathEscape(org.Slug())
+		if e.Request().URL.RawQuery != "" {
+			location += "?" + e.Request().URL.RawQuery
+		}
+		return e.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	setETag(e, org)
+	lastModified := newLastModified(org)
+	e.Response().Header().Set(echo.HeaderLastModified, lastModified.Format(http.TimeFormat))
+	if isNotModified(e.Request(), newETag(org), lastModified) {
+		return e.NoContent(http.StatusNotModified)
+	}
 	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
 		Data: newResponseHTTP(org),
 	})
@@ -100,13 +219,35 @@ func (c ControllerHTTP) update(e echo.Context) error {
 
 	opts := []UpdateOption{
 		WithUpdatedName(body.Name),
+		WithUpdatedSlug(body.Slug),
+		WithUpdatedDescription(body.Description),
+		WithUpdatedWebsiteURL(body.WebsiteURL),
+		WithUpdatedLogoURL(body.LogoURL),
+		WithUpdatedCountry(body.Country),
+		WithUpdatedLocale(body.Locale),
+		WithUpdatedTimeZone(body.TimeZone),
+		WithUpdatedLegalID(body.LegalID),
+		WithUpdatedTaxID(body.TaxID),
+		WithUpdatedLabels(body.Labels),
 	}
-
-	org, err := c.manager.ModifyByID(e.Request().Context(), id, opts...)
+	expectedVersions, err := parseIfMatch(e)
 	if err != nil {
 		return err
+	} else if len(expectedVersions) > 0 {
+		opts = append(opts, WithExpectedVersions(expectedVersions...))
 	}
 
+	org, err := c.manager.ModifyByID(e.Request().Context(), id, opts...)
+	if errors.Is(err, ErrInvalidSlug) {
+		return echo.NewHTTPError(http.StatusBadRequest,
+			"slug must be lowercase letters and digits separated by single hyphens").SetInternal(err)
+	} else if errors.Is(err, ErrInvalidProfile) || errors.Is(err, ErrInvalidLabels) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	} else if err != nil {
+		return newPreconditionErrorHTTP(e, err)
+	}
+
+	setETag(e, org)
 	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
 		Data: newResponseHTTP(org),
 	})
@@ -114,23 +255,327 @@ func (c ControllerHTTP) update(e echo.Context) error {
 
 func (c ControllerHTTP) delete(e echo.Context) error {
 	id := e.Param("organization_id")
-	err := c.manager.DeleteByID(e.Request().Context(), id)
+	var opts []DeleteOption
+	expectedVersions, err := parseIfMatch(e)
 	if err != nil {
 		return err
+	} else if len(expectedVersions) > 0 {
+		opts = append(opts, WithDeleteExpectedVersions(expectedVersions...))
+	}
+
+	err = c.manager.DeleteByID(e.Request().Context(), id, opts...)
+	if err != nil {
+		return newPreconditionErrorHTTP(e, err)
 	}
 	return e.NoContent(http.StatusNoContent)
 }
 
-func (c ControllerHTTP) list(e echo.Context) error {
-	page, err := c.lister.List(e.Request().Context(),
+// -- Conditional request(s) --
+
+// DEV-NOTE: Entity tags (ETag) are derived from the organization row version, so they change every time the
+// organization is modified. Clients send them back through the If-Match header to perform
+// conditional writes (optimistic concurrency control), or through the If-None-Match header to perform
+// conditional reads (revalidation of cached copies).
+
+// newETag returns the strong entity tag of the given [Organization].
+func newETag(org Organization) string {
+	return strconv.Quote(strconv.FormatUint(org.Version(), 10))
+}
+
+// setETag sets the ETag header of the response using the given [Organization].
+func setETag(e echo.Context, org Organization) {
+	e.Response().Header().Set(_headerETag, newETag(org))
+}
+
+// parseIfMatch returns the organization versions expected by the client through the If-Match header, any of them.
+//
+// It returns nil if the header was not set or if it matches any version (i.e. `*`).
+func parseIfMatch(e echo.Context) ([]uint64, error) {
+	header := strings.TrimSpace(e.Request().Header.Get(_headerIfMatch))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	versions := make([]uint64, 0, 1)
+	for _, tag := range strings.Split(header, ",") {
+		// If-Match uses strong comparison, weak tags never match
+		version, err := strconv.ParseUint(strings.Trim(strings.TrimSpace(tag), `"`), 10, 64)
+		if strings.HasPrefix(strings.TrimSpace(tag), "W/") || err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+
+	if len(versions) == 0 {
+		return nil, echo.NewHTTPError(http.StatusPreconditionFailed, "organization does not match If-Match header")
+	}
+	// DEV-NOTE: The versions are checked against the version read by the manager when writing, reading it here
+	// (e.g. through a cached fetcher) might see a stale version.
+	return versions, nil
+}
+
+// newLastModified returns the time the given [Organization] was last modified, truncated to HTTP-date
+// precision (seconds).
+func newLastModified(org Organization) time.Time {
+	lastModified := org.LastUpdateTime()
+	if lastModified.IsZero() {
+		lastModified = org.CreateTime()
+	}
+	return lastModified.UTC().Truncate(time.Second)
+}
+
+// newPageETag returns the weak entity tag of a page of organizations listed with the given query parameters.
+//
+// The tag is weak as it is derived from the query, the identity, version and position of every item and the
+// presence of adjacent pages rather than from the response bytes. Page tokens are encrypted (i.e. they differ
+// on every response listing the very same page), so only their presence is part of the tag.
+func newPageETag(query url.Values, page *paging.Page[Organization]) string {
+	digest := sha256.New()
+	_, _ = fmt.Fprintf(digest, "%s|%d|%t|%t", query.Encode(), page.TotalItems, page.PreviousPageToken != "",
+		page.NextPageToken != "")
+	for _, org := range page.Items {
+		_, _ = fmt.Fprintf(digest, "|%s:%d", org.ID(), org.Version())
+	}
+	return `W/"` + hex.EncodeToString(digest.Sum(nil)[:16]) + `"`
+}
+
+// isNotModified evaluates the If-None-Match and If-Modified-Since preconditions of a GET request
+// (RFC 9110, section 13.2.2) against the current validators of the representation.
+//
+// It returns true if the client copy is current. lastModified is ignored if zero.
+func isNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if header := strings.TrimSpace(r.Header.Get(_headerIfNoneMatch)); header != "" {
+		if header == "*" {
+			return true
+		}
+		// If-None-Match uses weak comparison
+		for _, tag := range strings.Split(header, ",") {
+			if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	// If-Modified-Since is ignored when If-None-Match is set
+	if lastModified.IsZero() {
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get(echo.HeaderIfModifiedSince))
+	return err == nil && !lastModified.After(since)
+}
+
+// newPreconditionErrorHTTP translates [ErrVersionConflict] into a 412 (Precondition Failed) error if the
+// request was conditional.
+func newPreconditionErrorHTTP(e echo.Context, err error) error {
+	if errors.Is(err, ErrVersionConflict) && e.Request().Header.Get(_headerIfMatch) != "" {
+		return echo.NewHTTPError(http.StatusPreconditionFailed, "organization does not match If-Match header").
+			SetInternal(err)
+	}
+	return err
+}
+
+func (c ControllerHTTP) restore(e echo.Context, id string) error {
+	org, err := c.manager.RestoreByID(e.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	setETag(e, org)
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(org),
+	})
+}
+
+func (c ControllerHTTP) move(e echo.Context, id string) error {
+	body := moveRequestHTTP{}
+	if err := e.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.validator.Validate(e.Request().Context(), body); err != nil {
+		return err
+	}
+
+	org, err := c.manager.MoveUnder(e.Request().Context(), id, body.ParentID)
+	if err != nil {
+		return err
+	}
+
+	setETag(e, org)
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(org),
+	})
+}
+
+// transition handles the status custom methods (suspend, reactivate and archive) using the given [Manager]
+// operation.
+func (c ControllerHTTP) transition(e echo.Context, id string,
+	apply func(ctx context.Context, id string, reason StatusReason) (Organization, error)) error {
+	body := transitionRequestHTTP{}
+	if err := e.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.validator.Validate(e.Request().Context(), body); err != nil {
+		return err
+	}
+
+	org, err := apply(e.Request().Context(), id, StatusReason(body.Reason))
+	if errors.Is(err, ErrInvalidStatusReason) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unsupported reason %q", body.Reason)).
+			SetInternal(err)
+	} else if err != nil {
+		return err
+	}
+
+	setETag(e, org)
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: newResponseHTTP(org),
+	})
+}
+
+func (c ControllerHTTP) listChildren(e echo.Context) error {
+	opts := []ListOption{
+		WithListParent(e.Param("organization_id")),
 		WithListPageOptions(geckhttp.NewPaginationOptions(e)...),
+	}
+	if showDeleted, _ := strconv.ParseBool(e.QueryParam("show_deleted")); !showDeleted {
+		opts = append(opts, WithListNonDeletedOnly())
+	}
+
+	page, err := c.lister.List(e.Request().Context(), opts...)
+	if errors.Is(err, ErrPageTokenMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest, "page_token belongs to another organization").
+			SetInternal(err)
+	} else if err != nil {
+		return err
+	} else if len(page.Items) == 0 {
+		return e.NoContent(http.StatusNotFound)
+	}
+
+	return e.JSON(http.StatusOK, transport.DataContainer[transport.PageResponse[responseHTTP]]{
+		Data: transport.PageResponse[responseHTTP]{
+			TotalItems:        page.TotalItems,
+			PreviousPageToken: page.PreviousPageToken,
+			NextPageToken:     page.NextPageToken,
+			Items: lo.Map(page.Items, func(o Organization, _ int) responseHTTP {
+				return newResponseHTTP(o)
+			}),
+		},
+	})
+}
+
+func (c ControllerHTTP) listAncestors(e echo.Context) error {
+	ancestors, err := c.lister.ListAncestors(e.Request().Context(), e.Param("organization_id"))
+	if err != nil {
+		return err
+	}
+	// DEV-NOTE: Root organizations have no ancestors, the list is empty rather than not found as the
+	// organization exists.
+	return e.JSON(http.StatusOK, transport.DataContainer[[]responseHTTP]{
+		Data: lo.Map(ancestors, func(o Organization, _ int) responseHTTP {
+			return newResponseHTTP(o)
+		}),
+	})
+}
+
+func (c ControllerHTTP) listHistory(e echo.Context) error {
+	startTime, err := parseTimeQueryParam(e, "start_time")
+	if err != nil {
+		return err
+	}
+	endTime, err := parseTimeQueryParam(e, "end_time")
+	if err != nil {
+		return err
+	} else if !startTime.IsZero() && !endTime.IsZero() && !startTime.Before(endTime) {
+		return echo.NewHTTPError(http.StatusBadRequest, "start_time must be before end_time")
+	}
+
+	page, err := c.history.List(e.Request().Context(), e.Param("organization_id"),
+		WithHistoryPageOptions(geckhttp.NewPaginationOptions(e)...),
+		WithHistoryTimeRange(startTime, endTime),
 	)
+	if errors.Is(err, ErrPageTokenMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest, "page_token belongs to another organization").
+			SetInternal(err)
+	} else if err != nil {
+		return err
+	} else if len(page.Items) == 0 {
+		return e.NoContent(http.StatusNotFound)
+	}
+
+	return e.JSON(http.StatusOK, transport.DataContainer[transport.PageResponse[historyRecordResponseHTTP]]{
+		Data: transport.PageResponse[historyRecordResponseHTTP]{
+			TotalItems:        page.TotalItems,
+			PreviousPageToken: page.PreviousPageToken,
+			NextPageToken:     page.NextPageToken,
+			Items: lo.Map(page.Items, func(r HistoryRecord, _ int) historyRecordResponseHTTP {
+				return newHistoryRecordResponseHTTP(r)
+			}),
+		},
+	})
+}
+
+// parseTimeQueryParam parses the RFC 3339 timestamp of the query parameter param, it returns a zero time if the
+// parameter was not set.
+func parseTimeQueryParam(e echo.Context, param string) (time.Time, error) {
+	value := e.QueryParam(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
 	if err != nil {
+		return time.Time{}, echo.NewHTTPError(http.StatusBadRequest, param+" must be an RFC 3339 timestamp").
+			SetInternal(err)
+	}
+	return t, nil
+}
+
+func (c ControllerHTTP) list(e echo.Context) error {
+	opts := []ListOption{
+		WithListPageOptions(geckhttp.NewPaginationOptions(e)...),
+	}
+	if showDeleted, _ := strconv.ParseBool(e.QueryParam("show_deleted")); !showDeleted {
+		opts = append(opts, WithListNonDeletedOnly())
+	}
+	filterOpts, err := newListFilterOptions(e.QueryParam("filter"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	opts = append(opts, filterOpts...)
+	if labelSelector := e.QueryParam("label_selector"); labelSelector != "" {
+		selector, errSelector := ParseLabelSelector(labelSelector)
+		if errSelector != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, errSelector.Error()).SetInternal(errSelector)
+		}
+		opts = append(opts, WithListLabelSelector(selector))
+	}
+	if orderBy := e.QueryParam("order_by"); orderBy != "" {
+		sortOpt, errSort := newListSortOption(orderBy)
+		if errSort != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, errSort.Error())
+		}
+		opts = append(opts, sortOpt)
+	}
+
+	page, err := c.lister.List(e.Request().Context(), opts...)
+	if errors.Is(err, ErrPageTokenMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest,
+			"filter, label_selector, order_by and show_deleted must not change between pages").SetInternal(err)
+	} else if err != nil {
 		return err
 	} else if len(page.Items) == 0 {
 		return e.NoContent(http.StatusNotFound)
 	}
 
+	// DEV-NOTE: Pages carry no Last-Modified header, the latest modification among items does not change
+	// when an item leaves the page (e.g. deleted), so only the weak entity tag may revalidate them.
+	etag := newPageETag(e.QueryParams(), page)
+	e.Response().Header().Set(_headerETag, etag)
+	if isNotModified(e.Request(), etag, time.Time{}) {
+		return e.NoContent(http.StatusNotModified)
+	}
 	return e.JSON(http.StatusOK, transport.DataContainer[transport.PageResponse[responseHTTP]]{
 		Data: transport.PageResponse[responseHTTP]{
 			TotalItems:        page.TotalItems,
@@ -143,24 +588,266 @@ func (c ControllerHTTP) list(e echo.Context) error {
 	})
 }
 
+func (c ControllerHTTP) search(e echo.Context) error {
+	query := strings.TrimSpace(e.QueryParam("q"))
+	if query == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "q query parameter is required")
+	}
+
+	page, err := c.searcher.Search(e.Request().Context(), query,
+		WithSearchPageOptions(geckhttp.NewPaginationOptions(e)...),
+	)
+	if errors.Is(err, ErrPageTokenMismatch) {
+		return echo.NewHTTPError(http.StatusBadRequest, "q must not change between pages").SetInternal(err)
+	} else if err != nil {
+		return err
+	}
+
+	return e.JSON(http.StatusOK, transport.DataContainer[transport.PageResponse[searchResponseHTTP]]{
+		Data: transport.PageResponse[searchResponseHTTP]{
+			TotalItems:        page.TotalItems,
+			PreviousPageToken: page.PreviousPageToken,
+			NextPageToken:     page.NextPageToken,
+			Items: lo.Map(page.Items, func(r SearchResult, _ int) searchResponseHTTP {
+				return searchResponseHTTP{
+					Organization: newResponseHTTP(r.Organization),
+					Score:        r.Score,
+					Highlight:    r.Highlight,
+				}
+			}),
+		},
+	})
+}
+
+// -- List query --
+
+// DEV-NOTE: List filtering and sorting follow AIP-160 (filter) and AIP-132 (order_by) syntax, though only
+// the subset the persistence layer is able to serve efficiently is supported.
+
+// _listFilterRestrictionRegexp matches a single filter restriction (e.g. `create_by = "alice"`).
+var _listFilterRestrictionRegexp = regexp.MustCompile(`^\s*([a-z_]+)\s*(>=|<=|!=|=|<|>|:)\s*(.+?)\s*$`)
+
+// newListFilterOptions parses an AIP-160 filter expression into [ListOption] items.
+//
+// Only conjunctions (AND) of the following restrictions are supported:
+//   - create_by = "<principal>"
+//   - create_time >= "<RFC 3339 timestamp>" and create_time < "<RFC 3339 timestamp>"
+//   - name = "<prefix>*"
+//   - parent_id = "<organization ID>"
+//   - status = "<active|suspended|archived>"
+func newListFilterOptions(filter string) ([]ListOption, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	var (
+		opts            []ListOption
+		createTimeStart time.Time
+		createTimeEnd   time.Time
+	)
+	for _, restriction := range splitListFilterConjunction(filter) {
+		matches := _listFilterRestrictionRegexp.FindStringSubmatch(restriction)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid filter restriction %q", strings.TrimSpace(restriction))
+		}
+		field, operator, value := matches[1], matches[2], matches[3]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+
+		switch {
+		case field == "create_by" && operator == "=":
+			opts = append(opts, WithListCreateBy(value))
+		case field == "create_time" && (operator == ">=" || operator == "<"):
+			createTime, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid create_time value %q, expected an RFC 3339 timestamp", value)
+			} else if operator == ">=" {
+				createTimeStart = createTime
+			} else {
+				createTimeEnd = createTime
+			}
+		case field == "name" && operator == "=" && strings.HasSuffix(value, "*"):
+			opts = append(opts, WithListNamePrefix(strings.TrimSuffix(value, "*")))
+		case field == "parent_id" && operator == "=" && value != "":
+			opts = append(opts, WithListParent(value))
+		case field == "status" && operator == "=":
+			if !Status(value).IsValid() {
+				return nil, fmt.Errorf("invalid status value %q, expected active, suspended or archived", value)
+			}
+			opts = append(opts, WithListStatus(Status(value)))
+		default:
+			return nil, fmt.Errorf("unsupported filter restriction %q", strings.TrimSpace(restriction))
+		}
+	}
+	if !createTimeStart.IsZero() || !createTimeEnd.IsZero() {
+		opts = append(opts, WithListCreateTimeRange(createTimeStart, createTimeEnd))
+	}
+	return opts, nil
+}
+
+// splitListFilterConjunction splits filter into its restrictions, ignoring AND operators within quoted values.
+func splitListFilterConjunction(filter string) []string {
+	const separator = " AND "
+	var (
+		restrictions []string
+		start        int
+		isQuoted     bool
+	)
+	for i := 0; i < len(filter); i++ {
+		switch {
+		case filter[i] == '\\' && isQuoted:
+			i++ // skip escaped character
+		case filter[i] == '"':
+			isQuoted = !isQuoted
+		case !isQuoted && strings.HasPrefix(filter[i:], separator):
+			restrictions = append(restrictions, filter[start:i])
+			start = i + len(separator)
+			i = start - 1
+		}
+	}
+	return append(restrictions, filter[start:])
+}
+
+// newListSortOption parses an AIP-132 order_by expression (e.g. `name desc`) into a [ListOption].
+//
+// Only a single sort field is supported.
+func newListSortOption(orderBy string) (ListOption, error) {
+	if strings.Contains(orderBy, ",") {
+		return nil, errors.New("order_by supports a single field only")
+	}
+
+	terms := strings.Fields(orderBy)
+	if len(terms) == 0 || len(terms) > 2 {
+		return nil, fmt.Errorf("invalid order_by %q", orderBy)
+	}
+	field := ListSortField(terms[0])
+	if !field.IsValid() {
+		return nil, fmt.Errorf("unsupported order_by field %q", terms[0])
+	}
+	isDescending := false
+	if len(terms) == 2 {
+		switch terms[1] {
+		case "asc":
+		case "desc":
+			isDescending = true
+		default:
+			return nil, fmt.Errorf("invalid order_by direction %q", terms[1])
+		}
+	}
+	return WithListSort(field, isDescending), nil
+}
+
 // -- Models --
 
+// DEV-NOTE: Profile attributes are only checked for size here, their formats (e.g. tax identifiers) are business
+// rules validated by the domain (see Profile.Validate).
+
 type registerRequestHTTP struct {
-	Name string `json:"name" validate:"required,lte=48"`
+	Name        string `json:"name" validate:"required,lte=48"`
+	ParentID    string `json:"parent_id" validate:"omitempty,lte=48"`
+	Description string `json:"description" validate:"omitempty,lte=1024"`
+	WebsiteURL  string `json:"website_url" validate:"omitempty,lte=2048"`
+	LogoURL     string `json:"logo_url" validate:"omitempty,lte=2048"`
+	Country     string `json:"country" validate:"omitempty,len=2"`
+	Locale      string `json:"locale" validate:"omitempty,lte=35"`
+	TimeZone    string `json:"time_zone" validate:"omitempty,lte=64"`
+	LegalID     string `json:"legal_id" validate:"omitempty,lte=32"`
+	TaxID       string `json:"tax_id" validate:"omitempty,lte=32"`
+	Labels      Labels `json:"labels,omitempty"`
 }
 
+// updateResponseHTTP is the body of a partial update, attributes left unset are not modified and profile
+// attributes set to an empty string are unset. Labels are merged (JSON merge patch), labels set to null are
+// removed.
 type updateResponseHTTP struct {
-	Name *string `json:"name" validate:"omitempty,lte=48"`
+	Name        *string            `json:"name" validate:"omitempty,lte=48"`
+	Slug        *string            `json:"slug" validate:"omitempty,lte=64"`
+	Description *string            `json:"description" validate:"omitempty,lte=1024"`
+	WebsiteURL  *string            `json:"website_url" validate:"omitempty,lte=2048"`
+	LogoURL     *string            `json:"logo_url" validate:"omitempty,lte=2048"`
+	Country     *string            `json:"country" validate:"omitempty,lte=2"`
+	Locale      *string            `json:"locale" validate:"omitempty,lte=35"`
+	TimeZone    *string            `json:"time_zone" validate:"omitempty,lte=64"`
+	LegalID     *string            `json:"legal_id" validate:"omitempty,lte=32"`
+	TaxID       *string            `json:"tax_id" validate:"omitempty,lte=32"`
+	Labels      map[string]*string `json:"labels,omitempty"`
+}
+
+// moveRequestHTTP is the body of the move custom method, an empty parent makes the organization a root
+// organization.
+type moveRequestHTTP struct {
+	ParentID string `json:"parent_id" validate:"omitempty,lte=48"`
+}
+
+// transitionRequestHTTP is the body of the status custom methods (suspend, reactivate and archive).
+type transitionRequestHTTP struct {
+	Reason string `json:"reason" validate:"required,lte=32"`
 }
 
 type responseHTTP struct {
-	ID   string `json:"organization_id"`
-	Name string `json:"name"`
+	ID           string `json:"organization_id"`
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Status       string `json:"status"`
+	StatusReason string `json:"status_reason,omitempty"`
+	ParentID     string `json:"parent_id,omitempty"`
+	Description  string `json:"description,omitempty"`
+	WebsiteURL   string `json:"website_url,omitempty"`
+	LogoURL      string `json:"logo_url,omitempty"`
+	Country      string `json:"country,omitempty"`
+	Locale       string `json:"locale,omitempty"`
+	TimeZone     string `json:"time_zone,omitempty"`
+	LegalID      string `json:"legal_id,omitempty"`
+	TaxID        string `json:"tax_id,omitempty"`
+	Labels       Labels `json:"labels,omitempty"`
+}
+
+type historyRecordResponseHTTP struct {
+	OrganizationID string    `json:"organization_id"`
+	Operation      string    `json:"operation"`
+	Actor          string    `json:"actor"`
+	RequestID      string    `json:"request_id,omitempty"`
+	Before         *Snapshot `json:"before,omitempty"`
+	After          *Snapshot `json:"after,omitempty"`
+	Time           time.Time `json:"time"`
+}
+
+func newHistoryRecordResponseHTTP(r HistoryRecord) historyRecordResponseHTTP {
+	return historyRecordResponseHTTP{
+		OrganizationID: r.OrganizationID,
+		Operation:      string(r.Operation),
+		Actor:          r.Actor,
+		RequestID:      r.RequestID,
+		Before:         r.Before,
+		After:          r.After,
+		Time:           r.Time,
+	}
 }
 
 func newResponseHTTP(org Organization) responseHTTP {
+	profile := org.Profile()
 	return responseHTTP{
-		ID:   org.ID(),
-		Name: org.Name(),
+		ID:           org.ID(),
+		Name:         org.Name(),
+		Slug:         org.Slug(),
+		Status:       string(org.Status()),
+		StatusReason: string(org.StatusReason()),
+		ParentID:     org.ParentID(),
+		Description:  profile.Description,
+		WebsiteURL:   profile.WebsiteURL,
+		LogoURL:      profile.LogoURL,
+		Country:      profile.Country,
+		Locale:       profile.Locale,
+		TimeZone:     profile.TimeZone,
+		LegalID:      profile.LegalID,
+		TaxID:        profile.TaxID,
+		Labels:       org.Labels(),
 	}
 }
+
+type searchResponseHTTP struct {
+	Organization responseHTTP `json:"organization"`
+	Score        float64      `json:"score"`
+	Highlight    string       `json:"highlight"`
+}
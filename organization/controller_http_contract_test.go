@@ -0,0 +1,324 @@
+package organization_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/go-playground/validator/v10"
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/idempotency"
+	"github.com/hadroncorp/service-template/idempotencymock"
+	"github.com/hadroncorp/service-template/openapi"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+	"github.com/hadroncorp/service-template/problem"
+)
+
+// controllerHTTPContractSuite verifies [organization.ControllerHTTP] handlers behave as described by the
+// OpenAPI document generated from their routes.
+type controllerHTTPContractSuite struct {
+	suite.Suite
+
+	manager  *organizationmock.MockManager
+	fetcher  *organizationmock.MockFetcher
+	lister   *organizationmock.MockLister
+	searcher *organizationmock.MockSearcher
+	server   *echo.Echo
+	router   routers.Router
+}
+
+func TestControllerHTTPContractSuite(t *testing.T) {
+	suite.Run(t, new(controllerHTTPContractSuite))
+}
+
+func (s *controllerHTTPContractSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.manager = organizationmock.NewMockManager(ctrl)
+	s.fetcher = organizationmock.NewMockFetcher(ctrl)
+	s.lister = organizationmock.NewMockLister(ctrl)
+	s.searcher = organizationmock.NewMockSearcher(ctrl)
+	validate := validator.New()
+	controller := organization.NewControllerHTTP(
+		s.manager,
+		s.fetcher,
+		s.lister,
+		s.searcher,
+		organizationmock.NewMockHistoryLister(ctrl),
+		authn.NewMiddlewareHTTP(authn.Config{}, authn.KeySet{}),
+		idempotency.NewMiddlewareHTTP(idempotency.Config{}, idempotencymock.NewMockStore(ctrl), slog.Default()),
+		identifier.FactoryKSUID{},
+		validatorFunc(validate.StructCtx),
+		slog.Default(),
+	)
+	s.server = echo.New()
+	s.server.HTTPErrorHandler = problem.NewErrorHandlerHTTP(slog.Default()).Handle
+	controller.SetVersionedEndpoints(s.server.Group(""))
+
+	doc, err := openapi.NewDocument(openapi.Config{Title: "test", Version: "v1"}, s.server.Routes(),
+		controller.Operations())
+	s.Require().NoError(err)
+	s.router, err = gorillamux.NewRouter(doc)
+	s.Require().NoError(err)
+}
+
+// serve executes the request against both the handlers and the OpenAPI document. It fails if the request
+// validity or the response differ from the document.
+func (s *controllerHTTPContractSuite) serve(method, target, body string, isValidRequest bool) *httptest.ResponseRecorder {
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		}
+		return req
+	}
+
+	ctx := context.Background()
+	req := newRequest()
+	route, pathParams, err := s.router.FindRoute(req)
+	s.Require().NoError(err, "operation not documented")
+	reqInput := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+	}
+	if err = openapi3filter.ValidateRequest(ctx, reqInput); isValidRequest {
+		s.Require().NoError(err)
+	} else {
+		s.Require().Error(err)
+	}
+
+	rec := httptest.NewRecorder()
+	s.server.ServeHTTP(rec, newRequest())
+
+	err = openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: reqInput,
+		Status:                 rec.Code,
+		Header:                 rec.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(rec.Body.Bytes())),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+		},
+	})
+	s.Require().NoError(err, "response does not match the document: %s", rec.Body.String())
+	return rec
+}
+
+func (s *controllerHTTPContractSuite) TestControllerHTTP_Contract() {
+	tests := []struct {
+		name           string
+		inMethod       string
+		inTarget       string
+		inBody         string
+		isValidRequest bool
+		setup          func()
+		expStatus      int
+	}{
+		{
+			name:           "create",
+			inMethod:       http.MethodPost,
+			inTarget:       "/organizations",
+			inBody:         `{"name":"foo"}`,
+			isValidRequest: true,
+			setup: func() {
+				s.manager.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, args organization.RegisterArguments) (
+						organization.Organization, error) {
+						return organization.New(ctx, args.ID, args.Name), nil
+					})
+			},
+			expStatus: http.StatusCreated,
+		},
+		{
+			name:      "create name too long",
+			inMethod:  http.MethodPost,
+			inTarget:  "/organizations",
+			inBody:    `{"name":"` + strings.Repeat("a", 49) + `"}`,
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "create name taken",
+			inMethod:       http.MethodPost,
+			inTarget:       "/organizations",
+			inBody:         `{"name":"foo"}`,
+			isValidRequest: true,
+			setup: func() {
+				s.manager.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(organization.Organization{}, organization.ErrAlreadyExists)
+			},
+			expStatus: http.StatusConflict,
+		},
+		{
+			name:           "get",
+			inMethod:       http.MethodGet,
+			inTarget:       "/organizations/1?show_deleted=true",
+			isValidRequest: true,
+			setup: func() {
+				s.fetcher.EXPECT().
+					GetByID(gomock.Any(), "1", gomock.Any()).
+					Times(1).
+					Return(organization.New(context.Background(), "1", "foo"), error(nil))
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:           "get not found",
+			inMethod:       http.MethodGet,
+			inTarget:       "/organizations/1",
+			isValidRequest: true,
+			setup: func() {
+				s.fetcher.EXPECT().
+					GetByID(gomock.Any(), "1").
+					Times(1).
+					Return(organization.Organization{}, organization.ErrNotFound)
+			},
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:           "update",
+			inMethod:       http.MethodPatch,
+			inTarget:       "/organizations/1",
+			inBody:         `{"name":"bar"}`,
+			isValidRequest: true,
+			setup: func() {
+				s.manager.EXPECT().
+					ModifyByID(gomock.Any(), "1", gomock.Any()).
+					Times(1).
+					Return(organization.New(context.Background(), "1", "bar"), error(nil))
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:           "update remove label",
+			inMethod:       http.MethodPatch,
+			inTarget:       "/organizations/1",
+			inBody:         `{"labels":{"tier":null}}`,
+			isValidRequest: true,
+			setup: func() {
+				s.manager.EXPECT().
+					ModifyByID(gomock.Any(), "1", gomock.Any()).
+					Times(1).
+					Return(organization.New(context.Background(), "1", "foo"), error(nil))
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:           "delete",
+			inMethod:       http.MethodDelete,
+			inTarget:       "/organizations/1",
+			isValidRequest: true,
+			setup: func() {
+				s.manager.EXPECT().
+					DeleteByID(gomock.Any(), "1").
+					Times(1).
+					Return(error(nil))
+			},
+			expStatus: http.StatusNoContent,
+		},
+		{
+			name:           "undelete",
+			inMethod:       http.MethodPost,
+			inTarget:       "/organizations/1:undelete",
+			isValidRequest: true,
+			setup: func() {
+				s.manager.EXPECT().
+					RestoreByID(gomock.Any(), "1").
+					Times(1).
+					Return(organization.New(context.Background(), "1", "foo"), error(nil))
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:           "list",
+			inMethod:       http.MethodGet,
+			inTarget:       "/organizations?page_size=10&order_by=name",
+			isValidRequest: true,
+			setup: func() {
+				s.lister.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&paging.Page[organization.Organization]{
+						TotalItems:    1,
+						NextPageToken: "next",
+						Items: []organization.Organization{
+							organization.New(context.Background(), "1", "foo"),
+						},
+					}, error(nil))
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:           "list empty",
+			inMethod:       http.MethodGet,
+			inTarget:       "/organizations",
+			isValidRequest: true,
+			setup: func() {
+				s.lister.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(&paging.Page[organization.Organization]{Items: []organization.Organization{}}, error(nil))
+			},
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:           "search",
+			inMethod:       http.MethodGet,
+			inTarget:       "/organizations:search?q=foo",
+			isValidRequest: true,
+			setup: func() {
+				s.searcher.EXPECT().
+					Search(gomock.Any(), "foo", gomock.Any()).
+					Times(1).
+					Return(&paging.Page[organization.SearchResult]{
+						TotalItems: 1,
+						Items: []organization.SearchResult{
+							{
+								Organization: organization.New(context.Background(), "1", "foo"),
+								Score:        0.5,
+								Highlight:    "<mark>foo</mark>",
+							},
+						},
+					}, error(nil))
+			},
+			expStatus: http.StatusOK,
+		},
+		{
+			name:      "search without query",
+			inMethod:  http.MethodGet,
+			inTarget:  "/organizations:search",
+			expStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			// act
+			rec := s.serve(tt.inMethod, tt.inTarget, tt.inBody, tt.isValidRequest)
+
+			// assert
+			s.Assert().Equal(tt.expStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package organization
+
+import (
+	"net/http"
+
+	"github.com/hadroncorp/geck/transport"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hadroncorp/service-template/openapi"
+)
+
+// compile-time assertion
+var _ openapi.Describer = (*ControllerHTTP)(nil)
+
+var (
+	_tagsOpenAPI = []string{"Organizations"}
+
+	_paginationParamsOpenAPI = []openapi.Parameter{
+		{Name: "page_size", In: "query", Description: "Maximum number of items per page.", Type: 0},
+		{Name: "page_token", In: "query", Description: "Token of the page to retrieve."},
+	}
+	_showDeletedParamOpenAPI = openapi.Parameter{
+		Name:        "show_deleted",
+		In:          "query",
+		Description: "Include deleted organizations.",
+		Type:        false,
+	}
+	_idempotencyKeyParamOpenAPI = openapi.Parameter{
+		Name:        "Idempotency-Key",
+		In:          "header",
+		Description: "Unique key making retries of the request safe.",
+	}
+	_ifMatchParamOpenAPI = openapi.Parameter{
+		Name:        _headerIfMatch,
+		In:          "header",
+		Description: "Entity tags the organization is expected to match.",
+	}
+	_ifNoneMatchParamOpenAPI = openapi.Parameter{
+		Name:        _headerIfNoneMatch,
+		In:          "header",
+		Description: "Entity tags of the cached copy; the response is 304 if any of them is current.",
+	}
+)
+
+func (c ControllerHTTP) Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			ID:          "CreateOrganization",
+			Method:      http.MethodPost,
+			Handler:     c.register,
+			Summary:     "Creates an organization.",
+			Tags:        _tagsOpenAPI,
+			Parameters:  []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			RequestBody: registerRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusCreated,
+					Description: "Organization created.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag},
+				},
+			},
+		},
+		{
+			ID:      "GetOrganization",
+			Method:  http.MethodGet,
+			Handler: c.get,
+			Summary: "Retrieves an organization.",
+			Tags:    _tagsOpenAPI,
+			Parameters: []openapi.Parameter{
+				_showDeletedParamOpenAPI,
+				_ifNoneMatchParamOpenAPI,
+				{
+					Name:        echo.HeaderIfModifiedSince,
+					In:          "header",
+					Description: "HTTP-date of the cached copy; the response is 304 if it is current.",
+				},
+			},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization found.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag, echo.HeaderLastModified},
+				},
+				{
+					StatusCode:  http.StatusNotModified,
+					Description: "Cached copy is current.",
+					Headers:     []string{_headerETag, echo.HeaderLastModified},
+				},
+			},
+		},
+		{
+			ID:      "GetOrganizationBySlug",
+			Method:  http.MethodGet,
+			Handler: c.getBySlug,
+			Summary: "Retrieves an organization by its slug. Previous slugs redirect to the current one.",
+			Tags:    _tagsOpenAPI,
+			Parameters: []openapi.Parameter{
+				_showDeletedParamOpenAPI,
+				_ifNoneMatchParamOpenAPI,
+				{
+					Name:        echo.HeaderIfModifiedSince,
+					In:          "header",
+					Description: "HTTP-date of the cached copy; the response is 304 if it is current.",
+				},
+			},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization found.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag, echo.HeaderLastModified},
+				},
+				{
+					StatusCode:  http.StatusMovedPermanently,
+					Description: "Previous slug of the organization, redirects to its current slug.",
+					Headers:     []string{echo.HeaderLocation},
+				},
+				{
+					StatusCode:  http.StatusNotModified,
+					Description: "Cached copy is current.",
+					Headers:     []string{_headerETag, echo.HeaderLastModified},
+				},
+			},
+		},
+		{
+			ID:          "UpdateOrganization",
+			Method:      http.MethodPatch,
+			Handler:     c.update,
+			Summary:     "Modifies an organization.",
+			Tags:        _tagsOpenAPI,
+			Parameters:  []openapi.Parameter{_ifMatchParamOpenAPI},
+			RequestBody: updateResponseHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization modified.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag},
+				},
+			},
+		},
+		{
+			ID:         "DeleteOrganization",
+			Method:     http.MethodDelete,
+			Handler:    c.delete,
+			Summary:    "Deletes an organization. Deletion is logical, use UndeleteOrganization to revert it.",
+			Tags:       _tagsOpenAPI,
+			Parameters: []openapi.Parameter{_ifMatchParamOpenAPI},
+		},
+		{
+			ID:           "UndeleteOrganization",
+			Method:       http.MethodPost,
+			Handler:      c.customMethod,
+			CustomMethod: "undelete",
+			Summary:      "Restores a deleted organization.",
+			Tags:         _tagsOpenAPI,
+			Parameters:   []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization restored.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag},
+				},
+			},
+		},
+		{
+			ID:           "MoveOrganization",
+			Method:       http.MethodPost,
+			Handler:      c.customMethod,
+			CustomMethod: "move",
+			Summary:      "Moves an organization under another parent. An empty parent makes it a root organization.",
+			Tags:         _tagsOpenAPI,
+			Parameters:   []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			RequestBody:  moveRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization moved.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag},
+				},
+			},
+		},
+		{
+			ID:           "SuspendOrganization",
+			Method:       http.MethodPost,
+			Handler:      c.customMethod,
+			CustomMethod: "suspend",
+			Summary:      "Suspends an active organization. Suspended organizations are read-only until reactivated.",
+			Tags:         _tagsOpenAPI,
+			Parameters:   []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			RequestBody:  transitionRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization suspended.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag},
+				},
+			},
+		},
+		{
+			ID:           "ReactivateOrganization",
+			Method:       http.MethodPost,
+			Handler:      c.customMethod,
+			CustomMethod: "reactivate",
+			Summary:      "Reactivates a suspended organization.",
+			Tags:         _tagsOpenAPI,
+			Parameters:   []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			RequestBody:  transitionRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization reactivated.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag},
+				},
+			},
+		},
+		{
+			ID:           "ArchiveOrganization",
+			Method:       http.MethodPost,
+			Handler:      c.customMethod,
+			CustomMethod: "archive",
+			Summary:      "Archives an active or suspended organization. Archiving is permanent.",
+			Tags:         _tagsOpenAPI,
+			Parameters:   []openapi.Parameter{_idempotencyKeyParamOpenAPI},
+			RequestBody:  transitionRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization archived.",
+					Body:        transport.DataContainer[responseHTTP]{},
+					Headers:     []string{_headerETag},
+				},
+			},
+		},
+		{
+			ID:      "ListOrganizationChildren",
+			Method:  http.MethodGet,
+			Handler: c.listChildren,
+			Summary: "Lists the children of an organization.",
+			Tags:    _tagsOpenAPI,
+			Parameters: append([]openapi.Parameter{
+				_showDeletedParamOpenAPI,
+			}, _paginationParamsOpenAPI...),
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Page of child organizations.",
+					Body:        transport.DataContainer[transport.PageResponse[responseHTTP]]{},
+				},
+				{
+					StatusCode:  http.StatusNotFound,
+					Description: "The organization has no children.",
+				},
+			},
+		},
+		{
+			ID:      "ListOrganizationAncestors",
+			Method:  http.MethodGet,
+			Handler: c.listAncestors,
+			Summary: "Lists the ancestors of an organization, from its parent up to the root.",
+			Tags:    _tagsOpenAPI,
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Ancestor organizations.",
+					Body:        transport.DataContainer[[]responseHTTP]{},
+				},
+			},
+		},
+		{
+			ID:      "ListOrganizationHistory",
+			Method:  http.MethodGet,
+			Handler: c.listHistory,
+			Summary: "Lists the changes of an organization (audit trail), newest first.",
+			Tags:    _tagsOpenAPI,
+			Parameters: append([]openapi.Parameter{
+				{
+					Name:        "start_time",
+					In:          "query",
+					Description: "RFC 3339 timestamp of the oldest changes to list (inclusive).",
+				},
+				{
+					Name:        "end_time",
+					In:          "query",
+					Description: "RFC 3339 timestamp the listed changes must precede (exclusive).",
+				},
+			}, _paginationParamsOpenAPI...),
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Page of changes.",
+					Body:        transport.DataContainer[transport.PageResponse[historyRecordResponseHTTP]]{},
+				},
+				{
+					StatusCode:  http.StatusNotFound,
+					Description: "No change matched.",
+				},
+			},
+		},
+		{
+			ID:      "ListOrganizations",
+			Method:  http.MethodGet,
+			Handler: c.list,
+			Summary: "Lists organizations.",
+			Tags:    _tagsOpenAPI,
+			Parameters: append([]openapi.Parameter{
+				_showDeletedParamOpenAPI,
+				_ifNoneMatchParamOpenAPI,
+				{Name: "filter", In: "query", Description: "AIP-160 filter expression."},
+				{
+					Name:        "label_selector",
+					In:          "query",
+					Description: "Label selector (e.g. `tier in (gold,platinum),!trial`).",
+				},
+				{Name: "order_by", In: "query", Description: "AIP-132 sort expression."},
+			}, _paginationParamsOpenAPI...),
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Page of organizations.",
+					Body:        transport.DataContainer[transport.PageResponse[responseHTTP]]{},
+					Headers:     []string{_headerETag},
+				},
+				{
+					StatusCode:  http.StatusNotModified,
+					Description: "Cached page is current.",
+					Headers:     []string{_headerETag},
+				},
+				{
+					StatusCode:  http.StatusNotFound,
+					Description: "No organization matched.",
+				},
+			},
+		},
+		{
+			ID:      "SearchOrganizations",
+			Method:  http.MethodGet,
+			Handler: c.search,
+			Summary: "Searches organizations by name, ranked by relevance.",
+			Tags:    _tagsOpenAPI,
+			Parameters: append([]openapi.Parameter{
+				{Name: "q", In: "query", Description: "Search query.", Required: true},
+			}, _paginationParamsOpenAPI...),
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Page of search results.",
+					Body:        transport.DataContainer[transport.PageResponse[searchResponseHTTP]]{},
+				},
+			},
+		},
+	}
+}
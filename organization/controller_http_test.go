@@ -0,0 +1,587 @@
+package organization_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/idempotency"
+	"github.com/hadroncorp/service-template/idempotencymock"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+	"github.com/hadroncorp/service-template/problem"
+)
+
+type controllerHTTPSuite struct {
+	suite.Suite
+}
+
+func TestControllerHTTPSuite(t *testing.T) {
+	suite.Run(t, new(controllerHTTPSuite))
+}
+
+func (s *controllerHTTPSuite) newServer(manager organization.Manager, fetcher organization.Fetcher,
+	lister organization.Lister) *echo.Echo {
+	ctrl := gomock.NewController(s.T())
+	if manager == nil {
+		manager = organizationmock.NewMockManager(ctrl)
+	}
+	if fetcher == nil {
+		fetcher = organizationmock.NewMockFetcher(ctrl)
+	}
+	if lister == nil {
+		lister = organizationmock.NewMockLister(ctrl)
+	}
+	controller := organization.NewControllerHTTP(
+		manager,
+		fetcher,
+		lister,
+		organizationmock.NewMockSearcher(ctrl),
+		organizationmock.NewMockHistoryLister(ctrl),
+		authn.NewMiddlewareHTTP(authn.Config{}, authn.KeySet{}),
+		idempotency.NewMiddlewareHTTP(idempotency.Config{}, idempotencymock.NewMockStore(ctrl), slog.Default()),
+		identifier.FactoryKSUID{},
+		validatorFunc(validator.New().StructCtx),
+		slog.Default(),
+	)
+	e := echo.New()
+	e.HTTPErrorHandler = problem.NewErrorHandlerHTTP(slog.Default()).Handle
+	controller.SetVersionedEndpoints(e.Group(""))
+	return e
+}
+
+func (s *controllerHTTPSuite) TestControllerHTTP_List_Query() {
+	tests := []struct {
+		name            string
+		inFilter        string
+		inOrderBy       string
+		inLabelSelector string
+		inShowDeleted   string
+		inListErr       error
+		expStatus       int
+		// expQuery is nil if the lister must not be called
+		expQuery *organization.ListQuery
+	}{
+		{
+			name:      "no query",
+			expStatus: http.StatusOK,
+			expQuery:  &organization.ListQuery{NonDeletedOnly: true},
+		},
+		{
+			name:      "filter and order",
+			inFilter:  `create_by = "alice" AND create_time >= "2024-01-01T00:00:00Z" AND create_time < "2025-01-01T00:00:00Z" AND name = "acme*"`,
+			inOrderBy: "name desc",
+			expStatus: http.StatusOK,
+			expQuery: &organization.ListQuery{
+				NonDeletedOnly:  true,
+				SortField:       organization.ListSortByName,
+				SortDescending:  true,
+				CreateBy:        "alice",
+				CreateTimeStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				CreateTimeEnd:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				NamePrefix:      "acme",
+			},
+		},
+		{
+			name:      "quoted conjunction",
+			inFilter:  `name = "foo AND bar*"`,
+			expStatus: http.StatusOK,
+			expQuery:  &organization.ListQuery{NonDeletedOnly: true, NamePrefix: "foo AND bar"},
+		},
+		{
+			name:          "show deleted",
+			inShowDeleted: "true",
+			expStatus:     http.StatusOK,
+			expQuery:      &organization.ListQuery{},
+		},
+		{
+			name:          "show deleted changed",
+			inShowDeleted: "true",
+			inListErr:     organization.ErrPageTokenMismatch,
+			expStatus:     http.StatusBadRequest,
+			expQuery:      &organization.ListQuery{},
+		},
+		{
+			name:      "unsupported field",
+			inFilter:  `row_version = 1`,
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "unsupported operator",
+			inFilter:  `create_time > "2024-01-01T00:00:00Z"`,
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "invalid timestamp",
+			inFilter:  `create_time >= "yesterday"`,
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "exact name",
+			inFilter:  `name = "acme"`,
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "unsupported sort field",
+			inOrderBy: "row_version",
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "several sort fields",
+			inOrderBy: "name, create_time desc",
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "invalid sort direction",
+			inOrderBy: "name descending",
+			expStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "filter changed",
+			inFilter:  `create_by = "alice"`,
+			inListErr: organization.ErrPageTokenMismatch,
+			expStatus: http.StatusBadRequest,
+			expQuery:  &organization.ListQuery{NonDeletedOnly: true, CreateBy: "alice"},
+		},
+		{
+			name:            "label selector",
+			inLabelSelector: "tier in (gold,platinum),!legacy",
+			expStatus:       http.StatusOK,
+			expQuery: &organization.ListQuery{
+				NonDeletedOnly: true,
+				LabelSelector: organization.LabelSelector{
+					Requirements: []organization.LabelRequirement{
+						{Key: "tier", Operator: organization.LabelOpIn, Values: []string{"gold", "platinum"}},
+						{Key: "legacy", Operator: organization.LabelOpNotExists},
+					},
+				},
+			},
+		},
+		{
+			name:            "invalid label selector",
+			inLabelSelector: "tier in (gold",
+			expStatus:       http.StatusBadRequest,
+		},
+		{
+			name:            "label selector changed",
+			inLabelSelector: "tier=gold",
+			inListErr:       organization.ErrPageTokenMismatch,
+			expStatus:       http.StatusBadRequest,
+			expQuery: &organization.ListQuery{
+				NonDeletedOnly: true,
+				LabelSelector: organization.LabelSelector{
+					Requirements: []organization.LabelRequirement{
+						{Key: "tier", Operator: organization.LabelOpIn, Values: []string{"gold"}},
+					},
+				},
+			},
+		},
+		{
+			name:      "sort order changed",
+			inOrderBy: "create_time desc",
+			inListErr: organization.ErrPageTokenMismatch,
+			expStatus: http.StatusBadRequest,
+			expQuery: &organization.ListQuery{
+				NonDeletedOnly: true,
+				SortField:      organization.ListSortByCreateTime,
+				SortDescending: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			ctrl := gomock.NewController(s.T())
+			lister := organizationmock.NewMockLister(ctrl)
+			if tt.expQuery != nil {
+				lister.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, opts ...organization.ListOption) (
+						*paging.Page[organization.Organization], error) {
+						s.Assert().Equal(*tt.expQuery, organization.NewListQuery(opts...))
+						if tt.inListErr != nil {
+							return nil, tt.inListErr
+						}
+						return &paging.Page[organization.Organization]{
+							TotalItems: 1,
+							Items: []organization.Organization{
+								organization.New(context.Background(), "1", "foo"),
+							},
+						}, nil
+					})
+			}
+			query := url.Values{}
+			if tt.inFilter != "" {
+				query.Set("filter", tt.inFilter)
+			}
+			if tt.inOrderBy != "" {
+				query.Set("order_by", tt.inOrderBy)
+			}
+			if tt.inLabelSelector != "" {
+				query.Set("label_selector", tt.inLabelSelector)
+			}
+			if tt.inShowDeleted != "" {
+				query.Set("show_deleted", tt.inShowDeleted)
+			}
+			req := httptest.NewRequest(http.MethodGet, "/organizations?"+query.Encode(), nil)
+			rec := httptest.NewRecorder()
+
+			// act
+			s.newServer(nil, nil, lister).ServeHTTP(rec, req)
+
+			// assert
+			s.Assert().Equal(tt.expStatus, rec.Code)
+			if tt.expStatus == http.StatusBadRequest {
+				s.Assert().Contains(rec.Header().Get(echo.HeaderContentType), problem.MIMEApplicationProblemJSON)
+				s.Assert().Contains(rec.Body.String(), string(problem.CodeInvalidArgument))
+			}
+		})
+	}
+}
+
+func (s *controllerHTTPSuite) TestControllerHTTP_Get_Conditional() {
+	org := organization.New(context.Background(), "1", "foo")
+	etag := strconv.Quote(strconv.FormatUint(org.Version(), 10))
+	lastModified := org.CreateTime().UTC().Truncate(time.Second)
+	tests := []struct {
+		name              string
+		inIfNoneMatch     string
+		inIfModifiedSince string
+		expStatus         int
+	}{
+		{
+			name:      "unconditional",
+			expStatus: http.StatusOK,
+		},
+		{
+			name:          "etag match",
+			inIfNoneMatch: `"none", ` + etag,
+			expStatus:     http.StatusNotModified,
+		},
+		{
+			name:          "weak etag match",
+			inIfNoneMatch: "W/" + etag,
+			expStatus:     http.StatusNotModified,
+		},
+		{
+			name:          "any etag",
+			inIfNoneMatch: "*",
+			expStatus:     http.StatusNotModified,
+		},
+		{
+			name:          "etag mismatch",
+			inIfNoneMatch: `"none"`,
+			expStatus:     http.StatusOK,
+		},
+		{
+			name:              "etag takes precedence",
+			inIfNoneMatch:     `"none"`,
+			inIfModifiedSince: lastModified.Format(http.TimeFormat),
+			expStatus:         http.StatusOK,
+		},
+		{
+			name:              "not modified since",
+			inIfModifiedSince: lastModified.Format(http.TimeFormat),
+			expStatus:         http.StatusNotModified,
+		},
+		{
+			name:              "modified since",
+			inIfModifiedSince: lastModified.Add(-time.Second).Format(http.TimeFormat),
+			expStatus:         http.StatusOK,
+		},
+		{
+			name:              "invalid date",
+			inIfModifiedSince: "yesterday",
+			expStatus:         http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			ctrl := gomock.NewController(s.T())
+			fetcher := organizationmock.NewMockFetcher(ctrl)
+			fetcher.EXPECT().
+				GetByID(gomock.Any(), "1").
+				Times(1).
+				Return(org, error(nil))
+			req := httptest.NewRequest(http.MethodGet, "/organizations/1", nil)
+			if tt.inIfNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.inIfNoneMatch)
+			}
+			if tt.inIfModifiedSince != "" {
+				req.Header.Set(echo.HeaderIfModifiedSince, tt.inIfModifiedSince)
+			}
+			rec := httptest.NewRecorder()
+
+			// act
+			s.newServer(nil, fetcher, nil).ServeHTTP(rec, req)
+
+			// assert
+			s.Assert().Equal(tt.expStatus, rec.Code)
+			s.Assert().Equal(etag, rec.Header().Get("ETag"))
+			s.Assert().Equal(lastModified.Format(http.TimeFormat), rec.Header().Get(echo.HeaderLastModified))
+			if tt.expStatus == http.StatusNotModified {
+				s.Assert().Empty(rec.Body.String())
+			}
+		})
+	}
+}
+
+func (s *controllerHTTPSuite) TestControllerHTTP_List_Conditional() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	lister := organizationmock.NewMockLister(ctrl)
+	items := []organization.Organization{
+		organization.New(context.Background(), "1", "foo"),
+	}
+	lister.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(_ context.Context, _ ...organization.ListOption) (
+			*paging.Page[organization.Organization], error) {
+			return &paging.Page[organization.Organization]{
+				TotalItems: len(items),
+				Items:      items,
+			}, nil
+		})
+	server := s.newServer(nil, nil, lister)
+	serve := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/organizations", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// act
+	first := serve("")
+	etag := first.Header().Get("ETag")
+	cached := serve(etag)
+	items = append(items, organization.New(context.Background(), "2", "bar"))
+	changed := serve(etag)
+
+	// assert
+	s.Assert().Equal(http.StatusOK, first.Code)
+	s.Assert().Regexp(`^W/"[0-9a-f]+"$`, etag)
+	s.Assert().Equal(http.StatusNotModified, cached.Code)
+	s.Assert().Empty(cached.Body.String())
+	s.Assert().Equal(http.StatusOK, changed.Code)
+	s.Assert().NotEqual(etag, changed.Header().Get("ETag"))
+}
+
+func (s *controllerHTTPSuite) TestControllerHTTP_List_Conditional_Page_Tokens() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	lister := organizationmock.NewMockLister(ctrl)
+	cipherKey := []byte("0123456789abcdef0123456789abcdef")
+	lister.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(_ context.Context, _ ...organization.ListOption) (
+			*paging.Page[organization.Organization], error) {
+			// tokens are encrypted on every call, thus they differ between responses listing the same page
+			nextToken, err := paging.NewToken(cipherKey, map[string]string{"organization_id": "1"})
+			if err != nil {
+				return nil, err
+			}
+			return &paging.Page[organization.Organization]{
+				TotalItems:    2,
+				NextPageToken: nextToken,
+				Items: []organization.Organization{
+					organization.New(context.Background(), "1", "foo"),
+				},
+			}, nil
+		})
+	server := s.newServer(nil, nil, lister)
+	serve := func(target, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// act
+	first := serve("/organizations?page_size=1", "")
+	etag := first.Header().Get("ETag")
+	cached := serve("/organizations?page_size=1", etag)
+	otherQuery := serve("/organizations?page_size=1&order_by=name", etag)
+
+	// assert
+	s.Assert().Equal(http.StatusOK, first.Code)
+	s.Assert().Equal(http.StatusNotModified, cached.Code)
+	s.Assert().Equal(etag, cached.Header().Get("ETag"))
+	s.Assert().Equal(http.StatusOK, otherQuery.Code)
+	s.Assert().NotEqual(etag, otherQuery.Header().Get("ETag"))
+}
+
+func (s *controllerHTTPSuite) TestControllerHTTP_Update_Conditional() {
+	tests := []struct {
+		name        string
+		inIfMatch   string
+		inModifyErr error
+		expStatus   int
+		expCalled   bool
+		// expVersions are the versions the organization is expected to have, nil if unconditional
+		expVersions []uint64
+	}{
+		{
+			name:      "unconditional",
+			expStatus: http.StatusOK,
+			expCalled: true,
+		},
+		{
+			name:      "any etag",
+			inIfMatch: "*",
+			expStatus: http.StatusOK,
+			expCalled: true,
+		},
+		{
+			name:        "etag match",
+			inIfMatch:   `"3"`,
+			expStatus:   http.StatusOK,
+			expCalled:   true,
+			expVersions: []uint64{3},
+		},
+		{
+			name:        "etag mismatch",
+			inIfMatch:   `"2"`,
+			inModifyErr: organization.ErrVersionConflict,
+			expStatus:   http.StatusPreconditionFailed,
+			expCalled:   true,
+			expVersions: []uint64{2},
+		},
+		{
+			name:      "weak etag",
+			inIfMatch: `W/"3"`,
+			expStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:        "several etags match",
+			inIfMatch:   `"2", "3"`,
+			expStatus:   http.StatusOK,
+			expCalled:   true,
+			expVersions: []uint64{2, 3},
+		},
+		{
+			name:        "several etags mismatch",
+			inIfMatch:   `"1", W/"3", "2"`,
+			inModifyErr: organization.ErrVersionConflict,
+			expStatus:   http.StatusPreconditionFailed,
+			expCalled:   true,
+			expVersions: []uint64{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			ctrl := gomock.NewController(s.T())
+			manager := organizationmock.NewMockManager(ctrl)
+			org := organization.New(context.Background(), "1", "bar")
+			if tt.expCalled {
+				manager.EXPECT().
+					ModifyByID(gomock.Any(), "1", gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, _ string, opts ...organization.UpdateOption) (
+						organization.Organization, error) {
+						s.Assert().Equal(tt.expVersions, organization.NewExpectedVersions(opts...))
+						if tt.inModifyErr != nil {
+							return organization.Organization{}, tt.inModifyErr
+						}
+						return org, nil
+					})
+			}
+			req := httptest.NewRequest(http.MethodPatch, "/organizations/1", strings.NewReader(`{"name":"bar"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.inIfMatch != "" {
+				req.Header.Set("If-Match", tt.inIfMatch)
+			}
+			rec := httptest.NewRecorder()
+
+			// act
+			s.newServer(manager, nil, nil).ServeHTTP(rec, req)
+
+			// assert
+			s.Assert().Equal(tt.expStatus, rec.Code)
+			if tt.expStatus == http.StatusOK {
+				s.Assert().Equal(strconv.Quote(strconv.FormatUint(org.Version(), 10)), rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func (s *controllerHTTPSuite) TestControllerHTTP_Delete_Conditional() {
+	tests := []struct {
+		name        string
+		inIfMatch   string
+		inDeleteErr error
+		expStatus   int
+		expCalled   bool
+	}{
+		{
+			name:      "etag match",
+			inIfMatch: `"3"`,
+			expStatus: http.StatusNoContent,
+			expCalled: true,
+		},
+		{
+			name:        "etag mismatch",
+			inIfMatch:   `"2"`,
+			inDeleteErr: organization.ErrVersionConflict,
+			expStatus:   http.StatusPreconditionFailed,
+			expCalled:   true,
+		},
+		{
+			name:      "weak etag",
+			inIfMatch: `W/"3"`,
+			expStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:      "several etags",
+			inIfMatch: `"2", "3"`,
+			expStatus: http.StatusNoContent,
+			expCalled: true,
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			// arrange
+			ctrl := gomock.NewController(s.T())
+			manager := organizationmock.NewMockManager(ctrl)
+			if tt.expCalled {
+				manager.EXPECT().
+					DeleteByID(gomock.Any(), "1", gomock.Len(1)).
+					Times(1).
+					Return(tt.inDeleteErr)
+			}
+			req := httptest.NewRequest(http.MethodDelete, "/organizations/1", nil)
+			req.Header.Set("If-Match", tt.inIfMatch)
+			rec := httptest.NewRecorder()
+
+			// act
+			s.newServer(manager, nil, nil).ServeHTTP(rec, req)
+
+			// assert
+			s.Assert().Equal(tt.expStatus, rec.Code)
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/hadroncorp/service-template/notification"
 )
 
-// ControllerKafka is the Apache Kafka controller listening to events related to the notifications domain context.
+// ControllerKafka is the Apache Kafka controller listening to events related to the organizations domain context.
 type ControllerKafka struct {
 	logger         *slog.Logger
 	sender         notification.Sender
@@ -43,6 +43,7 @@ func (c ControllerKafka) RegisterReaders(rm kafka.ReaderManager) {
 			kinterceptor.UseDeadLetter(c.producerClient, ""),
 		),
 	)
+	// DEV-NOTE: Cached organizations are evicted by CacheReaderKafka, which reads without consumer group.
 }
 
 func (c ControllerKafka) sendEmailToOrgAdmin(_ context.Context, record *kgo.Record) error {
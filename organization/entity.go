@@ -2,6 +2,8 @@ package organization
 
 import (
 	"context"
+	"maps"
+	"strings"
 
 	"github.com/hadroncorp/geck/event"
 	"github.com/hadroncorp/geck/persistence/audit"
@@ -17,14 +19,41 @@ type Organization struct {
 	event.AggregatorTemplate
 	id   string
 	name string
+	// slug is the human-friendly handle of the organization, unique (case-insensitive) among every organization.
+	slug    string
+	profile Profile
+	labels  Labels
+	status  Status
+	// statusReason is the reason code of the latest status transition, empty if the organization never
+	// transitioned.
+	statusReason StatusReason
+	// parentID is empty for root organizations.
+	parentID string
+	// persistedVersion is the version the organization is expected to have in the persistence store, used to
+	// detect concurrent modifications (optimistic concurrency control).
+	persistedVersion uint64
+	// expectedVersions are the versions the caller expects the organization to have in the persistence store (any
+	// of them), empty if the caller has no expectation.
+	expectedVersions []uint64
+	// persistedSlug is the slug the organization has in the persistence store, kept as a redirect once the slug
+	// changes.
+	persistedSlug string
 }
 
 // New creates a new [Organization] with the given ID and name.
-func New(ctx context.Context, id string, name string) Organization {
+//
+// The organization is a root organization unless [WithParent] is given. Its slug is generated from name unless
+// [WithSlug] is given.
+func New(ctx context.Context, id string, name string, opts ...CreateOption) Organization {
 	org := Organization{
 		Auditable: audit.NewWithDefaults(ctx),
 		id:        id,
 		name:      name,
+		slug:      NewSlug(name),
+		status:    StatusActive,
+	}
+	for _, opt := range opts {
+		opt(&org)
 	}
 	org.RegisterEvents(newCreatedEvent(org))
 	return org
@@ -40,6 +69,42 @@ func (o Organization) Name() string {
 	return o.name
 }
 
+// Slug returns the human-friendly handle of the organization.
+func (o Organization) Slug() string {
+	return o.slug
+}
+
+// Profile returns the descriptive attributes of the organization.
+func (o Organization) Profile() Profile {
+	return o.profile
+}
+
+// Labels returns a copy of the labels of the organization.
+func (o Organization) Labels() Labels {
+	return maps.Clone(o.labels)
+}
+
+// Status returns the status of the organization.
+func (o Organization) Status() Status {
+	return o.status
+}
+
+// StatusReason returns the reason code of the latest status transition of the organization. It is empty if the
+// organization never transitioned.
+func (o Organization) StatusReason() StatusReason {
+	return o.statusReason
+}
+
+// IsActive checks whether the organization is active, only active organizations are writable.
+func (o Organization) IsActive() bool {
+	return o.status == StatusActive
+}
+
+// ParentID returns the unique identifier of the parent organization. It is empty for root organizations.
+func (o Organization) ParentID() string {
+	return o.parentID
+}
+
 // Update updates the [Organization] with the given options.
 //
 // It returns true if the organization was updated, false otherwise.
@@ -57,13 +122,137 @@ func (o *Organization) Update(ctx context.Context, opts ...UpdateOption) bool {
 }
 
 // Delete deletes the [Organization].
-func (o *Organization) Delete(ctx context.Context) {
+//
+// Deletion is logical (soft delete), use [Organization.Restore] to revert it.
+func (o *Organization) Delete(ctx context.Context, opts ...DeleteOption) {
+	for _, opt := range opts {
+		opt(o)
+	}
 	audit.Delete(ctx, &o.Auditable)
 	o.RegisterEvents(newDeletedEvent(o))
 }
 
+// Restore restores a deleted [Organization].
+//
+// It returns true if the organization was restored, false otherwise (i.e. it was not deleted).
+func (o *Organization) Restore(ctx context.Context) bool {
+	if !o.IsDeleted() {
+		return false // no-op
+	}
+
+	o.Auditable = audit.New(audit.NewArgs{
+		CreateTime:     o.CreateTime(),
+		CreateBy:       o.CreateBy(),
+		LastUpdateTime: o.LastUpdateTime(),
+		LastUpdateBy:   o.LastUpdateBy(),
+		Version:        o.Version(),
+		IsDeleted:      false,
+	})
+	audit.Update(ctx, &o.Auditable)
+	o.RegisterEvents(newRestoredEvent(o))
+	return true
+}
+
+// MoveUnder moves the [Organization] under the organization identified by parentID. Use an empty parentID to
+// make it a root organization.
+//
+// It returns true if the organization was moved, false otherwise (i.e. it was already under parentID). Hierarchy
+// rules (e.g. cycles) are enforced by [Manager] implementations as they span several organizations.
+func (o *Organization) MoveUnder(ctx context.Context, parentID string) bool {
+	if o.parentID == parentID {
+		return false // no-op
+	}
+
+	prevParentID := o.parentID
+	o.parentID = parentID
+	audit.Update(ctx, &o.Auditable)
+	o.RegisterEvents(newMovedEvent(o, prevParentID))
+	return true
+}
+
+// Suspend suspends the active [Organization] for the given reason.
+//
+// It fails with [ErrInvalidStatusTransition] if the organization is not active.
+func (o *Organization) Suspend(ctx context.Context, reason StatusReason) error {
+	if err := o.transitionTo(StatusSuspended, reason); err != nil {
+		return err
+	}
+	audit.Update(ctx, &o.Auditable)
+	o.RegisterEvents(newSuspendedEvent(o))
+	return nil
+}
+
+// Reactivate reactivates the suspended [Organization] for the given reason.
+//
+// It fails with [ErrInvalidStatusTransition] if the organization is not suspended.
+func (o *Organization) Reactivate(ctx context.Context, reason StatusReason) error {
+	if err := o.transitionTo(StatusActive, reason); err != nil {
+		return err
+	}
+	audit.Update(ctx, &o.Auditable)
+	o.RegisterEvents(newReactivatedEvent(o))
+	return nil
+}
+
+// Archive archives the active or suspended [Organization] for the given reason. Archiving is permanent.
+//
+// It fails with [ErrInvalidStatusTransition] if the organization is already archived.
+func (o *Organization) Archive(ctx context.Context, reason StatusReason) error {
+	prevStatus := o.status
+	if err := o.transitionTo(StatusArchived, reason); err != nil {
+		return err
+	}
+	audit.Update(ctx, &o.Auditable)
+	o.RegisterEvents(newArchivedEvent(o, prevStatus))
+	return nil
+}
+
+// transitionTo moves the [Organization] to the status next for the given reason, enforcing the status lifecycle.
+func (o *Organization) transitionTo(next Status, reason StatusReason) error {
+	if !reason.IsValid() {
+		return ErrInvalidStatusReason
+	} else if !o.status.CanTransitionTo(next) {
+		return ErrInvalidStatusTransition
+	}
+	o.status = next
+	o.statusReason = reason
+	return nil
+}
+
 // -- Option(s) --
 
+// CreateOption is a function that configures a new [Organization].
+type CreateOption func(o *Organization)
+
+// WithParent sets the parent of the new [Organization].
+func WithParent(parentID string) CreateOption {
+	return func(o *Organization) {
+		o.parentID = parentID
+	}
+}
+
+// WithSlug sets the slug of the new [Organization].
+func WithSlug(slug string) CreateOption {
+	return func(o *Organization) {
+		o.slug = strings.ToLower(slug)
+	}
+}
+
+// WithProfile sets the descriptive attributes of the new [Organization]. Attributes are normalized (e.g. country
+// codes in uppercase), use [Profile.Validate] to check them.
+func WithProfile(profile Profile) CreateOption {
+	return func(o *Organization) {
+		o.profile = profile.normalize()
+	}
+}
+
+// WithLabels sets the labels of the new [Organization], use [Labels.Validate] to check them.
+func WithLabels(labels Labels) CreateOption {
+	return func(o *Organization) {
+		o.labels = maps.Clone(labels)
+	}
+}
+
 // UpdateOption is a function that updates an [Organization].
 type UpdateOption func(o *Organization)
 
@@ -77,3 +266,172 @@ func WithUpdatedName(name *string) UpdateOption {
 		o.name = lo.FromPtr(name)
 	}
 }
+
+// WithUpdatedSlug sets the new slug of the [Organization]. Slugs are case-insensitive, they are stored in lowercase.
+//
+// The previous slug is kept as a redirect to the [Organization].
+func WithUpdatedSlug(slug *string) UpdateOption {
+	if slug == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.slug = strings.ToLower(lo.FromPtr(slug))
+	}
+}
+
+// WithUpdatedDescription sets the description of the [Organization]. Use an empty value to unset it.
+func WithUpdatedDescription(description *string) UpdateOption {
+	if description == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.Description = lo.FromPtr(description)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedWebsiteURL sets the website URL of the [Organization]. Use an empty value to unset it.
+func WithUpdatedWebsiteURL(websiteURL *string) UpdateOption {
+	if websiteURL == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.WebsiteURL = lo.FromPtr(websiteURL)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedLogoURL sets the logo URL of the [Organization]. Use an empty value to unset it.
+func WithUpdatedLogoURL(logoURL *string) UpdateOption {
+	if logoURL == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.LogoURL = lo.FromPtr(logoURL)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedCountry sets the ISO 3166-1 alpha-2 code of the country of the [Organization]. Use an empty value to unset it.
+func WithUpdatedCountry(country *string) UpdateOption {
+	if country == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.Country = lo.FromPtr(country)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedLocale sets the default BCP 47 locale of the [Organization]. Use an empty value to unset it.
+func WithUpdatedLocale(locale *string) UpdateOption {
+	if locale == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.Locale = lo.FromPtr(locale)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedTimeZone sets the default IANA time zone of the [Organization]. Use an empty value to unset it.
+func WithUpdatedTimeZone(timeZone *string) UpdateOption {
+	if timeZone == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.TimeZone = lo.FromPtr(timeZone)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedLegalID sets the company register identifier of the [Organization]. Use an empty value to unset it.
+func WithUpdatedLegalID(legalID *string) UpdateOption {
+	if legalID == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.LegalID = lo.FromPtr(legalID)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedTaxID sets the tax identifier of the [Organization]. Use an empty value to unset it.
+func WithUpdatedTaxID(taxID *string) UpdateOption {
+	if taxID == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		o.profile.TaxID = lo.FromPtr(taxID)
+		o.profile = o.profile.normalize()
+	}
+}
+
+// WithUpdatedLabels merges labels into the labels of the [Organization] (JSON merge patch semantics): labels with
+// a value are set, labels with a nil value are removed and labels left out are not modified.
+func WithUpdatedLabels(labels map[string]*string) UpdateOption {
+	if labels == nil {
+		// no-op
+		return func(_ *Organization) {}
+	}
+	return func(o *Organization) {
+		merged := maps.Clone(o.labels)
+		if merged == nil {
+			merged = make(Labels, len(labels))
+		}
+		for key, value := range labels {
+			if value == nil {
+				delete(merged, key)
+				continue
+			}
+			merged[key] = *value
+		}
+		o.labels = merged
+	}
+}
+
+// WithExpectedVersion sets the version the [Organization] is expected to have before the update.
+//
+// Saving the [Organization] fails with [ErrVersionConflict] if the stored version differs.
+func WithExpectedVersion(version uint64) UpdateOption {
+	return WithExpectedVersions(version)
+}
+
+// WithExpectedVersions sets the versions the [Organization] is expected to have before the update, any of them
+// (e.g. several entity tags sent through the If-Match header).
+//
+// Saving the [Organization] fails with [ErrVersionConflict] if the stored version is none of them.
+func WithExpectedVersions(versions ...uint64) UpdateOption {
+	return func(o *Organization) {
+		o.expectedVersions = versions
+	}
+}
+
+// DeleteOption is a function that configures the deletion of an [Organization].
+type DeleteOption func(o *Organization)
+
+// WithDeleteExpectedVersion sets the version the [Organization] is expected to have before the deletion.
+//
+// Deleting the [Organization] fails with [ErrVersionConflict] if the stored version differs.
+func WithDeleteExpectedVersion(version uint64) DeleteOption {
+	return WithDeleteExpectedVersions(version)
+}
+
+// WithDeleteExpectedVersions sets the versions the [Organization] is expected to have before the deletion, any of
+// them.
+//
+// Deleting the [Organization] fails with [ErrVersionConflict] if the stored version is none of them.
+func WithDeleteExpectedVersions(versions ...uint64) DeleteOption {
+	return func(o *Organization) {
+		o.expectedVersions = versions
+	}
+}
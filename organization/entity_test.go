@@ -111,3 +111,49 @@ func TestOrganization_Delete(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotZero(t, len(payload))
 }
+
+func TestOrganization_Restore(t *testing.T) {
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	tests := []struct {
+		name        string
+		inDeleted   bool
+		expRestored bool
+		expEvents   int
+	}{
+		{
+			name:        "deleted",
+			inDeleted:   true,
+			expRestored: true,
+			expEvents:   3,
+		},
+		{
+			name:        "no-op",
+			inDeleted:   false,
+			expRestored: false,
+			expEvents:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			org := organization.New(ctx, "1", "acme-corp")
+			if tt.inDeleted {
+				org.Delete(ctx)
+			}
+			restored := org.Restore(ctx)
+			assert.Equal(t, tt.expRestored, restored)
+			assert.False(t, org.IsDeleted())
+			assert.Equal(t, "acme-corp", org.Name())
+
+			// assert event generation
+			events := org.PullEvents()
+			require.Len(t, events, tt.expEvents)
+			if tt.expEvents == 1 {
+				return
+			}
+			assert.Equal(t, "hadron.iam.organization.restored", events[2].Topic().String())
+			payload, err := events[2].Bytes()
+			assert.NoError(t, err)
+			assert.NotZero(t, len(payload))
+		})
+	}
+}
@@ -26,6 +26,21 @@ var (
 	// TopicDeleted is the event topic for organization deletion.
 	TopicDeleted = event.NewTopic("hadron", "organization", "deleted",
 		event.WithPlatform("iam"))
+	// TopicRestored is the event topic for organization restoration (undelete).
+	TopicRestored = event.NewTopic("hadron", "organization", "restored",
+		event.WithPlatform("iam"))
+	// TopicMoved is the event topic for organization moves within the hierarchy.
+	TopicMoved = event.NewTopic("hadron", "organization", "moved",
+		event.WithPlatform("iam"))
+	// TopicSuspended is the event topic for organization suspension.
+	TopicSuspended = event.NewTopic("hadron", "organization", "suspended",
+		event.WithPlatform("iam"))
+	// TopicReactivated is the event topic for organization reactivation (after a suspension).
+	TopicReactivated = event.NewTopic("hadron", "organization", "reactivated",
+		event.WithPlatform("iam"))
+	// TopicArchived is the event topic for organization archival.
+	TopicArchived = event.NewTopic("hadron", "organization", "archived",
+		event.WithPlatform("iam"))
 )
 
 // CreatedEvent is an event that is emitted when an organization is created.
@@ -58,6 +73,11 @@ func (e CreatedEvent) Bytes() ([]byte, error) {
 		Name:           e.src.name,
 		CreateTime:     timestamppb.New(e.src.CreateTime()),
 		CreateBy:       e.src.CreateBy(),
+		ParentId:       e.src.parentID,
+		Slug:           e.src.slug,
+		Profile:        newProfileProto(e.src.profile),
+		Status:         string(e.src.status),
+		Labels:         e.src.labels,
 	})
 }
 
@@ -81,6 +101,20 @@ func (e CreatedEvent) SchemaSource() string {
 	return reflect.TypeFor[iampb.OrganizationCreatedEvent]().PkgPath()
 }
 
+// newProfileProto converts profile into its event schema counterpart.
+func newProfileProto(profile Profile) *iampb.OrganizationProfile {
+	return &iampb.OrganizationProfile{
+		Description: profile.Description,
+		WebsiteUrl:  profile.WebsiteURL,
+		LogoUrl:     profile.LogoURL,
+		Country:     profile.Country,
+		Locale:      profile.Locale,
+		TimeZone:    profile.TimeZone,
+		LegalId:     profile.LegalID,
+		TaxId:       profile.TaxID,
+	}
+}
+
 // UpdatedEvent is an event that is emitted when an organization is updated.
 type UpdatedEvent struct {
 	src   *Organization
@@ -111,6 +145,10 @@ func (e UpdatedEvent) Bytes() ([]byte, error) {
 		Name:           e.src.name,
 		UpdateTime:     timestamppb.New(e.src.LastUpdateTime()),
 		UpdateBy:       e.src.LastUpdateBy(),
+		Slug:           e.src.slug,
+		Profile:        newProfileProto(e.src.profile),
+		Status:         string(e.src.status),
+		Labels:         e.src.labels,
 	})
 }
 
@@ -185,3 +223,274 @@ func (e DeletedEvent) OccurrenceTime() time.Time {
 func (e DeletedEvent) SchemaSource() string {
 	return reflect.TypeFor[iampb.OrganizationDeletedEvent]().PkgPath()
 }
+
+// RestoredEvent is an event that is emitted when a deleted organization is restored.
+type RestoredEvent struct {
+	src   *Organization
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*RestoredEvent)(nil)
+
+func newRestoredEvent(src *Organization) RestoredEvent {
+	return RestoredEvent{
+		src:   src,
+		topic: TopicRestored,
+	}
+}
+
+func (e RestoredEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e RestoredEvent) Key() string {
+	return e.src.id
+}
+
+func (e RestoredEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.OrganizationRestoredEvent{
+		OrganizationId: e.src.id,
+		Name:           e.src.name,
+		RestoreTime:    timestamppb.New(e.src.LastUpdateTime()),
+		RestoreBy:      e.src.LastUpdateBy(),
+	})
+}
+
+func (e RestoredEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e RestoredEvent) Source() string {
+	return _eventSource
+}
+
+func (e RestoredEvent) Subject() string {
+	return e.src.id
+}
+
+func (e RestoredEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e RestoredEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.OrganizationRestoredEvent]().PkgPath()
+}
+
+// MovedEvent is an event that is emitted when an organization is moved under another parent.
+type MovedEvent struct {
+	src          *Organization
+	prevParentID string
+	topic        event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*MovedEvent)(nil)
+
+func newMovedEvent(src *Organization, prevParentID string) MovedEvent {
+	return MovedEvent{
+		src:          src,
+		prevParentID: prevParentID,
+		topic:        TopicMoved,
+	}
+}
+
+func (e MovedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e MovedEvent) Key() string {
+	return e.src.id
+}
+
+func (e MovedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.OrganizationMovedEvent{
+		OrganizationId:   e.src.id,
+		ParentId:         e.src.parentID,
+		PreviousParentId: e.prevParentID,
+		MoveTime:         timestamppb.New(e.src.LastUpdateTime()),
+		MoveBy:           e.src.LastUpdateBy(),
+	})
+}
+
+func (e MovedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e MovedEvent) Source() string {
+	return _eventSource
+}
+
+func (e MovedEvent) Subject() string {
+	return e.src.id
+}
+
+func (e MovedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e MovedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.OrganizationMovedEvent]().PkgPath()
+}
+
+// SuspendedEvent is an event that is emitted when an active organization is suspended.
+type SuspendedEvent struct {
+	src   *Organization
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*SuspendedEvent)(nil)
+
+func newSuspendedEvent(src *Organization) SuspendedEvent {
+	return SuspendedEvent{
+		src:   src,
+		topic: TopicSuspended,
+	}
+}
+
+func (e SuspendedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e SuspendedEvent) Key() string {
+	return e.src.id
+}
+
+func (e SuspendedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.OrganizationSuspendedEvent{
+		OrganizationId: e.src.id,
+		Reason:         string(e.src.statusReason),
+		SuspendTime:    timestamppb.New(e.src.LastUpdateTime()),
+		SuspendBy:      e.src.LastUpdateBy(),
+	})
+}
+
+func (e SuspendedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e SuspendedEvent) Source() string {
+	return _eventSource
+}
+
+func (e SuspendedEvent) Subject() string {
+	return e.src.id
+}
+
+func (e SuspendedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e SuspendedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.OrganizationSuspendedEvent]().PkgPath()
+}
+
+// ReactivatedEvent is an event that is emitted when a suspended organization is reactivated.
+type ReactivatedEvent struct {
+	src   *Organization
+	topic event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*ReactivatedEvent)(nil)
+
+func newReactivatedEvent(src *Organization) ReactivatedEvent {
+	return ReactivatedEvent{
+		src:   src,
+		topic: TopicReactivated,
+	}
+}
+
+func (e ReactivatedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e ReactivatedEvent) Key() string {
+	return e.src.id
+}
+
+func (e ReactivatedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.OrganizationReactivatedEvent{
+		OrganizationId: e.src.id,
+		Reason:         string(e.src.statusReason),
+		ReactivateTime: timestamppb.New(e.src.LastUpdateTime()),
+		ReactivateBy:   e.src.LastUpdateBy(),
+	})
+}
+
+func (e ReactivatedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e ReactivatedEvent) Source() string {
+	return _eventSource
+}
+
+func (e ReactivatedEvent) Subject() string {
+	return e.src.id
+}
+
+func (e ReactivatedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e ReactivatedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.OrganizationReactivatedEvent]().PkgPath()
+}
+
+// ArchivedEvent is an event that is emitted when an organization is archived.
+type ArchivedEvent struct {
+	src        *Organization
+	prevStatus Status
+	topic      event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*ArchivedEvent)(nil)
+
+func newArchivedEvent(src *Organization, prevStatus Status) ArchivedEvent {
+	return ArchivedEvent{
+		src:        src,
+		prevStatus: prevStatus,
+		topic:      TopicArchived,
+	}
+}
+
+func (e ArchivedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e ArchivedEvent) Key() string {
+	return e.src.id
+}
+
+func (e ArchivedEvent) Bytes() ([]byte, error) {
+	return proto.Marshal(&iampb.OrganizationArchivedEvent{
+		OrganizationId: e.src.id,
+		Reason:         string(e.src.statusReason),
+		PreviousStatus: string(e.prevStatus),
+		ArchiveTime:    timestamppb.New(e.src.LastUpdateTime()),
+		ArchiveBy:      e.src.LastUpdateBy(),
+	})
+}
+
+func (e ArchivedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e ArchivedEvent) Source() string {
+	return _eventSource
+}
+
+func (e ArchivedEvent) Subject() string {
+	return e.src.id
+}
+
+func (e ArchivedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e ArchivedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.OrganizationArchivedEvent]().PkgPath()
+}
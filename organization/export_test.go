@@ -0,0 +1,49 @@
+package organization
+
+import "time"
+
+// ListQuery is the state [ListOption] items set, exposed to tests of the transport layer.
+type ListQuery struct {
+	NonDeletedOnly  bool
+	SortField       ListSortField
+	SortDescending  bool
+	CreateBy        string
+	CreateTimeStart time.Time
+	CreateTimeEnd   time.Time
+	NamePrefix      string
+	ParentID        string
+	Status          Status
+	LabelSelector   LabelSelector
+	MemberUserID    string
+}
+
+// NewListQuery applies opts and returns the resulting [ListQuery]. Page options are left out.
+func NewListQuery(opts ...ListOption) ListQuery {
+	options := listOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return ListQuery{
+		NonDeletedOnly:  options.findNonDeletedOnly,
+		SortField:       options.sortField,
+		SortDescending:  options.sortDescending,
+		CreateBy:        options.createBy,
+		CreateTimeStart: options.createTimeStart,
+		CreateTimeEnd:   options.createTimeEnd,
+		NamePrefix:      options.namePrefix,
+		ParentID:        options.parentID,
+		Status:          options.status,
+		LabelSelector:   options.labelSelector,
+		MemberUserID:    options.memberUserID,
+	}
+}
+
+// NewExpectedVersions applies opts and returns the versions the organization is expected to have (see
+// [WithExpectedVersions]), nil if unset.
+func NewExpectedVersions(opts ...UpdateOption) []uint64 {
+	org := Organization{}
+	for _, opt := range opts {
+		opt(&org)
+	}
+	return org.expectedVersions
+}
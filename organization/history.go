@@ -0,0 +1,91 @@
+package organization
+
+import (
+	"time"
+)
+
+// Operation is the [Manager] operation a [HistoryRecord] audits.
+type Operation string
+
+const (
+	// OperationRegister audits [Manager.Register].
+	OperationRegister Operation = "register"
+	// OperationModify audits [Manager.ModifyByID].
+	OperationModify Operation = "modify"
+	// OperationDelete audits [Manager.DeleteByID].
+	OperationDelete Operation = "delete"
+	// OperationRestore audits [Manager.RestoreByID].
+	OperationRestore Operation = "restore"
+	// OperationPurge audits [Manager.PurgeByID].
+	OperationPurge Operation = "purge"
+	// OperationMove audits [Manager.MoveUnder].
+	OperationMove Operation = "move"
+	// OperationSuspend audits [Manager.Suspend].
+	OperationSuspend Operation = "suspend"
+	// OperationReactivate audits [Manager.Reactivate].
+	OperationReactivate Operation = "reactivate"
+	// OperationArchive audits [Manager.Archive].
+	OperationArchive Operation = "archive"
+)
+
+// HistoryRecord is an immutable entry of the audit trail of an [Organization], appended every time a [Manager]
+// operation changes it.
+type HistoryRecord struct {
+	// ID is the unique identifier of the record, records appended later have greater identifiers.
+	ID             int64
+	OrganizationID string
+	Operation      Operation
+	// Actor is the unique identifier of the principal who performed the operation.
+	Actor string
+	// RequestID is the identifier of the request the operation was performed on, it might be empty (e.g.
+	// operations triggered by events).
+	RequestID string
+	// Before is the state of the organization before the operation, nil if it did not exist (i.e. registered).
+	Before *Snapshot
+	// After is the state of the organization after the operation, nil if it no longer exists (i.e. purged).
+	After *Snapshot
+	Time  time.Time
+}
+
+// Snapshot is the state of an [Organization] at a point in time.
+type Snapshot struct {
+	Name         string       `json:"name"`
+	Slug         string       `json:"slug"`
+	ParentID     string       `json:"parent_id,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	WebsiteURL   string       `json:"website_url,omitempty"`
+	LogoURL      string       `json:"logo_url,omitempty"`
+	Country      string       `json:"country,omitempty"`
+	Locale       string       `json:"locale,omitempty"`
+	TimeZone     string       `json:"time_zone,omitempty"`
+	LegalID      string       `json:"legal_id,omitempty"`
+	TaxID        string       `json:"tax_id,omitempty"`
+	Status       Status       `json:"status"`
+	StatusReason StatusReason `json:"status_reason,omitempty"`
+	Labels       Labels       `json:"labels,omitempty"`
+	IsDeleted    bool         `json:"is_deleted"`
+	Version      uint64       `json:"version"`
+}
+
+// newSnapshot captures the current state of org.
+func newSnapshot(org Organization) *Snapshot {
+	profile := org.Profile()
+	return &Snapshot{
+		Name:         org.Name(),
+		Slug:         org.Slug(),
+		ParentID:     org.ParentID(),
+		Description:  profile.Description,
+		WebsiteURL:   profile.WebsiteURL,
+		LogoURL:      profile.LogoURL,
+		Country:      profile.Country,
+		Locale:       profile.Locale,
+		TimeZone:     profile.TimeZone,
+		LegalID:      profile.LegalID,
+		TaxID:        profile.TaxID,
+		Status:       org.Status(),
+		StatusReason: org.StatusReason(),
+		Labels:       org.Labels(),
+		IsDeleted:    org.IsDeleted(),
+		Version:      org.Version(),
+	}
+}
@@ -0,0 +1,230 @@
+package organization
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrInvalidLabels is returned when a label of the organization is invalid (see [Labels.Validate]).
+	ErrInvalidLabels = errors.New("organization: invalid labels")
+	// ErrInvalidLabelSelector is returned when a label selector cannot be parsed (see [ParseLabelSelector]).
+	ErrInvalidLabelSelector = errors.New("organization: invalid label selector")
+)
+
+const (
+	// MaxLabels is the maximum number of labels of an [Organization].
+	MaxLabels = 64
+	// MaxLabelNameLength is the maximum number of characters of label values and of label key names (the part
+	// after the prefix).
+	MaxLabelNameLength = 63
+	// MaxLabelPrefixLength is the maximum number of characters of label key prefixes.
+	MaxLabelPrefixLength = 253
+)
+
+// DEV-NOTE: Labels follow the Kubernetes syntax (https://kubernetes.io/docs/concepts/overview/working-with-objects/labels),
+// so tooling and habits of platform teams carry over.
+
+var (
+	// _labelNameRegexp matches label key names and non-empty label values.
+	_labelNameRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	// _labelPrefixRegexp matches label key prefixes (DNS subdomains, e.g. billing.hadron.io).
+	_labelPrefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// Labels are key/value pairs platform teams tag organizations with (e.g. tier=enterprise), use a [LabelSelector]
+// to filter organizations by them.
+//
+// Keys are made of an optional prefix and a name separated by a slash (e.g. billing.hadron.io/tier), values might
+// be empty.
+type Labels map[string]string
+
+// Validate checks every label, it returns an error wrapping [ErrInvalidLabels] describing the first invalid
+// label.
+func (l Labels) Validate() error {
+	if len(l) > MaxLabels {
+		return fmt.Errorf("%w: must be at most %d labels", ErrInvalidLabels, MaxLabels)
+	}
+	// sorted, so the reported label does not depend on map iteration order
+	for _, key := range slices.Sorted(maps.Keys(l)) {
+		if err := validateLabelKey(key); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidLabels, err)
+		} else if err = validateLabelValue(l[key]); err != nil {
+			return fmt.Errorf("%w: key %q %w", ErrInvalidLabels, key, err)
+		}
+	}
+	return nil
+}
+
+// validateLabelKey checks whether key is a valid label key.
+func validateLabelKey(key string) error {
+	prefix, name, hasPrefix := strings.Cut(key, "/")
+	if !hasPrefix {
+		prefix, name = "", key
+	} else if prefix == "" || len(prefix) > MaxLabelPrefixLength || !_labelPrefixRegexp.MatchString(prefix) {
+		return fmt.Errorf("key %q prefix must be a DNS subdomain of at most %d characters", key,
+			MaxLabelPrefixLength)
+	}
+	if len(name) > MaxLabelNameLength || !_labelNameRegexp.MatchString(name) {
+		return fmt.Errorf("key %q name must be at most %d alphanumeric characters, '-', '_' or '.'", key,
+			MaxLabelNameLength)
+	}
+	return nil
+}
+
+// validateLabelValue checks whether value is a valid label value.
+func validateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	} else if len(value) > MaxLabelNameLength || !_labelNameRegexp.MatchString(value) {
+		return fmt.Errorf("value %q must be at most %d alphanumeric characters, '-', '_' or '.'", value,
+			MaxLabelNameLength)
+	}
+	return nil
+}
+
+// LabelOperator is the operator of a [LabelRequirement].
+type LabelOperator string
+
+const (
+	// LabelOpExists requires the label key to be set, whatever its value.
+	LabelOpExists LabelOperator = "exists"
+	// LabelOpNotExists requires the label key not to be set.
+	LabelOpNotExists LabelOperator = "!"
+	// LabelOpIn requires the label value to be one of the requirement values.
+	LabelOpIn LabelOperator = "in"
+	// LabelOpNotIn requires the label value not to be any of the requirement values. Organizations without the
+	// label key match.
+	LabelOpNotIn LabelOperator = "notin"
+)
+
+// LabelRequirement is a single condition of a [LabelSelector] (e.g. tier in (gold,platinum)).
+type LabelRequirement struct {
+	Key      string        `json:"key"`
+	Operator LabelOperator `json:"op"`
+	// Values is empty for [LabelOpExists] and [LabelOpNotExists].
+	Values []string `json:"values,omitempty"`
+}
+
+// Matches checks whether labels satisfy the requirement.
+func (r LabelRequirement) Matches(labels Labels) bool {
+	value, ok := labels[r.Key]
+	switch r.Operator {
+	case LabelOpExists:
+		return ok
+	case LabelOpNotExists:
+		return !ok
+	case LabelOpIn:
+		return ok && slices.Contains(r.Values, value)
+	case LabelOpNotIn:
+		return !ok || !slices.Contains(r.Values, value)
+	default:
+		return false
+	}
+}
+
+// LabelSelector is a conjunction of [LabelRequirement] items, organizations match if their labels satisfy every
+// requirement. The zero value matches every organization.
+type LabelSelector struct {
+	Requirements []LabelRequirement
+}
+
+// IsEmpty checks whether the selector has no requirements (i.e. it matches every organization).
+func (s LabelSelector) IsEmpty() bool {
+	return len(s.Requirements) == 0
+}
+
+// Matches checks whether labels satisfy every requirement of the selector.
+func (s LabelSelector) Matches(labels Labels) bool {
+	for _, r := range s.Requirements {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// _labelSetRequirementRegexp matches set-based requirements (e.g. tier in (gold,platinum)).
+	_labelSetRequirementRegexp = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+	// _labelEqualityRequirementRegexp matches equality-based requirements (e.g. tier=gold, tier!=gold).
+	_labelEqualityRequirementRegexp = regexp.MustCompile(`^([^=!\s]+)\s*(==|=|!=)\s*(\S*)$`)
+)
+
+// ParseLabelSelector parses a label selector expression, made of comma-separated requirements:
+//   - key (key is set) and !key (key is not set)
+//   - key=value, key==value and key!=value
+//   - key in (value1,value2) and key notin (value1,value2)
+//
+// Requirements with != and notin are satisfied by organizations without the key. It returns an error wrapping
+// [ErrInvalidLabelSelector] if expression is malformed.
+func ParseLabelSelector(expression string) (LabelSelector, error) {
+	selector := LabelSelector{}
+	if strings.TrimSpace(expression) == "" {
+		return selector, nil
+	}
+
+	for _, term := range splitLabelSelector(expression) {
+		requirement, err := parseLabelRequirement(strings.TrimSpace(term))
+		if err != nil {
+			return LabelSelector{}, fmt.Errorf("%w: %w", ErrInvalidLabelSelector, err)
+		}
+		selector.Requirements = append(selector.Requirements, requirement)
+	}
+	return selector, nil
+}
+
+// splitLabelSelector splits expression into its requirements, ignoring commas within value sets.
+func splitLabelSelector(expression string) []string {
+	var (
+		terms []string
+		start int
+		depth int
+	)
+	for i, r := range expression {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			terms = append(terms, expression[start:i])
+			start = i + 1
+		}
+	}
+	return append(terms, expression[start:])
+}
+
+// parseLabelRequirement parses a single requirement of a label selector.
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	var requirement LabelRequirement
+	if matches := _labelSetRequirementRegexp.FindStringSubmatch(term); matches != nil {
+		requirement = LabelRequirement{Key: matches[1], Operator: LabelOperator(matches[2])}
+		for _, value := range strings.Split(matches[3], ",") {
+			requirement.Values = append(requirement.Values, strings.TrimSpace(value))
+		}
+	} else if matches = _labelEqualityRequirementRegexp.FindStringSubmatch(term); matches != nil {
+		requirement = LabelRequirement{Key: matches[1], Operator: LabelOpIn, Values: []string{matches[3]}}
+		if matches[2] == "!=" {
+			requirement.Operator = LabelOpNotIn
+		}
+	} else if key, ok := strings.CutPrefix(term, "!"); ok {
+		requirement = LabelRequirement{Key: strings.TrimSpace(key), Operator: LabelOpNotExists}
+	} else {
+		requirement = LabelRequirement{Key: term, Operator: LabelOpExists}
+	}
+
+	if err := validateLabelKey(requirement.Key); err != nil {
+		return LabelRequirement{}, fmt.Errorf("requirement %q %w", term, err)
+	}
+	for _, value := range requirement.Values {
+		if err := validateLabelValue(value); err != nil {
+			return LabelRequirement{}, fmt.Errorf("requirement %q %w", term, err)
+		}
+	}
+	return requirement, nil
+}
@@ -0,0 +1,92 @@
+package organization_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hadroncorp/service-template/organization"
+)
+
+func TestLabels_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		inLabels organization.Labels
+		expErr   error
+	}{
+		{name: "empty", inLabels: nil},
+		{name: "valid", inLabels: organization.Labels{"tier": "gold", "billing.hadron.io/plan": "pro-2024", "trial": ""}},
+		{name: "invalid key", inLabels: organization.Labels{"tier gold": "x"}, expErr: organization.ErrInvalidLabels},
+		{name: "invalid prefix", inLabels: organization.Labels{"Billing/tier": "x"}, expErr: organization.ErrInvalidLabels},
+		{name: "empty prefix", inLabels: organization.Labels{"/tier": "x"}, expErr: organization.ErrInvalidLabels},
+		{name: "invalid value", inLabels: organization.Labels{"tier": "-gold"}, expErr: organization.ErrInvalidLabels},
+		{
+			name:     "too long value",
+			inLabels: organization.Labels{"tier": strings.Repeat("a", organization.MaxLabelNameLength+1)},
+			expErr:   organization.ErrInvalidLabels,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.inLabels.Validate(), tt.expErr)
+		})
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	labels := organization.Labels{"tier": "gold", "region": "eu"}
+	tests := []struct {
+		name         string
+		inExpression string
+		expErr       error
+		expMatches   bool
+	}{
+		{name: "empty", inExpression: "", expMatches: true},
+		{name: "exists", inExpression: "tier", expMatches: true},
+		{name: "not exists", inExpression: "!trial", expMatches: true},
+		{name: "equals", inExpression: "tier=gold", expMatches: true},
+		{name: "double equals", inExpression: "tier==silver", expMatches: false},
+		{name: "not equals", inExpression: "region!=us", expMatches: true},
+		{name: "not equals missing key", inExpression: "trial!=true", expMatches: true},
+		{name: "in", inExpression: "tier in (gold,platinum),!trial", expMatches: true},
+		{name: "in not matching", inExpression: "tier in (silver, platinum)", expMatches: false},
+		{name: "notin", inExpression: "region notin (us,apac)", expMatches: true},
+		{name: "conjunction", inExpression: "tier=gold,region=us", expMatches: false},
+		{name: "invalid key", inExpression: "tier gold", expErr: organization.ErrInvalidLabelSelector},
+		{name: "invalid value", inExpression: "tier in (gold,-x)", expErr: organization.ErrInvalidLabelSelector},
+		{name: "empty term", inExpression: "tier,,region", expErr: organization.ErrInvalidLabelSelector},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := organization.ParseLabelSelector(tt.inExpression)
+			assert.ErrorIs(t, err, tt.expErr)
+			if tt.expErr == nil {
+				assert.Equal(t, tt.expMatches, selector.Matches(labels))
+			}
+		})
+	}
+}
+
+func TestOrganization_Update_Labels(t *testing.T) {
+	// arrange
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	org := organization.New(ctx, "1", "acme", organization.WithLabels(organization.Labels{
+		"tier":  "gold",
+		"trial": "",
+	}))
+	before := org.Labels()
+
+	// act
+	org.Update(ctx, organization.WithUpdatedLabels(map[string]*string{
+		"tier":   lo.ToPtr("platinum"),
+		"region": lo.ToPtr("eu"),
+		"trial":  nil,
+	}))
+
+	// assert
+	assert.Equal(t, organization.Labels{"tier": "platinum", "region": "eu"}, org.Labels())
+	assert.Equal(t, organization.Labels{"tier": "gold", "trial": ""}, before)
+}
@@ -0,0 +1,178 @@
+package organization
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zones are validated against the embedded IANA database, not the one of the host
+
+	"golang.org/x/text/language"
+)
+
+// ErrInvalidProfile is returned when a profile attribute of the organization is invalid (see [Profile.Validate]).
+var ErrInvalidProfile = errors.New("organization: invalid profile")
+
+const (
+	// MaxDescriptionLength is the maximum number of characters of [Profile.Description].
+	MaxDescriptionLength = 1024
+	// MaxURLLength is the maximum number of characters of [Profile.WebsiteURL] and [Profile.LogoURL].
+	MaxURLLength = 2048
+)
+
+// Profile is the set of descriptive attributes of an [Organization]. Every attribute is optional, empty values
+// stand for unset attributes.
+type Profile struct {
+	Description string
+	// WebsiteURL is the absolute HTTP(S) URL of the organization website.
+	WebsiteURL string
+	// LogoURL is the absolute HTTP(S) URL of the organization logo.
+	LogoURL string
+	// Country is the ISO 3166-1 alpha-2 code of the country the organization is registered in (e.g. MX).
+	Country string
+	// Locale is the BCP 47 language tag used by default for the organization (e.g. es-MX).
+	Locale string
+	// TimeZone is the IANA time zone used by default for the organization (e.g. America/Mexico_City).
+	TimeZone string
+	// LegalID is the identifier of the organization in the company register of Country.
+	LegalID string
+	// TaxID is the identifier of the organization for tax purposes in Country.
+	TaxID string
+}
+
+// DEV-NOTE: Identifier formats are only enforced for the countries listed below, identifiers of other
+// countries must only match the generic format. Add countries as the platform expands to new markets.
+
+// _genericIDRegexp matches identifiers of countries without a known format.
+var _genericIDRegexp = regexp.MustCompile(`^[A-Z0-9]{2,32}$`)
+
+// _legalIDRegexps are the formats of company register identifiers by country.
+var _legalIDRegexps = map[string]*regexp.Regexp{
+	"BR": regexp.MustCompile(`^\d{11}$`),                      // NIRE
+	"DE": regexp.MustCompile(`^HR[AB]\d{1,6}$`),               // Handelsregisternummer
+	"ES": regexp.MustCompile(`^[A-HJNPQRSUVW]\d{7}[0-9A-J]$`), // CIF
+	"FR": regexp.MustCompile(`^\d{9}$`),                       // SIREN
+	"GB": regexp.MustCompile(`^([A-Z]{2}\d{6}|\d{8})$`),       // Company number
+}
+
+// _taxIDRegexps are the formats of tax identifiers by country.
+var _taxIDRegexps = map[string]*regexp.Regexp{
+	"BR": regexp.MustCompile(`^\d{14}$`),                                   // CNPJ
+	"DE": regexp.MustCompile(`^DE\d{9}$`),                                  // USt-IdNr.
+	"ES": regexp.MustCompile(`^(ES)?[A-Z0-9]\d{7}[A-Z0-9]$`),               // NIF
+	"FR": regexp.MustCompile(`^FR[A-Z0-9]{2}\d{9}$`),                       // Numéro de TVA
+	"GB": regexp.MustCompile(`^GB(\d{9}|\d{12}|GD\d{3}|HA\d{3})$`),         // VAT registration number
+	"IN": regexp.MustCompile(`^\d{2}[A-Z]{5}\d{4}[A-Z][1-9A-Z]Z[0-9A-Z]$`), // GSTIN
+	"MX": regexp.MustCompile(`^[A-Z&Ñ]{3,4}\d{6}[A-Z0-9]{3}$`),             // RFC
+	"US": regexp.MustCompile(`^\d{9}$`),                                    // EIN
+}
+
+// Validate checks every attribute of the profile, it returns an error wrapping [ErrInvalidProfile] describing
+// the first invalid attribute.
+//
+// Legal and tax identifiers require a country as their format depends on it.
+func (p Profile) Validate() error {
+	if len([]rune(p.Description)) > MaxDescriptionLength {
+		return newProfileError("description", fmt.Sprintf("must be at most %d characters", MaxDescriptionLength))
+	}
+	if p.WebsiteURL != "" && !isValidProfileURL(p.WebsiteURL) {
+		return newProfileError("website_url", "must be an absolute HTTP(S) URL")
+	}
+	if p.LogoURL != "" && !isValidProfileURL(p.LogoURL) {
+		return newProfileError("logo_url", "must be an absolute HTTP(S) URL")
+	}
+	if p.Country != "" {
+		region, err := language.ParseRegion(p.Country)
+		if err != nil || !region.IsCountry() || region.String() != p.Country {
+			return newProfileError("country", "must be an ISO 3166-1 alpha-2 country code")
+		}
+	}
+	if p.Locale != "" {
+		if _, err := language.Parse(p.Locale); err != nil {
+			return newProfileError("locale", "must be a BCP 47 language tag")
+		}
+	}
+	if p.TimeZone != "" {
+		if _, err := time.LoadLocation(p.TimeZone); err != nil || p.TimeZone == "Local" {
+			return newProfileError("time_zone", "must be an IANA time zone")
+		}
+	}
+	if err := validateProfileID("legal_id", p.LegalID, p.Country, _legalIDRegexps); err != nil {
+		return err
+	}
+	return validateProfileID("tax_id", p.TaxID, p.Country, _taxIDRegexps)
+}
+
+// validateProfileID checks the identifier id of country against the format of country in formats.
+func validateProfileID(field, id, country string, formats map[string]*regexp.Regexp) error {
+	if id == "" {
+		return nil
+	} else if country == "" {
+		return newProfileError(field, "requires country")
+	}
+
+	format, ok := formats[country]
+	if !ok {
+		format = _genericIDRegexp
+	}
+	if !format.MatchString(id) {
+		return newProfileError(field, "does not match the format of "+country)
+	}
+	return nil
+}
+
+// isValidProfileURL checks whether rawURL is an absolute HTTP(S) URL.
+func isValidProfileURL(rawURL string) bool {
+	if len(rawURL) > MaxURLLength {
+		return false
+	}
+	u, err := url.Parse(rawURL)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+// newProfileError returns an error wrapping [ErrInvalidProfile] describing why field is invalid.
+func newProfileError(field, reason string) error {
+	return fmt.Errorf("%w: %s %s", ErrInvalidProfile, field, reason)
+}
+
+// normalize returns the profile with every attribute in its canonical form (e.g. country codes in uppercase).
+func (p Profile) normalize() Profile {
+	return Profile{
+		Description: strings.TrimSpace(p.Description),
+		WebsiteURL:  strings.TrimSpace(p.WebsiteURL),
+		LogoURL:     strings.TrimSpace(p.LogoURL),
+		Country:     normalizeProfileCode(p.Country),
+		Locale:      normalizeLocale(p.Locale),
+		TimeZone:    strings.TrimSpace(p.TimeZone),
+		LegalID:     normalizeProfileID(p.LegalID),
+		TaxID:       normalizeProfileID(p.TaxID),
+	}
+}
+
+// normalizeProfileCode returns code in uppercase without surrounding spaces (e.g. country codes).
+func normalizeProfileCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// normalizeProfileID returns the identifier id in uppercase without separators (spaces, hyphens, dots and
+// slashes), as identifiers are written in several ways (e.g. 12-3456789 and 123456789).
+func normalizeProfileID(id string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '.' || r == '/' {
+			return -1
+		}
+		return r
+	}, normalizeProfileCode(id))
+}
+
+// normalizeLocale returns the canonical form of the BCP 47 language tag locale (e.g. es_mx becomes es-MX). Invalid
+// tags are returned as is, so they are reported by [Profile.Validate].
+func normalizeLocale(locale string) string {
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	if tag, err := language.Parse(locale); err == nil {
+		return tag.String()
+	}
+	return locale
+}
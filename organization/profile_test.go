@@ -0,0 +1,119 @@
+package organization_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hadroncorp/service-template/organization"
+)
+
+func TestProfile_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     organization.Profile
+		expErr string
+	}{
+		{
+			name: "empty",
+			in:   organization.Profile{},
+		},
+		{
+			name: "valid",
+			in: organization.Profile{
+				Description: "Software for everyone",
+				WebsiteURL:  "https://acme.example",
+				LogoURL:     "https://cdn.acme.example/logo.png",
+				Country:     "MX",
+				Locale:      "es-MX",
+				TimeZone:    "America/Mexico_City",
+				TaxID:       "ACM010101AB1",
+			},
+		},
+		{
+			name:   "description too long",
+			in:     organization.Profile{Description: strings.Repeat("a", organization.MaxDescriptionLength+1)},
+			expErr: "description",
+		},
+		{
+			name:   "relative website",
+			in:     organization.Profile{WebsiteURL: "acme.example"},
+			expErr: "website_url",
+		},
+		{
+			name:   "non http logo",
+			in:     organization.Profile{LogoURL: "ftp://acme.example/logo.png"},
+			expErr: "logo_url",
+		},
+		{
+			name:   "unknown country",
+			in:     organization.Profile{Country: "XX"},
+			expErr: "country",
+		},
+		{
+			name:   "invalid locale",
+			in:     organization.Profile{Locale: "not a locale"},
+			expErr: "locale",
+		},
+		{
+			name:   "unknown time zone",
+			in:     organization.Profile{TimeZone: "Mars/Olympus_Mons"},
+			expErr: "time_zone",
+		},
+		{
+			name:   "tax id without country",
+			in:     organization.Profile{TaxID: "123456789"},
+			expErr: "tax_id",
+		},
+		{
+			name:   "tax id of another country",
+			in:     organization.Profile{Country: "DE", TaxID: "123456789"},
+			expErr: "tax_id",
+		},
+		{
+			name:   "legal id of another country",
+			in:     organization.Profile{Country: "FR", LegalID: "HRB12345"},
+			expErr: "legal_id",
+		},
+		{
+			name: "identifiers of country without known format",
+			in:   organization.Profile{Country: "JP", LegalID: "1234567890123", TaxID: "T1234567890123"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.Validate()
+			if tt.expErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, organization.ErrInvalidProfile)
+			assert.ErrorContains(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestOrganization_Update_Profile(t *testing.T) {
+	// arrange
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	org := organization.New(ctx, "1", "acme")
+
+	// act
+	ok := org.Update(ctx,
+		organization.WithUpdatedCountry(lo.ToPtr(" us ")),
+		organization.WithUpdatedLocale(lo.ToPtr("en_us")),
+		organization.WithUpdatedTaxID(lo.ToPtr("12-3456789")),
+	)
+
+	// assert
+	assert.True(t, ok)
+	assert.Equal(t, organization.Profile{
+		Country: "US",
+		Locale:  "en-US",
+		TaxID:   "123456789",
+	}, org.Profile())
+	assert.NoError(t, org.Profile().Validate())
+}
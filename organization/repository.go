@@ -17,12 +17,66 @@ import (
 type Repository interface {
 	persistence.WriteRepository[string, Organization]
 	persistence.ReadRepository[string, Organization]
-	// ExistsByName checks if an [Organization] exists by its name.
-	ExistsByName(ctx context.Context, name string) (bool, error)
+	HierarchyReadRepository
+	// ExistsByName checks if a non-deleted [Organization] exists by its name (case-insensitive).
+	//
+	// The [Organization] identified by excludeKey is ignored (e.g. the one being modified). Use an empty
+	// excludeKey to take every [Organization] into account.
+	ExistsByName(ctx context.Context, name, excludeKey string) (bool, error)
+	// ExistsBySlug checks if an [Organization] exists by its slug (case-insensitive). Deleted organizations are
+	// taken into account as they keep their slugs.
+	//
+	// The [Organization] identified by excludeKey is ignored (e.g. the one being modified). Use an empty
+	// excludeKey to take every [Organization] into account.
+	ExistsBySlug(ctx context.Context, slug, excludeKey string) (bool, error)
+	// PurgeByKey permanently removes an [Organization] from the persistence store.
+	//
+	// Delete and DeleteByKey perform logical deletions (soft delete) instead. It fails with [ErrHasChildren] if
+	// other organizations are placed under the [Organization].
+	PurgeByKey(ctx context.Context, key string) error
+	// LockByKey retrieves an [Organization] by its unique identifier and locks it until the transaction carried
+	// by the context ends, so it cannot be changed by others in the meantime. It returns nil if the
+	// [Organization] does not exist.
+	LockByKey(ctx context.Context, key string) (*Organization, error)
+	// LockHierarchy locks the [Organization] hierarchy until the transaction carried by the context ends, so
+	// concurrent hierarchy changes cannot create cycles or exceed the maximum depth.
+	LockHierarchy(ctx context.Context) error
 }
 
 // ReadRepository offers a set of routines to manage [Organization] read operations.
 type ReadRepository interface {
 	persistence.ReadRepository[string, Organization]
+	HierarchyReadRepository
 	FindAll(ctx context.Context, opts ...ListOption) (*paging.Page[Organization], error)
+	// FindBySlug retrieves an [Organization] by its current slug or, if no organization has it, by one of its
+	// previous slugs (case-insensitive). It returns nil if no organization ever had the slug.
+	FindBySlug(ctx context.Context, slug string) (*Organization, error)
+}
+
+// HierarchyReadRepository offers a set of routines to traverse the [Organization] hierarchy. Deleted organizations
+// are traversed as well.
+type HierarchyReadRepository interface {
+	// FindAncestors retrieves the ancestors of the [Organization] identified by key, from its parent up to the root.
+	FindAncestors(ctx context.Context, key string) ([]Organization, error)
+	// FindDescendants retrieves the descendants of the [Organization] identified by key breadth-first, from its
+	// children down to the leaves.
+	FindDescendants(ctx context.Context, key string) ([]Organization, error)
+}
+
+// SearchRepository offers a set of routines to search [Organization] entities by relevance (e.g. full-text and fuzzy
+// matching).
+type SearchRepository interface {
+	// Search retrieves the non-deleted [Organization] entities matching query, most relevant first.
+	Search(ctx context.Context, query string, opts ...SearchOption) (*paging.Page[SearchResult], error)
+}
+
+// HistoryRepository offers a set of routines to manage [HistoryRecord] persistence store operations.
+//
+// Records are immutable, they can only be appended.
+type HistoryRepository interface {
+	// Append stores record. It takes part of the transaction carried by the context, if any, so records are
+	// stored along the changes they audit.
+	Append(ctx context.Context, record HistoryRecord) error
+	// FindAll retrieves the records of the [Organization] identified by organizationID, newest first.
+	FindAll(ctx context.Context, organizationID string, opts ...HistoryOption) (*paging.Page[HistoryRecord], error)
 }
@@ -3,15 +3,20 @@ package organization
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"slices"
+	"strings"
+	"unicode"
 
 	"github.com/hadroncorp/geck/persistence/audit"
 	"github.com/hadroncorp/geck/persistence/paging"
 	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/samber/lo"
 
 	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/internal/sqltx"
 )
 
 // DEV-NOTE: Repositories are separated into write and read repositories as they might have different
@@ -37,19 +42,33 @@ var (
 )
 
 // NewPostgresRepository creates a new [PostgresRepository] instance.
+//
+// Operations take part of the transaction carried by the context, if any (see [sqltx.Runner]).
 func NewPostgresRepository(db gecksql.DB) PostgresRepository {
 	return PostgresRepository{
-		db: postgresgen.New(db),
+		db: postgresgen.New(sqltx.NewConn(db)),
 	}
 }
 
-func (p PostgresRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
-	return p.db.ExistOrganizationByName(ctx, name)
+func (p PostgresRepository) ExistsByName(ctx context.Context, name, excludeKey string) (bool, error) {
+	return p.db.ExistOrganizationByName(ctx, postgresgen.ExistOrganizationByNameParams{
+		Name:                  name,
+		ExcludeOrganizationID: excludeKey,
+	})
+}
+
+func (p PostgresRepository) ExistsBySlug(ctx context.Context, slug, excludeKey string) (bool, error) {
+	return p.db.ExistOrganizationBySlug(ctx, postgresgen.ExistOrganizationBySlugParams{
+		Slug:                  slug,
+		ExcludeOrganizationID: excludeKey,
+	})
 }
 
 func (p PostgresRepository) Save(ctx context.Context, entity Organization) error {
+	// DEV-NOTE: Name and slug uniqueness is enforced by the database (unique indexes), checks performed by
+	// services before writing are not enough as they are prone to race conditions.
 	if entity.IsNew() {
-		return p.db.CreateOrganization(ctx, postgresgen.CreateOrganizationParams{
+		err := p.db.CreateOrganization(ctx, postgresgen.CreateOrganizationParams{
 			OrganizationID: entity.id,
 			Name:           entity.name,
 			CreateTime:     entity.CreateTime(),
@@ -58,24 +77,116 @@ func (p PostgresRepository) Save(ctx context.Context, entity Organization) error
 			LastUpdateBy:   entity.LastUpdateBy(),
 			RowVersion:     int64(entity.Version()),
 			IsDeleted:      entity.IsDeleted(),
+			ParentID:       newNullString(entity.parentID),
+			Slug:           entity.slug,
+			Description:    entity.profile.Description,
+			WebsiteUrl:     entity.profile.WebsiteURL,
+			LogoUrl:        entity.profile.LogoURL,
+			CountryCode:    entity.profile.Country,
+			Locale:         entity.profile.Locale,
+			TimeZone:       entity.profile.TimeZone,
+			LegalID:        entity.profile.LegalID,
+			TaxID:          entity.profile.TaxID,
+			Status:         string(entity.status),
+			StatusReason:   string(entity.statusReason),
+			Labels:         newPostgresLabels(entity.labels),
 		})
+		if err != nil {
+			return translatePostgresError(err)
+		}
+		// the slug might have been a redirect to another organization
+		return p.db.DeleteOrganizationSlugRedirect(ctx, entity.slug)
+	}
+	return p.update(ctx, entity, entity.IsDeleted())
+}
+
+func (p PostgresRepository) update(ctx context.Context, entity Organization, isDeleted bool) error {
+	// DEV-NOTE: Optimistic concurrency control. The row is only updated if nobody else modified it since it
+	// was read, and if the version read is one the caller expects.
+	if len(entity.expectedVersions) > 0 && !slices.Contains(entity.expectedVersions, entity.persistedVersion) {
+		return ErrVersionConflict
+	}
+	affected, err := p.db.UpdateOrganization(ctx, postgresgen.UpdateOrganizationParams{
+		OrganizationID:     entity.id,
+		Name:               entity.name,
+		LastUpdateTime:     entity.LastUpdateTime(),
+		LastUpdateBy:       entity.LastUpdateBy(),
+		RowVersion:         int64(entity.Version()),
+		IsDeleted:          isDeleted,
+		ParentID:           newNullString(entity.parentID),
+		Slug:               entity.slug,
+		Description:        entity.profile.Description,
+		WebsiteUrl:         entity.profile.WebsiteURL,
+		LogoUrl:            entity.profile.LogoURL,
+		CountryCode:        entity.profile.Country,
+		Locale:             entity.profile.Locale,
+		TimeZone:           entity.profile.TimeZone,
+		LegalID:            entity.profile.LegalID,
+		TaxID:              entity.profile.TaxID,
+		Status:             string(entity.status),
+		StatusReason:       string(entity.statusReason),
+		Labels:             newPostgresLabels(entity.labels),
+		ExpectedRowVersion: int64(entity.persistedVersion),
+	})
+	if err != nil {
+		return translatePostgresError(err)
+	} else if affected == 0 {
+		return ErrVersionConflict
+	} else if entity.persistedSlug == "" || entity.slug == entity.persistedSlug {
+		// slug unchanged or unknown (entity not read from the persistence store)
+		return nil
+	}
+
+	// DEV-NOTE: Previous slugs redirect to the organization until another organization takes them, so links
+	// shared before the slug changed keep working.
+	if err = p.db.DeleteOrganizationSlugRedirect(ctx, entity.slug); err != nil {
+		return err
 	}
-	return p.db.UpdateOrganization(ctx, postgresgen.UpdateOrganizationParams{
+	return p.db.UpsertOrganizationSlugRedirect(ctx, postgresgen.UpsertOrganizationSlugRedirectParams{
+		Slug:           entity.persistedSlug,
 		OrganizationID: entity.id,
-		Name:           entity.name,
-		LastUpdateTime: entity.LastUpdateTime(),
-		LastUpdateBy:   entity.LastUpdateBy(),
-		RowVersion:     int64(entity.Version()),
-		IsDeleted:      entity.IsDeleted(),
+		CreateTime:     entity.LastUpdateTime(),
 	})
 }
 
 func (p PostgresRepository) DeleteByKey(ctx context.Context, key string) error {
-	return p.db.DeleteOrganization(ctx, key)
+	entity, err := p.FindByKey(ctx, key)
+	if err != nil || entity == nil || entity.IsDeleted() {
+		return err
+	}
+	entity.Delete(ctx)
+	return p.Delete(ctx, *entity)
 }
 
 func (p PostgresRepository) Delete(ctx context.Context, entity Organization) error {
-	return p.DeleteByKey(ctx, entity.id)
+	// DEV-NOTE: Organizations are soft deleted, use PurgeByKey to remove them permanently.
+	return p.update(ctx, entity, true)
+}
+
+func (p PostgresRepository) PurgeByKey(ctx context.Context, key string) error {
+	err := p.db.DeleteOrganization(ctx, key)
+	if isPostgresError(err, _pgForeignKeyViolation) {
+		// children reference the organization
+		return ErrHasChildren
+	}
+	return err
+}
+
+// _hierarchyLockID is the Postgres advisory lock key guarding the organization hierarchy.
+const _hierarchyLockID int64 = 0x6f72675f74726565 // "org_tree"
+
+func (p PostgresRepository) LockHierarchy(ctx context.Context) error {
+	// DEV-NOTE: Transaction-level advisory lock, it is released right away if no transaction is carried by
+	// the context (see sqltx.Runner).
+	return p.db.LockOrganizationHierarchy(ctx, _hierarchyLockID)
+}
+
+func (p PostgresRepository) FindAncestors(ctx context.Context, key string) ([]Organization, error) {
+	return findAncestorsPostgres(ctx, p.db, key)
+}
+
+func (p PostgresRepository) FindDescendants(ctx context.Context, key string) ([]Organization, error) {
+	return findDescendantsPostgres(ctx, p.db, key)
 }
 
 func (p PostgresRepository) FindByKey(ctx context.Context, key string) (*Organization, error) {
@@ -85,22 +196,24 @@ func (p PostgresRepository) FindByKey(ctx context.Context, key string) (*Organiz
 	} else if err != nil {
 		return nil, err
 	}
-	return &Organization{
-		id:   model.OrganizationID,
-		name: model.Name,
-		Auditable: audit.New(audit.NewArgs{
-			CreateTime:     model.CreateTime,
-			CreateBy:       model.CreateBy,
-			LastUpdateTime: model.LastUpdateTime,
-			LastUpdateBy:   model.LastUpdateBy,
-			Version:        uint64(model.RowVersion),
-			IsDeleted:      model.IsDeleted,
-		}),
-	}, nil
+	return lo.ToPtr(newFromPostgres(model)), nil
+}
+
+func (p PostgresRepository) LockByKey(ctx context.Context, key string) (*Organization, error) {
+	model, err := p.db.LockOrganizationByID(ctx, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(newFromPostgres(model)), nil
 }
 
 // - Read Repository(s) -
 
+// _defaultPageSize is the number of items per page used when no page size was specified.
+const _defaultPageSize = 100
+
 // PostgresReadRepository is the concrete implementation of the [ReadRepository] interface for Postgres.
 type PostgresReadRepository struct {
 	db                 *postgresgen.Queries
@@ -120,48 +233,176 @@ func NewPostgresReadRepository(db gecksql.DB, tokenConfig paging.TokenConfig) Po
 	}
 }
 
+// postgresPageToken is the state carried by page tokens of [PostgresReadRepository].
+type postgresPageToken struct {
+	Query            postgresgen.ListOrganizationsParams
+	IsSortDescending bool
+}
+
+// newPostgresPageToken creates the state of the first page of a listing.
+func newPostgresPageToken(opts listOptions) (postgresPageToken, error) {
+	labelContains, labelRequirements, err := newPostgresLabelSelector(opts.labelSelector)
+	if err != nil {
+		return postgresPageToken{}, err
+	}
+	query := postgresgen.ListOrganizationsParams{
+		IsDeleted: sql.NullBool{
+			Bool:  false,
+			Valid: opts.findNonDeletedOnly,
+		},
+		CreateBy: sql.NullString{
+			String: opts.createBy,
+			Valid:  opts.createBy != "",
+		},
+		CreateTimeStart: sql.NullTime{
+			Time:  opts.createTimeStart,
+			Valid: !opts.createTimeStart.IsZero(),
+		},
+		CreateTimeEnd: sql.NullTime{
+			Time:  opts.createTimeEnd,
+			Valid: !opts.createTimeEnd.IsZero(),
+		},
+		NamePrefix: sql.NullString{
+			String: opts.namePrefix,
+			Valid:  opts.namePrefix != "",
+		},
+		ParentID: sql.NullString{
+			String: opts.parentID,
+			Valid:  opts.parentID != "",
+		},
+		Status: sql.NullString{
+			String: string(opts.status),
+			Valid:  opts.status != "",
+		},
+		MemberUserID: sql.NullString{
+			String: opts.memberUserID,
+			Valid:  opts.memberUserID != "",
+		},
+		LabelContains:     labelContains,
+		LabelRequirements: labelRequirements,
+		SortBy:            string(lo.CoalesceOrEmpty(opts.sortField, ListSortByCreateTime)),
+		IsSeekAscending:   !opts.sortDescending,
+		PageSize:          _defaultPageSize,
+	}
+	if limit := opts.pageOpts.Limit(); limit > 0 {
+		query.PageSize = int32(limit)
+	}
+	return postgresPageToken{
+		Query:            query,
+		IsSortDescending: opts.sortDescending,
+	}, nil
+}
+
+// isForward indicates whether the page follows the sort order (i.e. the first or a next page).
+func (t postgresPageToken) isForward() bool {
+	return t.Query.IsSeekAscending != t.IsSortDescending
+}
+
+// matchesFilters checks whether the filters set in requested match the ones of t. Filters left unset in
+// requested are taken from t, so following pages may be listed with the page token alone. Deleted organizations
+// are either shown or hidden on every request, their filter must always match.
+func (t postgresPageToken) matchesFilters(requested postgresgen.ListOrganizationsParams) bool {
+	isNullStringMatch := func(requested, actual sql.NullString) bool {
+		return !requested.Valid || requested == actual
+	}
+	isNullTimeMatch := func(requested, actual sql.NullTime) bool {
+		return !requested.Valid || (actual.Valid && requested.Time.Equal(actual.Time))
+	}
+	return requested.IsDeleted == t.Query.IsDeleted &&
+		isNullStringMatch(requested.CreateBy, t.Query.CreateBy) &&
+		isNullTimeMatch(requested.CreateTimeStart, t.Query.CreateTimeStart) &&
+		isNullTimeMatch(requested.CreateTimeEnd, t.Query.CreateTimeEnd) &&
+		isNullStringMatch(requested.NamePrefix, t.Query.NamePrefix) &&
+		isNullStringMatch(requested.Status, t.Query.Status) &&
+		isNullStringMatch(requested.LabelContains, t.Query.LabelContains) &&
+		isNullStringMatch(requested.LabelRequirements, t.Query.LabelRequirements)
+}
+
+// withCursor returns the state of the page right after (forward) or right before (backward) the cursor row.
+func (t postgresPageToken) withCursor(cursor postgresgen.Organization, isForward bool) postgresPageToken {
+	t.Query.CursorOrganizationID = sql.NullString{
+		String: cursor.OrganizationID,
+		Valid:  true,
+	}
+	t.Query.CursorCreateTime = sql.NullTime{
+		Time:  cursor.CreateTime,
+		Valid: true,
+	}
+	t.Query.CursorName = sql.NullString{
+		String: cursor.Name,
+		Valid:  true,
+	}
+	t.Query.IsSeekAscending = isForward != t.IsSortDescending
+	return t
+}
+
 func (p PostgresReadRepository) FindAll(ctx context.Context, opts ...ListOption) (*paging.Page[Organization], error) {
 	listOpts := listOptions{}
 	for _, opt := range opts {
 		opt(&listOpts)
 	}
 
-	var queryParams postgresgen.ListOrganizationsParams
+	// DEV-NOTE: Keyset pagination. Pages are delimited by the (sort key, organization_id) tuple of their
+	// boundary rows, so rows sharing the very same sort key are neither skipped nor repeated.
+	// Page tokens carry the whole query (filters and sort order included) so every page of a listing applies
+	// the same filters and order.
+	token, err := newPostgresPageToken(listOpts)
+	if err != nil {
+		return nil, err
+	}
 	if listOpts.pageOpts.HasPageToken() {
-		if err := paging.ParseToken(p.pageTokenCipherKey, listOpts.pageOpts.PageToken(), &queryParams); err != nil {
+		requested := token.Query
+		if err := paging.ParseToken(p.pageTokenCipherKey, listOpts.pageOpts.PageToken(), &token); err != nil {
 			return nil, err
 		}
-	} else {
-		queryParams.IsDeleted = sql.NullBool{
-			Bool:  false,
-			Valid: listOpts.findNonDeletedOnly,
+		if listOpts.sortField != "" && (string(listOpts.sortField) != token.Query.SortBy ||
+			listOpts.sortDescending != token.IsSortDescending) {
+			return nil, ErrPageTokenMismatch
 		}
-		queryParams.PageSize = sql.NullInt32{
-			Int32: int32(listOpts.pageOpts.Limit()),
-			Valid: listOpts.pageOpts.Limit() > 0,
+		if !token.matchesFilters(requested) {
+			return nil, ErrPageTokenMismatch
+		}
+		// children of an organization cannot be listed with a page token of another organization, callers
+		// authorize the listing with the parent they ask for
+		if listOpts.parentID != "" && listOpts.parentID != token.Query.ParentID.String {
+			return nil, ErrPageTokenMismatch
+		}
+		// tokens are bound to the member they were created for, callers cannot see past their memberships
+		// through the page token of someone else
+		if listOpts.memberUserID != token.Query.MemberUserID.String {
+			return nil, ErrPageTokenMismatch
 		}
 	}
-	models, err := p.db.ListOrganizations(ctx, queryParams)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, nil
-	} else if err != nil {
+
+	// fetch an extra row to know whether more rows follow in seek order
+	query := token.Query
+	query.PageSize++
+	models, err := p.db.ListOrganizations(ctx, query)
+	if err != nil {
 		return nil, err
+	} else if len(models) == 0 {
+		return &paging.Page[Organization]{
+			Items: []Organization{},
+		}, nil
+	}
+	hasMore := len(models) > int(token.Query.PageSize)
+	if hasMore {
+		models = models[:token.Query.PageSize]
 	}
 
-	if queryParams.IsCursorForward.Valid && !queryParams.IsCursorForward.Bool {
+	isForward := token.isForward()
+	if !isForward {
+		// previous pages are read backwards
 		slices.Reverse(models)
 	}
 
-	hasPages, err := p.db.HasMorePagesOrganizationList(ctx, postgresgen.HasMorePagesOrganizationListParams{
-		CursorNext: sql.NullTime{
-			Time:  models[len(models)-1].CreateTime,
-			Valid: true,
-		},
-		CursorPrev: sql.NullTime{
-			Time:  models[0].CreateTime,
-			Valid: true,
-		},
-	})
+	first, last := models[0], models[len(models)-1]
+	hasPrev, hasNext := hasMore && !isForward, hasMore && isForward
+	if isForward && token.Query.CursorOrganizationID.Valid {
+		hasPrev, err = p.hasItems(ctx, token.withCursor(first, false))
+	} else if !isForward {
+		hasNext, err = p.hasItems(ctx, token.withCursor(last, true))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -170,37 +411,14 @@ func (p PostgresReadRepository) FindAll(ctx context.Context, opts ...ListOption)
 		prevToken string
 		nextToken string
 	)
-	if hasPages.HasPrev {
-		prevToken, err = paging.NewToken(p.pageTokenCipherKey, postgresgen.ListOrganizationsParams{
-			IsDeleted: queryParams.IsDeleted,
-			CursorValue: sql.NullTime{
-				Time:  models[0].CreateTime,
-				Valid: true,
-			},
-			IsCursorForward: sql.NullBool{
-				Bool:  false,
-				Valid: true,
-			},
-			PageSize: queryParams.PageSize,
-		})
+	if hasPrev {
+		prevToken, err = paging.NewToken(p.pageTokenCipherKey, token.withCursor(first, false))
 		if err != nil {
 			return nil, err
 		}
 	}
-
-	if hasPages.HasNext {
-		nextToken, err = paging.NewToken(p.pageTokenCipherKey, postgresgen.ListOrganizationsParams{
-			IsDeleted: queryParams.IsDeleted,
-			CursorValue: sql.NullTime{
-				Time:  models[len(models)-1].CreateTime,
-				Valid: true,
-			},
-			IsCursorForward: sql.NullBool{
-				Bool:  true,
-				Valid: true,
-			},
-			PageSize: queryParams.PageSize,
-		})
+	if hasNext {
+		nextToken, err = paging.NewToken(p.pageTokenCipherKey, token.withCursor(last, true))
 		if err != nil {
 			return nil, err
 		}
@@ -211,22 +429,27 @@ func (p PostgresReadRepository) FindAll(ctx context.Context, opts ...ListOption)
 		PreviousPageToken: prevToken,
 		NextPageToken:     nextToken,
 		Items: lo.Map(models, func(item postgresgen.Organization, _ int) Organization {
-			return Organization{
-				id:   item.OrganizationID,
-				name: item.Name,
-				Auditable: audit.New(audit.NewArgs{
-					CreateTime:     item.CreateTime,
-					CreateBy:       item.CreateBy,
-					LastUpdateTime: item.LastUpdateTime,
-					LastUpdateBy:   item.LastUpdateBy,
-					Version:        uint64(item.RowVersion),
-					IsDeleted:      item.IsDeleted,
-				}),
-			}
+			return newFromPostgres(item)
 		}),
 	}, nil
 }
 
+// hasItems checks whether the page described by token has at least one item.
+func (p PostgresReadRepository) hasItems(ctx context.Context, token postgresPageToken) (bool, error) {
+	query := token.Query
+	query.PageSize = 1
+	models, err := p.db.ListOrganizations(ctx, query)
+	return len(models) > 0, err
+}
+
+func (p PostgresReadRepository) FindAncestors(ctx context.Context, key string) ([]Organization, error) {
+	return findAncestorsPostgres(ctx, p.db, key)
+}
+
+func (p PostgresReadRepository) FindDescendants(ctx context.Context, key string) ([]Organization, error) {
+	return findDescendantsPostgres(ctx, p.db, key)
+}
+
 func (p PostgresReadRepository) FindByKey(ctx context.Context, key string) (*Organization, error) {
 	model, err := p.db.GetOrganizationByID(ctx, key)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -234,9 +457,360 @@ func (p PostgresReadRepository) FindByKey(ctx context.Context, key string) (*Org
 	} else if err != nil {
 		return nil, err
 	}
-	return &Organization{
-		id:   model.OrganizationID,
-		name: model.Name,
+	return lo.ToPtr(newFromPostgres(model)), nil
+}
+
+func (p PostgresReadRepository) FindBySlug(ctx context.Context, slug string) (*Organization, error) {
+	// current slugs take precedence over redirects
+	model, err := p.db.GetOrganizationBySlug(ctx, slug)
+	if err == nil {
+		return lo.ToPtr(newFromPostgres(model)), nil
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	id, err := p.db.GetOrganizationSlugRedirect(ctx, slug)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return p.FindByKey(ctx, id)
+}
+
+// newPostgresLabelSelector translates selector into the label filters of [postgresgen.ListOrganizationsParams]:
+// the labels every matching organization has (served by the labels index) and the whole list of requirements.
+// Both are null for empty selectors.
+func newPostgresLabelSelector(selector LabelSelector) (contains, requirements sql.NullString, err error) {
+	if selector.IsEmpty() {
+		return sql.NullString{}, sql.NullString{}, nil
+	}
+
+	containedLabels := Labels{}
+	for _, r := range selector.Requirements {
+		if r.Operator == LabelOpIn && len(r.Values) == 1 {
+			containedLabels[r.Key] = r.Values[0]
+		}
+	}
+	if len(containedLabels) > 0 {
+		raw, errMarshal := json.Marshal(containedLabels)
+		if errMarshal != nil {
+			return sql.NullString{}, sql.NullString{}, errMarshal
+		}
+		contains = sql.NullString{String: string(raw), Valid: true}
+	}
+
+	raw, err := json.Marshal(selector.Requirements)
+	if err != nil {
+		return sql.NullString{}, sql.NullString{}, err
+	}
+	return contains, sql.NullString{String: string(raw), Valid: true}, nil
+}
+
+// - Search Repository(s) -
+
+// PostgresSearchRepository is the concrete implementation of the [SearchRepository] interface for Postgres.
+//
+// Matching relies on full-text search (tsvector) for whole and prefix terms, and on trigram similarity (pg_trgm) for
+// typos.
+type PostgresSearchRepository struct {
+	db                 *postgresgen.Queries
+	pageTokenCipherKey []byte
+}
+
+// compile-time assertion(s)
+var (
+	_ SearchRepository = (*PostgresSearchRepository)(nil)
+)
+
+// NewPostgresSearchRepository creates a new [PostgresSearchRepository] instance.
+func NewPostgresSearchRepository(db gecksql.DB, tokenConfig paging.TokenConfig) PostgresSearchRepository {
+	return PostgresSearchRepository{
+		db:                 postgresgen.New(db),
+		pageTokenCipherKey: tokenConfig.CipherKeyBytes,
+	}
+}
+
+func (p PostgresSearchRepository) Search(ctx context.Context, query string,
+	opts ...SearchOption) (*paging.Page[SearchResult], error) {
+	searchOpts := searchOptions{}
+	for _, opt := range opts {
+		opt(&searchOpts)
+	}
+
+	// DEV-NOTE: Results are ranked, so pages are delimited by the (rank, organization_id) tuple of their last row.
+	// Only forward pagination is supported.
+	queryParams := postgresgen.SearchOrganizationsParams{
+		Query:       query,
+		PrefixQuery: newPrefixTSQuery(query),
+		MemberUserID: sql.NullString{
+			String: searchOpts.memberUserID,
+			Valid:  searchOpts.memberUserID != "",
+		},
+		PageSize: _defaultPageSize,
+	}
+	if limit := searchOpts.pageOpts.Limit(); limit > 0 {
+		queryParams.PageSize = int32(limit)
+	}
+	if searchOpts.pageOpts.HasPageToken() {
+		if err := paging.ParseToken(p.pageTokenCipherKey, searchOpts.pageOpts.PageToken(), &queryParams); err != nil {
+			return nil, err
+		} else if queryParams.Query != query || queryParams.MemberUserID.String != searchOpts.memberUserID {
+			return nil, ErrPageTokenMismatch
+		}
+	}
+
+	// fetch an extra row to know whether more rows follow
+	pageQuery := queryParams
+	pageQuery.PageSize++
+	rows, err := p.db.SearchOrganizations(ctx, pageQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextToken string
+	if len(rows) > int(queryParams.PageSize) {
+		rows = rows[:queryParams.PageSize]
+		last := rows[len(rows)-1]
+		queryParams.CursorRank = sql.NullFloat64{
+			Float64: last.Rank,
+			Valid:   true,
+		}
+		queryParams.CursorOrganizationID = sql.NullString{
+			String: last.Organization.OrganizationID,
+			Valid:  true,
+		}
+		nextToken, err = paging.NewToken(p.pageTokenCipherKey, queryParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &paging.Page[SearchResult]{
+		TotalItems:    len(rows),
+		NextPageToken: nextToken,
+		Items: lo.Map(rows, func(item postgresgen.SearchOrganizationsRow, _ int) SearchResult {
+			return SearchResult{
+				Organization: newFromPostgres(item.Organization),
+				Score:        item.Rank,
+				Highlight:    item.Highlight,
+			}
+		}),
+	}, nil
+}
+
+// newPrefixTSQuery builds a tsquery expression matching every term of query, the last one as a prefix
+// (e.g. `acme & cor:*`). Terms are reduced to letters and digits so the expression is always valid.
+func newPrefixTSQuery(query string) string {
+	terms := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(terms) == 0 {
+		return ""
+	}
+	terms[len(terms)-1] += ":*"
+	return strings.Join(terms, " & ")
+}
+
+// - Hierarchy -
+
+// findAncestorsPostgres retrieves the ancestors of the organization identified by key, from its parent up to
+// the root.
+func findAncestorsPostgres(ctx context.Context, db *postgresgen.Queries, key string) ([]Organization, error) {
+	rows, err := db.ListOrganizationAncestors(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(item postgresgen.ListOrganizationAncestorsRow, _ int) Organization {
+		return newFromPostgres(item.Organization)
+	}), nil
+}
+
+// findDescendantsPostgres retrieves the descendants of the organization identified by key breadth-first.
+func findDescendantsPostgres(ctx context.Context, db *postgresgen.Queries, key string) ([]Organization, error) {
+	rows, err := db.ListOrganizationDescendants(ctx, newNullString(key))
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(item postgresgen.ListOrganizationDescendantsRow, _ int) Organization {
+		return newFromPostgres(item.Organization)
+	}), nil
+}
+
+// - History Repository(s) -
+
+// PostgresHistoryRepository is the concrete implementation of the [HistoryRepository] interface for Postgres.
+type PostgresHistoryRepository struct {
+	db                 *postgresgen.Queries
+	pageTokenCipherKey []byte
+}
+
+// compile-time assertion(s)
+var (
+	_ HistoryRepository = (*PostgresHistoryRepository)(nil)
+)
+
+// NewPostgresHistoryRepository creates a new [PostgresHistoryRepository] instance.
+//
+// Operations take part of the transaction carried by the context, if any (see [sqltx.Runner]).
+func NewPostgresHistoryRepository(db gecksql.DB, tokenConfig paging.TokenConfig) PostgresHistoryRepository {
+	return PostgresHistoryRepository{
+		db:                 postgresgen.New(sqltx.NewConn(db)),
+		pageTokenCipherKey: tokenConfig.CipherKeyBytes,
+	}
+}
+
+func (p PostgresHistoryRepository) Append(ctx context.Context, record HistoryRecord) error {
+	before, err := json.Marshal(record.Before)
+	if err != nil {
+		return err
+	}
+	after, err := json.Marshal(record.After)
+	if err != nil {
+		return err
+	}
+	return p.db.CreateOrganizationHistoryRecord(ctx, postgresgen.CreateOrganizationHistoryRecordParams{
+		OrganizationID: record.OrganizationID,
+		Operation:      string(record.Operation),
+		Actor:          record.Actor,
+		RequestID:      record.RequestID,
+		BeforeSnapshot: before,
+		AfterSnapshot:  after,
+		RecordTime:     record.Time,
+	})
+}
+
+func (p PostgresHistoryRepository) FindAll(ctx context.Context, organizationID string,
+	opts ...HistoryOption) (*paging.Page[HistoryRecord], error) {
+	historyOpts := historyOptions{}
+	for _, opt := range opts {
+		opt(&historyOpts)
+	}
+
+	// DEV-NOTE: Keyset pagination. Pages are delimited by the record_id of their last row, only forward
+	// pagination is supported. Page tokens carry the whole query so every page applies the same filters.
+	query := postgresgen.ListOrganizationHistoryParams{
+		OrganizationID: organizationID,
+		StartTime: sql.NullTime{
+			Time:  historyOpts.startTime,
+			Valid: !historyOpts.startTime.IsZero(),
+		},
+		EndTime: sql.NullTime{
+			Time:  historyOpts.endTime,
+			Valid: !historyOpts.endTime.IsZero(),
+		},
+		PageSize: _defaultPageSize,
+	}
+	if limit := historyOpts.pageOpts.Limit(); limit > 0 {
+		query.PageSize = int32(limit)
+	}
+	if historyOpts.pageOpts.HasPageToken() {
+		if err := paging.ParseToken(p.pageTokenCipherKey, historyOpts.pageOpts.PageToken(), &query); err != nil {
+			return nil, err
+		} else if query.OrganizationID != organizationID {
+			return nil, ErrPageTokenMismatch
+		}
+	}
+
+	// fetch an extra row to know whether more rows follow
+	pageQuery := query
+	pageQuery.PageSize++
+	models, err := p.db.ListOrganizationHistory(ctx, pageQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextToken string
+	if len(models) > int(query.PageSize) {
+		models = models[:query.PageSize]
+		query.CursorRecordID = sql.NullInt64{
+			Int64: models[len(models)-1].RecordID,
+			Valid: true,
+		}
+		nextToken, err = paging.NewToken(p.pageTokenCipherKey, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	records := make([]HistoryRecord, 0, len(models))
+	for _, model := range models {
+		record, errMap := newHistoryRecordFromPostgres(model)
+		if errMap != nil {
+			return nil, errMap
+		}
+		records = append(records, record)
+	}
+	return &paging.Page[HistoryRecord]{
+		TotalItems:    len(records),
+		NextPageToken: nextToken,
+		Items:         records,
+	}, nil
+}
+
+// - Error(s) -
+
+const (
+	// _pgUniqueViolation is the Postgres error code (SQLSTATE) raised when a unique constraint is violated.
+	_pgUniqueViolation = "23505"
+	// _pgForeignKeyViolation is the Postgres error code (SQLSTATE) raised when a foreign key constraint is
+	// violated.
+	_pgForeignKeyViolation = "23503"
+)
+
+const (
+	// _pgPrimaryKey is the name of the primary key constraint of organizations.
+	_pgPrimaryKey = "organizations_pkey"
+	// _pgNameUniqueIndex is the name of the unique index enforcing name uniqueness.
+	_pgNameUniqueIndex = "idx_organizations_name_unique"
+	// _pgSlugUniqueIndex is the name of the unique index enforcing slug uniqueness.
+	_pgSlugUniqueIndex = "idx_organizations_slug_unique"
+)
+
+// isPostgresError checks whether err is a Postgres error with the given code (SQLSTATE).
+func isPostgresError(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
+
+// isConstraintViolation checks whether err is a Postgres error with the given code (SQLSTATE) raised by the
+// given constraint (or index).
+func isConstraintViolation(err error, code, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code && pgErr.ConstraintName == constraint
+}
+
+// translatePostgresError converts Postgres errors into domain errors. Unique violations of constraints other
+// than the ones of organizations (e.g. the ones of related tables) are returned as they are.
+func translatePostgresError(err error) error {
+	switch {
+	case isConstraintViolation(err, _pgUniqueViolation, _pgSlugUniqueIndex):
+		return ErrSlugAlreadyExists
+	case isConstraintViolation(err, _pgUniqueViolation, _pgNameUniqueIndex),
+		isConstraintViolation(err, _pgUniqueViolation, _pgPrimaryKey):
+		return ErrAlreadyExists
+	case isPostgresError(err, _pgForeignKeyViolation):
+		// the parent was purged meanwhile
+		return ErrParentNotFound
+	default:
+		return err
+	}
+}
+
+// - Mapper(s) -
+
+// newFromPostgres builds an [Organization] from its Postgres model.
+func newFromPostgres(model postgresgen.Organization) Organization {
+	return Organization{
+		id:               model.OrganizationID,
+		name:             model.Name,
+		slug:             model.Slug,
+		status:           Status(model.Status),
+		statusReason:     StatusReason(model.StatusReason),
+		labels:           newLabelsFromPostgres(model.Labels),
+		parentID:         model.ParentID.String,
+		persistedVersion: uint64(model.RowVersion),
+		persistedSlug:    model.Slug,
 		Auditable: audit.New(audit.NewArgs{
 			CreateTime:     model.CreateTime,
 			CreateBy:       model.CreateBy,
@@ -245,5 +819,60 @@ func (p PostgresReadRepository) FindByKey(ctx context.Context, key string) (*Org
 			Version:        uint64(model.RowVersion),
 			IsDeleted:      model.IsDeleted,
 		}),
-	}, nil
+		profile: Profile{
+			Description: model.Description,
+			WebsiteURL:  model.WebsiteUrl,
+			LogoURL:     model.LogoUrl,
+			Country:     model.CountryCode,
+			Locale:      model.Locale,
+			TimeZone:    model.TimeZone,
+			LegalID:     model.LegalID,
+			TaxID:       model.TaxID,
+		},
+	}
+}
+
+// newPostgresLabels returns the JSON object of labels stored in the labels column.
+func newPostgresLabels(labels Labels) json.RawMessage {
+	if labels == nil {
+		labels = Labels{}
+	}
+	// string maps always marshal
+	raw, _ := json.Marshal(labels)
+	return raw
+}
+
+// newLabelsFromPostgres returns the labels of the JSON object stored in the labels column.
+func newLabelsFromPostgres(raw json.RawMessage) Labels {
+	labels := Labels{}
+	// the column is a JSONB object of strings, so it always unmarshals
+	_ = json.Unmarshal(raw, &labels)
+	return labels
+}
+
+// newNullString returns the nullable Postgres value of s, empty strings are null.
+func newNullString(s string) sql.NullString {
+	return sql.NullString{
+		String: s,
+		Valid:  s != "",
+	}
+}
+
+// newHistoryRecordFromPostgres builds a [HistoryRecord] from its Postgres model.
+func newHistoryRecordFromPostgres(model postgresgen.OrganizationHistory) (HistoryRecord, error) {
+	record := HistoryRecord{
+		ID:             model.RecordID,
+		OrganizationID: model.OrganizationID,
+		Operation:      Operation(model.Operation),
+		Actor:          model.Actor,
+		RequestID:      model.RequestID,
+		Time:           model.RecordTime,
+	}
+	// JSON null snapshots decode into nil
+	if err := json.Unmarshal(model.BeforeSnapshot, &record.Before); err != nil {
+		return HistoryRecord{}, err
+	} else if err = json.Unmarshal(model.AfterSnapshot, &record.After); err != nil {
+		return HistoryRecord{}, err
+	}
+	return record, nil
 }
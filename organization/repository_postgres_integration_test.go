@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/internal/sqltx"
 	"github.com/hadroncorp/service-template/organization"
 )
 
@@ -33,6 +34,7 @@ type postgresRepositoryIntegrationSuite struct {
 	queryer           *postgresgen.Queries
 	repository        organization.Repository
 	readRepository    organization.ReadRepository
+	searchRepository  organization.SearchRepository
 }
 
 func TestLocalManagerIntegrationSuite(t *testing.T) {
@@ -74,6 +76,7 @@ func (s *postgresRepositoryIntegrationSuite) SetupSuite() {
 	tokenConfig, err := paging.NewTokenConfig()
 	s.Require().NoError(err)
 	s.readRepository = organization.NewPostgresReadRepository(s.db, tokenConfig)
+	s.searchRepository = organization.NewPostgresSearchRepository(s.db, tokenConfig)
 }
 
 func (s *postgresRepositoryIntegrationSuite) TearDownSuite() {
@@ -125,6 +128,76 @@ func (s *postgresRepositoryIntegrationSuite) execSeed() error {
 			RowVersion:     0,
 			IsDeleted:      false,
 		},
+		{
+			OrganizationID: "5",
+			Name:           "to-update-concurrently",
+			CreateTime:     now.Add(time.Minute * 6),
+			CreateBy:       "some-user",
+			LastUpdateTime: now.Add(time.Minute * 6),
+			LastUpdateBy:   "some-user",
+			RowVersion:     3,
+			IsDeleted:      false,
+		},
+		{
+			OrganizationID: "6",
+			Name:           "to-delete-concurrently",
+			CreateTime:     now.Add(time.Minute * 7),
+			CreateBy:       "some-user",
+			LastUpdateTime: now.Add(time.Minute * 7),
+			LastUpdateBy:   "some-user",
+			RowVersion:     3,
+			IsDeleted:      false,
+		},
+		{
+			OrganizationID: "7",
+			Name:           "Acme Corporation",
+			CreateTime:     now.Add(time.Minute * 8),
+			CreateBy:       "some-user",
+			LastUpdateTime: now.Add(time.Minute * 8),
+			LastUpdateBy:   "some-user",
+			RowVersion:     0,
+			IsDeleted:      false,
+		},
+		{
+			OrganizationID: "8",
+			Name:           "Acme Labs",
+			CreateTime:     now.Add(time.Minute * 9),
+			CreateBy:       "some-user",
+			LastUpdateTime: now.Add(time.Minute * 9),
+			LastUpdateBy:   "some-user",
+			RowVersion:     0,
+			IsDeleted:      false,
+		},
+		{
+			OrganizationID: "9",
+			Name:           "Globex Industries",
+			CreateTime:     now.Add(time.Minute * 10),
+			CreateBy:       "some-user",
+			LastUpdateTime: now.Add(time.Minute * 10),
+			LastUpdateBy:   "some-user",
+			RowVersion:     0,
+			IsDeleted:      false,
+		},
+		{
+			OrganizationID: "10",
+			Name:           "Acme Deleted",
+			CreateTime:     now.Add(time.Minute * 11),
+			CreateBy:       "some-user",
+			LastUpdateTime: now.Add(time.Minute * 11),
+			LastUpdateBy:   "some-user",
+			RowVersion:     0,
+			IsDeleted:      true,
+		},
+		{
+			OrganizationID: "11",
+			Name:           "to-change-slug",
+			CreateTime:     now.Add(time.Minute * 12),
+			CreateBy:       "some-user",
+			LastUpdateTime: now.Add(time.Minute * 12),
+			LastUpdateBy:   "some-user",
+			RowVersion:     0,
+			IsDeleted:      false,
+		},
 	}
 
 	tx, err := s.db.BeginTx(s.baseCtx, &sql.TxOptions{
@@ -138,17 +211,33 @@ func (s *postgresRepositoryIntegrationSuite) execSeed() error {
 	}()
 	qtx := s.queryer.WithTx(tx)
 	for _, cmd := range createCommands {
+		// slugs mirror IDs, as for organizations created before slugs existed
+		cmd.Slug = cmd.OrganizationID
 		if err = qtx.CreateOrganization(s.baseCtx, cmd); err != nil {
 			return err
 		}
 	}
+	for _, orgID := range []string{"7", "9"} {
+		err = qtx.CreateOrganizationMember(s.baseCtx, postgresgen.CreateOrganizationMemberParams{
+			OrganizationID: orgID,
+			UserID:         "member-user",
+			Role:           "member",
+			CreateTime:     now,
+			CreateBy:       "some-user",
+			LastUpdateTime: now,
+			LastUpdateBy:   "some-user",
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return tx.Commit()
 }
 
 func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_ExistsByName_Exists() {
 	// arrange
 	// act
-	exists, err := s.repository.ExistsByName(s.baseCtx, "foo")
+	exists, err := s.repository.ExistsByName(s.baseCtx, "foo", "")
 	// assert
 	s.Assert().NoError(err)
 	s.Assert().True(exists)
@@ -157,12 +246,49 @@ func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_ExistsByName
 func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_ExistsByName_NotExists() {
 	// arrange
 	// act
-	exists, err := s.repository.ExistsByName(s.baseCtx, strconv.Itoa(rand.Int()))
+	exists, err := s.repository.ExistsByName(s.baseCtx, strconv.Itoa(rand.Int()), "")
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().False(exists)
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_ExistsByName_Case_Insensitive() {
+	// arrange
+	// act
+	exists, err := s.repository.ExistsByName(s.baseCtx, "FOO", "")
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().True(exists)
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_ExistsByName_Excluded() {
+	// arrange
+	// act
+	exists, err := s.repository.ExistsByName(s.baseCtx, "foo", "1")
 	// assert
 	s.Assert().NoError(err)
 	s.Assert().False(exists)
 }
 
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Save_Create_Name_Taken() {
+	// arrange
+	entity := organization.New(s.baseCtx, strconv.Itoa(rand.Int()), "Foo")
+	// act
+	err := s.repository.Save(s.baseCtx, entity)
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrAlreadyExists)
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Save_Create_ID_Taken() {
+	// arrange
+	entity := organization.New(s.baseCtx, "1", "id-taken")
+	// act
+	err := s.repository.Save(s.baseCtx, entity)
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrAlreadyExists)
+	s.Assert().NotErrorIs(err, organization.ErrSlugAlreadyExists)
+}
+
 func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Save_Create() {
 	// arrange
 	entity := organization.New(s.baseCtx, strconv.Itoa(rand.Int()), "bar")
@@ -183,12 +309,73 @@ func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Save_Update(
 	s.Assert().NoError(err)
 }
 
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Save_Update_VersionConflict() {
+	// arrange
+	first, err := s.repository.FindByKey(s.baseCtx, "5")
+	s.Require().NoError(err)
+	s.Require().NotNil(first)
+	second, err := s.repository.FindByKey(s.baseCtx, "5")
+	s.Require().NoError(err)
+	s.Require().NotNil(second)
+	_ = first.Update(s.baseCtx, organization.WithUpdatedName(lo.ToPtr("first-writer")))
+	_ = second.Update(s.baseCtx, organization.WithUpdatedName(lo.ToPtr("second-writer")))
+
+	// act
+	err = s.repository.Save(s.baseCtx, *first)
+	s.Require().NoError(err)
+	err = s.repository.Save(s.baseCtx, *second)
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrVersionConflict)
+	stored, err := s.repository.FindByKey(s.baseCtx, "5")
+	s.Require().NoError(err)
+	s.Assert().Equal("first-writer", stored.Name())
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Save_Update_ExpectedVersion() {
+	// arrange
+	entity, err := s.repository.FindByKey(s.baseCtx, "5")
+	s.Require().NoError(err)
+	s.Require().NotNil(entity)
+	_ = entity.Update(s.baseCtx,
+		organization.WithUpdatedName(lo.ToPtr("stale-writer")),
+		organization.WithExpectedVersion(entity.Version()+100),
+	)
+
+	// act
+	err = s.repository.Save(s.baseCtx, *entity)
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrVersionConflict)
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Delete_VersionConflict() {
+	// arrange
+	entity, err := s.repository.FindByKey(s.baseCtx, "6")
+	s.Require().NoError(err)
+	s.Require().NotNil(entity)
+	entity.Delete(s.baseCtx, organization.WithDeleteExpectedVersion(0))
+
+	// act
+	err = s.repository.Delete(s.baseCtx, *entity)
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrVersionConflict)
+	stored, err := s.repository.FindByKey(s.baseCtx, "6")
+	s.Require().NoError(err)
+	s.Assert().NotNil(stored)
+}
+
 func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_DeleteByKey() {
 	// arrange
 	// act
 	err := s.repository.DeleteByKey(s.baseCtx, "3")
 	// assert
 	s.Assert().NoError(err)
+	entity, err := s.repository.FindByKey(s.baseCtx, "3")
+	s.Assert().NoError(err)
+	s.Require().NotNil(entity)
+	s.Assert().True(entity.IsDeleted())
 }
 
 func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Delete() {
@@ -198,6 +385,23 @@ func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_Delete() {
 	err := s.repository.Delete(s.baseCtx, entity)
 	// assert
 	s.Assert().NoError(err)
+	stored, err := s.repository.FindByKey(s.baseCtx, "4")
+	s.Assert().NoError(err)
+	s.Require().NotNil(stored)
+	s.Assert().True(stored.IsDeleted())
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_PurgeByKey() {
+	// arrange
+	entity := organization.New(s.baseCtx, strconv.Itoa(rand.Int()), "to-purge")
+	s.Require().NoError(s.repository.Save(s.baseCtx, entity))
+	// act
+	err := s.repository.PurgeByKey(s.baseCtx, entity.ID())
+	// assert
+	s.Assert().NoError(err)
+	stored, err := s.repository.FindByKey(s.baseCtx, entity.ID())
+	s.Assert().NoError(err)
+	s.Assert().Nil(stored)
 }
 
 func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_FindByKey_Exists() {
@@ -209,6 +413,32 @@ func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_FindByKey_Ex
 	s.Assert().NotNil(entity)
 }
 
+func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_LockByKey() {
+	// arrange
+	txRunner := sqltx.NewDBRunner(s.db)
+
+	// act
+	err := txRunner.RunInTx(s.baseCtx, func(ctx context.Context) error {
+		entity, errLock := s.repository.LockByKey(ctx, "1")
+		s.Require().NoError(errLock)
+		s.Require().NotNil(entity)
+
+		// writers outside the transaction wait until it ends
+		writeCtx, cancel := context.WithTimeout(s.baseCtx, time.Millisecond*200)
+		defer cancel()
+		_, errWrite := s.db.ExecContext(writeCtx,
+			"UPDATE organizations SET row_version = row_version WHERE organization_id = '1'")
+		s.Assert().Error(errWrite)
+		return nil
+	})
+
+	// assert
+	s.Require().NoError(err)
+	missing, err := s.repository.LockByKey(s.baseCtx, strconv.Itoa(rand.Int()))
+	s.Assert().NoError(err)
+	s.Assert().Nil(missing)
+}
+
 func (s *postgresRepositoryIntegrationSuite) TestReadPostgresRepository_FindByKey_Exists() {
 	// arrange
 	// act
@@ -227,6 +457,31 @@ func (s *postgresRepositoryIntegrationSuite) TestPostgresRepository_FindByKey_No
 	s.Assert().Nil(entity)
 }
 
+func (s *postgresRepositoryIntegrationSuite) TestReadPostgresRepository_FindBySlug_Redirect() {
+	// arrange
+	entity, err := s.repository.FindByKey(s.baseCtx, "11")
+	s.Require().NoError(err)
+	s.Require().NotNil(entity)
+	_ = entity.Update(s.baseCtx, organization.WithUpdatedSlug(lo.ToPtr("Renamed-Slug")))
+	s.Require().NoError(s.repository.Save(s.baseCtx, *entity))
+
+	// act
+	current, errCurrent := s.readRepository.FindBySlug(s.baseCtx, "renamed-slug")
+	previous, errPrevious := s.readRepository.FindBySlug(s.baseCtx, "11")
+	missing, errMissing := s.readRepository.FindBySlug(s.baseCtx, "not-a-slug")
+
+	// assert
+	s.Require().NoError(errCurrent)
+	s.Require().NotNil(current)
+	s.Assert().Equal("11", current.ID())
+	s.Assert().Equal("renamed-slug", current.Slug())
+	s.Require().NoError(errPrevious)
+	s.Require().NotNil(previous)
+	s.Assert().Equal("11", previous.ID())
+	s.Assert().NoError(errMissing)
+	s.Assert().Nil(missing)
+}
+
 func (s *postgresRepositoryIntegrationSuite) TestReadPostgresRepository_FindAll() {
 	// arrange
 	const pageSize = 1
@@ -247,6 +502,7 @@ func (s *postgresRepositoryIntegrationSuite) TestReadPostgresRepository_FindAll(
 		organization.WithListPageOptions(
 			paging.WithPageToken(page.NextPageToken),
 		),
+		organization.WithListNonDeletedOnly(),
 	)
 	s.Assert().NoError(err)
 	s.Assert().NotEmpty(page)
@@ -257,6 +513,7 @@ func (s *postgresRepositoryIntegrationSuite) TestReadPostgresRepository_FindAll(
 		organization.WithListPageOptions(
 			paging.WithPageToken(page.PreviousPageToken),
 		),
+		organization.WithListNonDeletedOnly(),
 	)
 	s.Assert().NoError(err)
 	s.Assert().NotEmpty(page)
@@ -264,3 +521,103 @@ func (s *postgresRepositoryIntegrationSuite) TestReadPostgresRepository_FindAll(
 	s.Assert().NotZero(page.NextPageToken)
 	s.Assert().Equal(firstPageHead.CreateTime(), page.Items[0].CreateTime())
 }
+
+func (s *postgresRepositoryIntegrationSuite) TestReadPostgresRepository_FindAll_Member() {
+	// arrange
+	// act
+	page, err := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListMember("member-user"),
+		organization.WithListPageOptions(paging.WithLimit(1)),
+	)
+	// assert
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Assert().Equal("7", page.Items[0].ID())
+
+	page, err = s.readRepository.FindAll(s.baseCtx,
+		organization.WithListMember("member-user"),
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Assert().Equal("9", page.Items[0].ID())
+
+	// page tokens cannot be used by another member
+	_, err = s.readRepository.FindAll(s.baseCtx,
+		organization.WithListMember("other-user"),
+		organization.WithListPageOptions(paging.WithPageToken(page.PreviousPageToken)),
+	)
+	s.Assert().ErrorIs(err, organization.ErrPageTokenMismatch)
+}
+
+func searchResultIDs(page *paging.Page[organization.SearchResult]) []string {
+	return lo.Map(page.Items, func(item organization.SearchResult, _ int) string {
+		return item.Organization.ID()
+	})
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestSearchPostgresRepository_Search_FullText() {
+	// arrange
+	// act
+	page, err := s.searchRepository.Search(s.baseCtx, "acme")
+	// assert
+	s.Require().NoError(err)
+	s.Assert().ElementsMatch([]string{"7", "8"}, searchResultIDs(page))
+	for _, item := range page.Items {
+		s.Assert().Positive(item.Score)
+		s.Assert().Contains(item.Highlight, "<mark>Acme</mark>")
+	}
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestSearchPostgresRepository_Search_Autocomplete() {
+	// arrange
+	// act
+	page, err := s.searchRepository.Search(s.baseCtx, "acme cor")
+	// assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.Items)
+	s.Assert().Equal("7", page.Items[0].Organization.ID())
+	s.Assert().Equal("<mark>Acme</mark> <mark>Corporation</mark>", page.Items[0].Highlight)
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestSearchPostgresRepository_Search_Typo() {
+	// arrange
+	// act
+	page, err := s.searchRepository.Search(s.baseCtx, "globx industries")
+	// assert
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.Items)
+	s.Assert().Equal("9", page.Items[0].Organization.ID())
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestSearchPostgresRepository_Search_Member() {
+	// arrange
+	// act
+	page, err := s.searchRepository.Search(s.baseCtx, "acme", organization.WithSearchMember("member-user"))
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal([]string{"7"}, searchResultIDs(page))
+}
+
+func (s *postgresRepositoryIntegrationSuite) TestSearchPostgresRepository_Search_Paging() {
+	// arrange
+	page, err := s.searchRepository.Search(s.baseCtx, "acme",
+		organization.WithSearchPageOptions(paging.WithLimit(1)))
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	// act
+	nextPage, err := s.searchRepository.Search(s.baseCtx, "acme",
+		organization.WithSearchPageOptions(paging.WithPageToken(page.NextPageToken)))
+	_, errChanged := s.searchRepository.Search(s.baseCtx, "globex",
+		organization.WithSearchPageOptions(paging.WithPageToken(page.NextPageToken)))
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Len(nextPage.Items, 1)
+	s.Assert().Empty(nextPage.NextPageToken)
+	s.Assert().ElementsMatch([]string{"7", "8"}, append(searchResultIDs(page), searchResultIDs(nextPage)...))
+	s.Assert().GreaterOrEqual(page.Items[0].Score, nextPage.Items[0].Score)
+	s.Assert().ErrorIs(errChanged, organization.ErrPageTokenMismatch)
+}
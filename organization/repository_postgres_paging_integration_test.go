@@ -0,0 +1,503 @@
+//go:build integration
+
+package organization_test
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/persistence/postgres/postgrestest"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/organization"
+)
+
+// postgresReadRepositoryPagingIntegrationSuite checks pagination properties of [organization.PostgresReadRepository]
+// over randomly generated datasets.
+type postgresReadRepositoryPagingIntegrationSuite struct {
+	suite.Suite
+
+	baseCtx           context.Context
+	baseCtxCancelFunc context.CancelFunc
+	dbContainer       *postgrestest.Container
+	db                gecksql.DB
+	queryer           *postgresgen.Queries
+	readRepository    organization.ReadRepository
+}
+
+func TestPostgresReadRepositoryPagingIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(postgresReadRepositoryPagingIntegrationSuite))
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) SetupSuite() {
+	// setup context
+	const testSuiteTimeout = time.Minute * 2
+	s.baseCtx, s.baseCtxCancelFunc = context.WithTimeout(context.Background(), testSuiteTimeout)
+
+	// setup container
+	var err error
+	s.dbContainer, err = postgrestest.NewContainer(s.baseCtx, s.T())
+	s.Require().NoError(err)
+	db, err := postgrestest.StartContainer(s.baseCtx, s.T(), s.dbContainer, "./thirdparty/postgres/migrations")
+	s.Require().NoError(err)
+	s.db = gecksql.NewDB(db)
+	s.queryer = postgresgen.New(s.db)
+
+	// setup repository
+	tokenConfig, err := paging.NewTokenConfig()
+	s.Require().NoError(err)
+	s.readRepository = organization.NewPostgresReadRepository(s.db, tokenConfig)
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) TearDownSuite() {
+	defer s.baseCtxCancelFunc()
+	shutdownCtx, cancelFunc := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFunc()
+	s.Assert().NoError(s.dbContainer.Instance.Terminate(shutdownCtx))
+}
+
+// _pagingBaseTime is the earliest creation time of generated datasets.
+var _pagingBaseTime = time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+
+// execRandomSeed replaces the organizations table contents with a random dataset. Creation times are picked
+// from a small set of timestamps so many rows share the very same create_time.
+//
+// It returns the dataset sorted by (create_time, organization_id).
+func (s *postgresReadRepositoryPagingIntegrationSuite) execRandomSeed(rnd *rand.Rand) []postgresgen.Organization {
+	_, err := s.db.ExecContext(s.baseCtx, "TRUNCATE TABLE organizations CASCADE")
+	s.Require().NoError(err)
+
+	totalItems := rnd.IntN(40)
+	distinctTimes := rnd.IntN(5) + 1
+	models := make([]postgresgen.Organization, 0, totalItems)
+	for i := range totalItems {
+		createTime := _pagingBaseTime.Add(time.Duration(rnd.IntN(distinctTimes)) * time.Minute)
+		model := postgresgen.Organization{
+			OrganizationID: fmt.Sprintf("org-%06d", rnd.IntN(1000)*100+i),
+			Name:           fmt.Sprintf("%s-%02d", []string{"acme", "globex"}[rnd.IntN(2)], i),
+			CreateTime:     createTime,
+			CreateBy:       []string{"some-user", "some-other-user"}[rnd.IntN(2)],
+			LastUpdateTime: createTime,
+			LastUpdateBy:   "some-user",
+			IsDeleted:      rnd.IntN(3) == 0,
+		}
+		err = s.queryer.CreateOrganization(s.baseCtx, postgresgen.CreateOrganizationParams{
+			OrganizationID: model.OrganizationID,
+			Name:           model.Name,
+			CreateTime:     model.CreateTime,
+			CreateBy:       model.CreateBy,
+			LastUpdateTime: model.LastUpdateTime,
+			LastUpdateBy:   model.LastUpdateBy,
+			IsDeleted:      model.IsDeleted,
+			Slug:           model.OrganizationID,
+		})
+		s.Require().NoError(err)
+		models = append(models, model)
+	}
+	slices.SortFunc(models, func(a, b postgresgen.Organization) int {
+		return cmp.Or(a.CreateTime.Compare(b.CreateTime), cmp.Compare(a.OrganizationID, b.OrganizationID))
+	})
+	return models
+}
+
+// walkForward lists every page following next page tokens, opts are passed on every page as transports do. It
+// returns the item IDs of each page.
+func (s *postgresReadRepositoryPagingIntegrationSuite) walkForward(pageSize int,
+	opts ...organization.ListOption) ([][]string, *paging.Page[organization.Organization]) {
+	page, err := s.readRepository.FindAll(s.baseCtx,
+		append(opts, organization.WithListPageOptions(paging.WithLimit(pageSize)))...)
+	s.Require().NoError(err)
+	s.Require().NotNil(page)
+	s.Require().Empty(page.PreviousPageToken)
+
+	pages := [][]string{pageIDs(page)}
+	for page.NextPageToken != "" {
+		page, err = s.readRepository.FindAll(s.baseCtx,
+			append(opts, organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)))...)
+		s.Require().NoError(err)
+		s.Require().NotNil(page)
+		s.Require().NotEmpty(page.PreviousPageToken)
+		pages = append(pages, pageIDs(page))
+		s.Require().Less(len(pages), 1000, "pagination does not terminate")
+	}
+	return pages, page
+}
+
+// walkBackward lists every page before last following previous page tokens, opts are passed on every page as
+// transports do. It returns the item IDs of each page, in listing order.
+func (s *postgresReadRepositoryPagingIntegrationSuite) walkBackward(last *paging.Page[organization.Organization],
+	opts ...organization.ListOption) [][]string {
+	pages := [][]string{pageIDs(last)}
+	page := last
+	for page.PreviousPageToken != "" {
+		var err error
+		page, err = s.readRepository.FindAll(s.baseCtx,
+			append(opts, organization.WithListPageOptions(paging.WithPageToken(page.PreviousPageToken)))...)
+		s.Require().NoError(err)
+		s.Require().NotNil(page)
+		s.Require().NotEmpty(page.NextPageToken)
+		pages = append([][]string{pageIDs(page)}, pages...)
+		s.Require().Less(len(pages), 1000, "pagination does not terminate")
+	}
+	return pages
+}
+
+func pageIDs(page *paging.Page[organization.Organization]) []string {
+	return lo.Map(page.Items, func(item organization.Organization, _ int) string {
+		return item.ID()
+	})
+}
+
+// listCase is a listing checked by TestPostgresReadRepository_FindAll_Properties.
+type listCase struct {
+	name    string
+	opts    []organization.ListOption
+	keep    func(model postgresgen.Organization) bool
+	compare func(a, b postgresgen.Organization) int
+}
+
+func compareCreateTime(a, b postgresgen.Organization) int {
+	return cmp.Or(a.CreateTime.Compare(b.CreateTime), cmp.Compare(a.OrganizationID, b.OrganizationID))
+}
+
+func compareName(a, b postgresgen.Organization) int {
+	return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.OrganizationID, b.OrganizationID))
+}
+
+func reverseCompare(compare func(a, b postgresgen.Organization) int) func(a, b postgresgen.Organization) int {
+	return func(a, b postgresgen.Organization) int {
+		return compare(b, a)
+	}
+}
+
+func newListCases() []listCase {
+	keepAll := func(postgresgen.Organization) bool { return true }
+	return []listCase{
+		{
+			name:    "all",
+			keep:    keepAll,
+			compare: compareCreateTime,
+		},
+		{
+			name:    "non-deleted",
+			opts:    []organization.ListOption{organization.WithListNonDeletedOnly()},
+			keep:    func(model postgresgen.Organization) bool { return !model.IsDeleted },
+			compare: compareCreateTime,
+		},
+		{
+			name:    "create-time-desc",
+			opts:    []organization.ListOption{organization.WithListSort(organization.ListSortByCreateTime, true)},
+			keep:    keepAll,
+			compare: reverseCompare(compareCreateTime),
+		},
+		{
+			name:    "name-asc",
+			opts:    []organization.ListOption{organization.WithListSort(organization.ListSortByName, false)},
+			keep:    keepAll,
+			compare: compareName,
+		},
+		{
+			name: "name-desc-non-deleted",
+			opts: []organization.ListOption{
+				organization.WithListSort(organization.ListSortByName, true),
+				organization.WithListNonDeletedOnly(),
+			},
+			keep:    func(model postgresgen.Organization) bool { return !model.IsDeleted },
+			compare: reverseCompare(compareName),
+		},
+		{
+			name: "filtered",
+			opts: []organization.ListOption{
+				organization.WithListCreateBy("some-user"),
+				organization.WithListNamePrefix("ACME"),
+				organization.WithListCreateTimeRange(_pagingBaseTime.Add(time.Minute), time.Time{}),
+			},
+			keep: func(model postgresgen.Organization) bool {
+				return model.CreateBy == "some-user" && strings.HasPrefix(model.Name, "acme") &&
+					!model.CreateTime.Before(_pagingBaseTime.Add(time.Minute))
+			},
+			compare: compareCreateTime,
+		},
+	}
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) TestPostgresReadRepository_FindAll_Properties() {
+	const iterations = 25
+	for i := range iterations {
+		seed := uint64(time.Now().UnixNano()) + uint64(i)
+		rnd := rand.New(rand.NewPCG(seed, seed))
+		dataset := s.execRandomSeed(rnd)
+
+		for _, listCase := range newListCases() {
+			expectedModels := lo.Filter(dataset, func(item postgresgen.Organization, _ int) bool {
+				return listCase.keep(item)
+			})
+			slices.SortFunc(expectedModels, listCase.compare)
+			expected := lo.Map(expectedModels, func(item postgresgen.Organization, _ int) string {
+				return item.OrganizationID
+			})
+			for _, pageSize := range []int{1, 2, 3, 7, 100} {
+				s.Run(fmt.Sprintf("seed_%d_%s_size_%d", seed, listCase.name, pageSize), func() {
+					forwardPages, lastPage := s.walkForward(pageSize, listCase.opts...)
+
+					// every item is listed exactly once and in sort order
+					s.Assert().Equal(expected, lo.Flatten(forwardPages))
+					// every page but the last one is full
+					for j, page := range forwardPages {
+						if j < len(forwardPages)-1 {
+							s.Assert().Len(page, pageSize)
+						} else {
+							s.Assert().LessOrEqual(len(page), pageSize)
+						}
+					}
+					// walking backwards yields the very same pages
+					s.Assert().Equal(forwardPages, s.walkBackward(lastPage, listCase.opts...))
+				})
+			}
+		}
+	}
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) TestPostgresReadRepository_FindAll_Sort_Changed() {
+	// arrange
+	_, err := s.db.ExecContext(s.baseCtx, "TRUNCATE TABLE organizations CASCADE")
+	s.Require().NoError(err)
+	for _, name := range []string{"foo", "bar"} {
+		err = s.queryer.CreateOrganization(s.baseCtx, postgresgen.CreateOrganizationParams{
+			OrganizationID: name,
+			Name:           name,
+			CreateTime:     _pagingBaseTime,
+			CreateBy:       "some-user",
+			LastUpdateTime: _pagingBaseTime,
+			LastUpdateBy:   "some-user",
+			Slug:           name,
+		})
+		s.Require().NoError(err)
+	}
+	page, err := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithLimit(1)),
+		organization.WithListSort(organization.ListSortByName, false),
+	)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	// act
+	_, errSame := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListSort(organization.ListSortByName, false),
+	)
+	_, errChanged := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListSort(organization.ListSortByName, true),
+	)
+
+	// assert
+	s.Assert().NoError(errSame)
+	s.Assert().ErrorIs(errChanged, organization.ErrPageTokenMismatch)
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) TestPostgresReadRepository_FindAll_Filter_Changed() {
+	// arrange
+	_, err := s.db.ExecContext(s.baseCtx, "TRUNCATE TABLE organizations CASCADE")
+	s.Require().NoError(err)
+	for _, name := range []string{"foo", "foobar", "bar"} {
+		err = s.queryer.CreateOrganization(s.baseCtx, postgresgen.CreateOrganizationParams{
+			OrganizationID: name,
+			Name:           name,
+			CreateTime:     _pagingBaseTime,
+			CreateBy:       "some-user",
+			LastUpdateTime: _pagingBaseTime,
+			LastUpdateBy:   "some-user",
+			Slug:           name,
+		})
+		s.Require().NoError(err)
+	}
+	page, err := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithLimit(1)),
+		organization.WithListNamePrefix("foo"),
+	)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	// act
+	same, errSame := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListNamePrefix("foo"),
+	)
+	tokenOnly, errTokenOnly := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+	)
+	_, errChanged := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListNamePrefix("bar"),
+	)
+	_, errAdded := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListCreateBy("some-user"),
+	)
+	_, errDeletedHidden := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListNamePrefix("foo"),
+		organization.WithListNonDeletedOnly(),
+	)
+
+	// assert
+	s.Require().NoError(errSame)
+	s.Require().NoError(errTokenOnly)
+	s.Assert().Equal("foobar", same.Items[0].Name())
+	s.Assert().Equal("foobar", tokenOnly.Items[0].Name())
+	s.Assert().ErrorIs(errChanged, organization.ErrPageTokenMismatch)
+	s.Assert().ErrorIs(errAdded, organization.ErrPageTokenMismatch)
+	s.Assert().ErrorIs(errDeletedHidden, organization.ErrPageTokenMismatch)
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) execLabeledSeed() {
+	_, err := s.db.ExecContext(s.baseCtx, "TRUNCATE TABLE organizations CASCADE")
+	s.Require().NoError(err)
+	seed := map[string]string{
+		"gold":     `{"tier":"gold","region":"eu"}`,
+		"platinum": `{"tier":"platinum"}`,
+		"silver":   `{"tier":"silver","region":"us"}`,
+		"none":     `{}`,
+	}
+	for name, labels := range seed {
+		err = s.queryer.CreateOrganization(s.baseCtx, postgresgen.CreateOrganizationParams{
+			OrganizationID: name,
+			Name:           name,
+			CreateTime:     _pagingBaseTime,
+			CreateBy:       "some-user",
+			LastUpdateTime: _pagingBaseTime,
+			LastUpdateBy:   "some-user",
+			Slug:           name,
+			Labels:         json.RawMessage(labels),
+		})
+		s.Require().NoError(err)
+	}
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) TestPostgresReadRepository_FindAll_Label_Selector() {
+	// arrange
+	s.execLabeledSeed()
+	tests := []struct {
+		name     string
+		selector string
+		exp      []string
+	}{
+		{
+			name:     "equals",
+			selector: "tier=gold",
+			exp:      []string{"gold"},
+		},
+		{
+			name:     "in",
+			selector: "tier in (gold,platinum)",
+			exp:      []string{"gold", "platinum"},
+		},
+		{
+			name:     "not equals",
+			selector: "tier!=gold",
+			exp:      []string{"none", "platinum", "silver"},
+		},
+		{
+			name:     "notin",
+			selector: "tier notin (gold,platinum)",
+			exp:      []string{"none", "silver"},
+		},
+		{
+			name:     "exists",
+			selector: "region",
+			exp:      []string{"gold", "silver"},
+		},
+		{
+			name:     "not exists",
+			selector: "!region",
+			exp:      []string{"none", "platinum"},
+		},
+		{
+			name:     "several requirements",
+			selector: "tier,!region",
+			exp:      []string{"platinum"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			selector, err := organization.ParseLabelSelector(tt.selector)
+			s.Require().NoError(err)
+
+			// act
+			page, err := s.readRepository.FindAll(s.baseCtx,
+				organization.WithListPageOptions(paging.WithLimit(10)),
+				organization.WithListSort(organization.ListSortByName, false),
+				organization.WithListLabelSelector(selector),
+			)
+
+			// assert
+			s.Require().NoError(err)
+			s.Assert().Equal(tt.exp, pageIDs(page))
+		})
+	}
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) TestPostgresReadRepository_FindAll_Label_Selector_Changed() {
+	// arrange
+	s.execLabeledSeed()
+	selector, err := organization.ParseLabelSelector("tier in (gold,platinum)")
+	s.Require().NoError(err)
+	otherSelector, err := organization.ParseLabelSelector("tier=silver")
+	s.Require().NoError(err)
+	page, err := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithLimit(1)),
+		organization.WithListSort(organization.ListSortByName, false),
+		organization.WithListLabelSelector(selector),
+	)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(page.NextPageToken)
+
+	// act
+	same, errSame := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListLabelSelector(selector),
+	)
+	tokenOnly, errTokenOnly := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+	)
+	_, errChanged := s.readRepository.FindAll(s.baseCtx,
+		organization.WithListPageOptions(paging.WithPageToken(page.NextPageToken)),
+		organization.WithListLabelSelector(otherSelector),
+	)
+
+	// assert
+	s.Require().NoError(errSame)
+	s.Require().NoError(errTokenOnly)
+	s.Assert().Equal([]string{"platinum"}, pageIDs(same))
+	s.Assert().Equal([]string{"platinum"}, pageIDs(tokenOnly))
+	s.Assert().ErrorIs(errChanged, organization.ErrPageTokenMismatch)
+}
+
+func (s *postgresReadRepositoryPagingIntegrationSuite) TestPostgresReadRepository_FindAll_Empty() {
+	// arrange
+	_, err := s.db.ExecContext(s.baseCtx, "TRUNCATE TABLE organizations CASCADE")
+	s.Require().NoError(err)
+
+	// act
+	page, err := s.readRepository.FindAll(s.baseCtx, organization.WithListPageOptions(paging.WithLimit(10)))
+
+	// assert
+	s.Require().NoError(err)
+	s.Require().NotNil(page)
+	s.Assert().Empty(page.Items)
+	s.Assert().Zero(page.TotalItems)
+	s.Assert().Empty(page.NextPageToken)
+	s.Assert().Empty(page.PreviousPageToken)
+}
@@ -3,11 +3,20 @@ package organization
 import (
 	"context"
 	"errors"
-
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env/v11"
 	"github.com/hadroncorp/geck/event"
 	"github.com/hadroncorp/geck/persistence"
 	"github.com/hadroncorp/geck/persistence/paging"
 	"github.com/hadroncorp/geck/syserr"
+
+	"github.com/hadroncorp/service-template/internal/sqltx"
 )
 
 // - Error(s) -
@@ -17,24 +26,95 @@ var (
 	ErrNotFound = syserr.NewResourceNotFound[Organization]()
 	// ErrAlreadyExists is returned when the organization already exists.
 	ErrAlreadyExists = syserr.NewResourceAlreadyExists[Organization]()
+	// ErrVersionConflict is returned when the organization was modified by someone else (i.e. its stored version
+	// differs from the expected one).
+	ErrVersionConflict = syserr.NewResourceConflict[Organization]()
+	// ErrPageTokenMismatch is returned when the list options given along a page token differ from the ones
+	// the page token was created with (e.g. sort order changed mid-pagination).
+	ErrPageTokenMismatch = errors.New("organization: list options do not match page token")
+	// ErrParentNotFound is returned when the parent organization is not found.
+	ErrParentNotFound = fmt.Errorf("organization: parent organization not found: %w", syserr.ErrResourceNotFound)
+	// ErrParentNotActive is returned when an organization is placed under a suspended or archived organization.
+	ErrParentNotActive = fmt.Errorf("organization: parent organization is not active: %w",
+		syserr.ErrResourceConflict)
+	// ErrHierarchyCycle is returned when an organization is moved under itself or one of its descendants.
+	ErrHierarchyCycle = fmt.Errorf("organization: organization cannot be placed under itself or its descendants: %w",
+		syserr.ErrResourceConflict)
+	// ErrMaxDepthExceeded is returned when placing an organization would make the hierarchy deeper than
+	// [HierarchyConfig.MaxDepth].
+	ErrMaxDepthExceeded = fmt.Errorf("organization: hierarchy maximum depth exceeded: %w", syserr.ErrResourceConflict)
+	// ErrHasChildren is returned when an organization with children is purged.
+	ErrHasChildren = fmt.Errorf("organization: organization has children: %w", syserr.ErrResourceConflict)
+	// ErrSlugAlreadyExists is returned when the slug is taken by another organization.
+	ErrSlugAlreadyExists = fmt.Errorf("organization: slug already exists: %w", syserr.ErrResourceAlreadyExists)
+	// ErrInvalidSlug is returned when the slug does not match the slug format (see [IsValidSlug]).
+	ErrInvalidSlug = errors.New("organization: invalid slug")
 )
 
+// HierarchyConfig is the configuration of the [Organization] hierarchy.
+type HierarchyConfig struct {
+	// MaxDepth is the maximum number of levels of the hierarchy, root organizations being the first level.
+	MaxDepth int `env:"ORGANIZATION_MAX_DEPTH" envDefault:"5"`
+}
+
+// NewHierarchyConfig creates a new [HierarchyConfig] instance from environment variables.
+func NewHierarchyConfig() (HierarchyConfig, error) {
+	return env.ParseAs[HierarchyConfig]()
+}
+
 // - Domain Service(s) -
 
 // getByID retrieves an [Organization] by its unique identifier.
-func getByID(ctx context.Context, r persistence.ReadRepository[string, Organization], id string) (Organization, error) {
+//
+// Deleted organizations are treated as not found unless [WithFetchDeleted] is given.
+func getByID(ctx context.Context, r persistence.ReadRepository[string, Organization], id string,
+	opts ...FetchOption) (Organization, error) {
+	options := fetchOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	org, err := r.FindByKey(ctx, id)
 	if err != nil {
 		return Organization{}, err
-	} else if org == nil {
+	} else if org == nil || (org.IsDeleted() && !options.includeDeleted) {
 		return Organization{}, ErrNotFound
 	}
 	return *org, nil
 }
 
-// existByName checks if an [Organization] exists by its name.
-func existByName(ctx context.Context, r Repository, name string) error {
-	ok, err := r.ExistsByName(ctx, name)
+// getActiveByID retrieves an active [Organization] by its unique identifier, it fails with [ErrNotActive] if
+// the organization is suspended or archived.
+func getActiveByID(ctx context.Context, r persistence.ReadRepository[string, Organization], id string) (
+	Organization, error) {
+	org, err := getByID(ctx, r, id)
+	if err != nil {
+		return Organization{}, err
+	} else if !org.IsActive() {
+		return Organization{}, ErrNotActive
+	}
+	return org, nil
+}
+
+// EnsureActive locks the [Organization] identified by id until the transaction carried by the context ends and
+// checks it is active, so it cannot be suspended, archived or deleted while resources it owns (e.g. members,
+// invitations or settings) are changed. It returns [ErrNotFound] if the organization does not exist (or is
+// deleted) and [ErrNotActive] if it is suspended or archived, as those are read-only.
+func EnsureActive(ctx context.Context, r Repository, id string) error {
+	org, err := r.LockByKey(ctx, id)
+	if err != nil {
+		return err
+	} else if org == nil || org.IsDeleted() {
+		return ErrNotFound
+	} else if !org.IsActive() {
+		return ErrNotActive
+	}
+	return nil
+}
+
+// existByName checks if an [Organization] other than the one identified by excludeID exists by its name.
+func existByName(ctx context.Context, r Repository, name, excludeID string) error {
+	ok, err := r.ExistsByName(ctx, name, excludeID)
 	if err != nil {
 		return err
 	} else if ok {
@@ -43,6 +123,96 @@ func existByName(ctx context.Context, r Repository, name string) error {
 	return nil
 }
 
+// existBySlug checks if an [Organization] other than the one identified by excludeID exists by its slug.
+func existBySlug(ctx context.Context, r Repository, slug, excludeID string) error {
+	ok, err := r.ExistsBySlug(ctx, slug, excludeID)
+	if err != nil {
+		return err
+	} else if ok {
+		return ErrSlugAlreadyExists
+	}
+	return nil
+}
+
+// _maxSlugSequence is the greatest sequence number appended to generated slugs (e.g. acme-9) before falling
+// back to a suffix derived from the organization ID.
+const _maxSlugSequence = 9
+
+// newUniqueSlug generates a slug from name not taken by any other [Organization]. Taken slugs are suffixed with
+// a sequence number (e.g. acme-2) and, as a last resort, with the end of id.
+func newUniqueSlug(ctx context.Context, r Repository, id, name string) (string, error) {
+	base := NewSlug(name)
+	idSlug := NewSlug(id)
+	if base == "" {
+		// names without letters nor digits (e.g. emojis)
+		base = idSlug
+	}
+
+	candidates := make([]string, 0, _maxSlugSequence+1)
+	candidates = append(candidates, base)
+	for i := 2; i <= _maxSlugSequence; i++ {
+		candidates = append(candidates, newSlugWithSuffix(base, strconv.Itoa(i)))
+	}
+	candidates = append(candidates, newSlugWithSuffix(base, idSlug[max(0, len(idSlug)-8):]))
+	for _, candidate := range candidates {
+		ok, err := r.ExistsBySlug(ctx, candidate, id)
+		if err != nil {
+			return "", err
+		} else if !ok {
+			return candidate, nil
+		}
+	}
+	return "", ErrSlugAlreadyExists
+}
+
+// checkPlacement checks whether the [Organization] identified by id can be placed under the [Organization]
+// identified by parentID. height is the number of levels below the placed organization (zero for leaves).
+//
+// Use an empty id for organizations not created yet.
+func checkPlacement(ctx context.Context, r Repository, config HierarchyConfig, id, parentID string,
+	height int) error {
+	parent, err := getByID(ctx, r, parentID)
+	if errors.Is(err, ErrNotFound) {
+		return ErrParentNotFound
+	} else if err != nil {
+		return err
+	} else if !parent.IsActive() {
+		return ErrParentNotActive
+	}
+
+	ancestors, err := r.FindAncestors(ctx, parentID)
+	if err != nil {
+		return err
+	} else if id == parentID || slices.ContainsFunc(ancestors, func(a Organization) bool {
+		return a.ID() == id
+	}) {
+		return ErrHierarchyCycle
+	}
+
+	// the parent is at level len(ancestors)+1, so the organization lands right below it
+	if len(ancestors)+2+height > config.MaxDepth {
+		return ErrMaxDepthExceeded
+	}
+	return nil
+}
+
+// getHeight returns the number of levels below the [Organization] identified by id (zero for leaves).
+func getHeight(ctx context.Context, r Repository, id string) (int, error) {
+	descendants, err := r.FindDescendants(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	// descendants are sorted breadth-first, parents come before their children
+	levels := map[string]int{id: 0}
+	height := 0
+	for _, d := range descendants {
+		levels[d.ID()] = levels[d.ParentID()] + 1
+		height = max(height, levels[d.ID()])
+	}
+	return height, nil
+}
+
 // - Application Service(s) -
 
 // -- Manager --
@@ -60,7 +230,29 @@ type Manager interface {
 	// ModifyByID modifies an [Organization] by its unique identifier.
 	ModifyByID(ctx context.Context, id string, opts ...UpdateOption) (Organization, error)
 	// DeleteByID deletes an [Organization] by its unique identifier.
-	DeleteByID(ctx context.Context, id string) error
+	//
+	// Deletion is logical (soft delete), use RestoreByID to revert it or PurgeByID to make it permanent.
+	DeleteByID(ctx context.Context, id string, opts ...DeleteOption) error
+	// RestoreByID restores a deleted [Organization] by its unique identifier.
+	RestoreByID(ctx context.Context, id string) (Organization, error)
+	// PurgeByID permanently erases an [Organization] by its unique identifier.
+	//
+	// It fails with [ErrHasChildren] if other organizations are placed under the [Organization].
+	PurgeByID(ctx context.Context, id string) error
+	// MoveUnder moves an [Organization] by its unique identifier under the [Organization] identified by parentID.
+	// Use an empty parentID to make it a root organization.
+	//
+	// It fails with [ErrHierarchyCycle] if parentID is the organization itself or one of its descendants, and with
+	// [ErrMaxDepthExceeded] if the hierarchy would get deeper than the maximum depth.
+	MoveUnder(ctx context.Context, id, parentID string) (Organization, error)
+	// Suspend suspends an active [Organization] by its unique identifier for the given reason. Suspended
+	// organizations are readable but not writable (see [ErrNotActive]) until reactivated.
+	Suspend(ctx context.Context, id string, reason StatusReason) (Organization, error)
+	// Reactivate reactivates a suspended [Organization] by its unique identifier for the given reason.
+	Reactivate(ctx context.Context, id string, reason StatusReason) (Organization, error)
+	// Archive permanently archives an active or suspended [Organization] by its unique identifier for the given
+	// reason. Archived organizations are read-only.
+	Archive(ctx context.Context, id string, reason StatusReason) (Organization, error)
 }
 
 // DEV-NOTE: Service arguments do not contain validation tags, this must be done in the transport layer (controller) or
@@ -72,6 +264,10 @@ type Manager interface {
 type RegisterArguments struct {
 	ID   string
 	Name string
+	// ParentID is empty for root organizations.
+	ParentID string
+	Profile  Profile
+	Labels   Labels
 }
 
 // --- Implementation(s) ---
@@ -79,14 +275,15 @@ type RegisterArguments struct {
 // LocalManager is a concrete implementation of the [Manager] interface that uses local resources (from the service
 // perspective).
 type LocalManager struct {
+	config     HierarchyConfig
 	repository Repository
 	// DEV-NOTE: Consider the dual-write atomicity problem. If you need to publish events, and you need
-	// strong consistency, consider using an event publisher that writes events into a table (outbox) in
-	// the transactional database used to write entities. This publisher must retrieve the current transaction
-	// and append the event-writing operations to the transaction.
+	// strong consistency, use an event publisher that writes events into a table (outbox) in
+	// the transactional database used to write entities (e.g. outbox.PostgresPublisher). This publisher retrieves
+	// the current transaction and appends the event-writing operations to the transaction.
 	// This way, the events are only published if the transaction is committed.
 	// If the transaction is rolled back, the events are not published.
-	// Then use a log-trailing mechanism to propagate the events into the event infrastructure (e.g. Apache Kafka).
+	// Finally, wrap the manager with a TransactionalManager so the transaction boundary spans every operation.
 	eventPublisher event.Publisher
 }
 
@@ -94,18 +291,41 @@ type LocalManager struct {
 var _ Manager = (*LocalManager)(nil)
 
 // NewLocalManager creates a new [LocalManager] instance.
-func NewLocalManager(r Repository, p event.Publisher) LocalManager {
-	return LocalManager{repository: r, eventPublisher: p}
+func NewLocalManager(config HierarchyConfig, r Repository, p event.Publisher) LocalManager {
+	return LocalManager{config: config, repository: r, eventPublisher: p}
 }
 
 // Register creates a new [Organization].
 func (l LocalManager) Register(ctx context.Context, args RegisterArguments) (Organization, error) {
-	err := existByName(ctx, l.repository, args.Name)
+	// profiles are validated the way they are stored
+	err := args.Profile.normalize().Validate()
 	if err != nil {
 		return Organization{}, err
 	}
+	if err = args.Labels.Validate(); err != nil {
+		return Organization{}, err
+	}
+	if err = existByName(ctx, l.repository, args.Name, ""); err != nil {
+		return Organization{}, err
+	}
 
-	org := New(ctx, args.ID, args.Name)
+	slug, err := newUniqueSlug(ctx, l.repository, args.ID, args.Name)
+	if err != nil {
+		return Organization{}, err
+	}
+
+	opts := []CreateOption{WithSlug(slug), WithProfile(args.Profile), WithLabels(args.Labels)}
+	if args.ParentID != "" {
+		if err = l.repository.LockHierarchy(ctx); err != nil {
+			return Organization{}, err
+		}
+		if err = checkPlacement(ctx, l.repository, l.config, "", args.ParentID, 0); err != nil {
+			return Organization{}, err
+		}
+		opts = append(opts, WithParent(args.ParentID))
+	}
+
+	org := New(ctx, args.ID, args.Name, opts...)
 	if err = l.repository.Save(ctx, org); err != nil {
 		return Organization{}, err
 	}
@@ -121,15 +341,38 @@ func (l LocalManager) ModifyByID(ctx context.Context, id string, opts ...UpdateO
 	if len(opts) == 0 {
 		return Organization{}, nil // no-op
 	}
-	org, err := getByID(ctx, l.repository, id)
+	org, err := getActiveByID(ctx, l.repository, id)
 	if err != nil {
 		return Organization{}, err
 	}
 
+	prevName, prevSlug, prevProfile, prevLabels := org.Name(), org.Slug(), org.Profile(), org.Labels()
 	org.Update(ctx, opts...)
 
-	if err = existByName(ctx, l.repository, org.Name()); err != nil {
-		return Organization{}, err
+	// profiles stored before a validation rule was introduced are left as they are until they change
+	if org.Profile() != prevProfile {
+		if err = org.Profile().Validate(); err != nil {
+			return Organization{}, err
+		}
+	}
+	if !maps.Equal(org.Labels(), prevLabels) {
+		if err = org.Labels().Validate(); err != nil {
+			return Organization{}, err
+		}
+	}
+
+	if org.Name() != prevName {
+		if err = existByName(ctx, l.repository, org.Name(), org.ID()); err != nil {
+			return Organization{}, err
+		}
+	}
+	if org.Slug() != prevSlug {
+		if !IsValidSlug(org.Slug()) {
+			return Organization{}, ErrInvalidSlug
+		}
+		if err = existBySlug(ctx, l.repository, org.Slug(), org.ID()); err != nil {
+			return Organization{}, err
+		}
 	}
 
 	if err = l.repository.Save(ctx, org); err != nil {
@@ -142,28 +385,300 @@ func (l LocalManager) ModifyByID(ctx context.Context, id string, opts ...UpdateO
 }
 
 // DeleteByID deletes an [Organization] by its unique identifier.
-func (l LocalManager) DeleteByID(ctx context.Context, id string) error {
+func (l LocalManager) DeleteByID(ctx context.Context, id string, opts ...DeleteOption) error {
 	// DEV-NOTE: If you delete by ID, no events can be propagated as they are appended to
 	// the entity.
-	org, err := getByID(ctx, l.repository, id)
+	org, err := getActiveByID(ctx, l.repository, id)
 	if errors.Is(err, syserr.ErrResourceNotFound) {
 		return nil // no-op
 	} else if err != nil {
 		return err
 	}
-	org.Delete(ctx)
+	org.Delete(ctx, opts...)
 	if err = l.repository.Delete(ctx, org); err != nil {
 		return err
 	}
 	return l.eventPublisher.Publish(ctx, org.PullEvents())
 }
 
+// RestoreByID restores a deleted [Organization] by its unique identifier.
+func (l LocalManager) RestoreByID(ctx context.Context, id string) (Organization, error) {
+	org, err := getByID(ctx, l.repository, id, WithFetchDeleted())
+	if err != nil {
+		return Organization{}, err
+	} else if !org.IsActive() {
+		return Organization{}, ErrNotActive
+	} else if !org.Restore(ctx) {
+		return org, nil // no-op
+	}
+
+	// name might have been taken while the organization was deleted
+	if err = existByName(ctx, l.repository, org.Name(), org.ID()); err != nil {
+		return Organization{}, err
+	}
+
+	if err = l.repository.Save(ctx, org); err != nil {
+		return Organization{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, org.PullEvents()); err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// PurgeByID permanently erases an [Organization] by its unique identifier.
+func (l LocalManager) PurgeByID(ctx context.Context, id string) error {
+	org, err := getByID(ctx, l.repository, id, WithFetchDeleted())
+	if errors.Is(err, syserr.ErrResourceNotFound) {
+		return nil // no-op
+	} else if err != nil {
+		return err
+	}
+
+	if !org.IsDeleted() {
+		// let downstream services know the organization is gone
+		org.Delete(ctx)
+	}
+	if err = l.repository.PurgeByKey(ctx, org.ID()); err != nil {
+		return err
+	}
+	return l.eventPublisher.Publish(ctx, org.PullEvents())
+}
+
+// MoveUnder moves an [Organization] by its unique identifier under the [Organization] identified by parentID.
+func (l LocalManager) MoveUnder(ctx context.Context, id, parentID string) (Organization, error) {
+	// DEV-NOTE: Hierarchy checks span several organizations, so hierarchy changes are serialized. Otherwise,
+	// concurrent moves (e.g. A under B and B under A) might create cycles.
+	if err := l.repository.LockHierarchy(ctx); err != nil {
+		return Organization{}, err
+	}
+	org, err := getActiveByID(ctx, l.repository, id)
+	if err != nil {
+		return Organization{}, err
+	} else if org.ParentID() == parentID {
+		return org, nil // no-op
+	}
+
+	// becoming a root organization never makes the hierarchy deeper
+	if parentID != "" {
+		height, errHeight := getHeight(ctx, l.repository, id)
+		if errHeight != nil {
+			return Organization{}, errHeight
+		}
+		if err = checkPlacement(ctx, l.repository, l.config, id, parentID, height); err != nil {
+			return Organization{}, err
+		}
+	}
+
+	org.MoveUnder(ctx, parentID)
+	if err = l.repository.Save(ctx, org); err != nil {
+		return Organization{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, org.PullEvents()); err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// DEV-NOTE: Statuses do not cascade through the hierarchy, suspending or archiving an organization leaves its
+// children as they are. Children can neither be registered nor moved under inactive organizations though.
+
+// Suspend suspends an active [Organization] by its unique identifier for the given reason.
+func (l LocalManager) Suspend(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	return l.transition(ctx, id, StatusSuspended, func(org *Organization) error {
+		return org.Suspend(ctx, reason)
+	})
+}
+
+// Reactivate reactivates a suspended [Organization] by its unique identifier for the given reason.
+func (l LocalManager) Reactivate(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	return l.transition(ctx, id, StatusActive, func(org *Organization) error {
+		return org.Reactivate(ctx, reason)
+	})
+}
+
+// Archive permanently archives an active or suspended [Organization] by its unique identifier for the given
+// reason.
+func (l LocalManager) Archive(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	return l.transition(ctx, id, StatusArchived, func(org *Organization) error {
+		return org.Archive(ctx, reason)
+	})
+}
+
+// transition moves the [Organization] identified by id to the status next using apply. Organizations already
+// in status next are left as they are.
+func (l LocalManager) transition(ctx context.Context, id string, next Status,
+	apply func(org *Organization) error) (Organization, error) {
+	org, err := getByID(ctx, l.repository, id)
+	if err != nil {
+		return Organization{}, err
+	} else if org.Status() == next {
+		return org, nil // no-op
+	}
+
+	if err = apply(&org); err != nil {
+		return Organization{}, err
+	}
+	if err = l.repository.Save(ctx, org); err != nil {
+		return Organization{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, org.PullEvents()); err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// TransactionalManager is a [Manager] decorator executing every operation of the underlying [Manager] within
+// a single transaction.
+//
+// Use it along a transactional outbox [event.Publisher] to make entity writes and event writes atomic.
+type TransactionalManager struct {
+	next     Manager
+	txRunner sqltx.Runner
+}
+
+// compile-time assertion
+var _ Manager = (*TransactionalManager)(nil)
+
+// NewTransactionalManager creates a new [TransactionalManager] instance.
+func NewTransactionalManager(next Manager, r sqltx.Runner) TransactionalManager {
+	return TransactionalManager{next: next, txRunner: r}
+}
+
+// Register creates a new [Organization].
+func (t TransactionalManager) Register(ctx context.Context, args RegisterArguments) (Organization, error) {
+	var org Organization
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		org, err = t.next.Register(scopedCtx, args)
+		return err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// ModifyByID modifies an [Organization] by its unique identifier.
+func (t TransactionalManager) ModifyByID(ctx context.Context, id string, opts ...UpdateOption) (Organization, error) {
+	var org Organization
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		org, err = t.next.ModifyByID(scopedCtx, id, opts...)
+		return err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// DeleteByID deletes an [Organization] by its unique identifier.
+func (t TransactionalManager) DeleteByID(ctx context.Context, id string, opts ...DeleteOption) error {
+	return t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) error {
+		return t.next.DeleteByID(scopedCtx, id, opts...)
+	})
+}
+
+// RestoreByID restores a deleted [Organization] by its unique identifier.
+func (t TransactionalManager) RestoreByID(ctx context.Context, id string) (Organization, error) {
+	var org Organization
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		org, err = t.next.RestoreByID(scopedCtx, id)
+		return err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// PurgeByID permanently erases an [Organization] by its unique identifier.
+func (t TransactionalManager) PurgeByID(ctx context.Context, id string) error {
+	return t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) error {
+		return t.next.PurgeByID(scopedCtx, id)
+	})
+}
+
+// MoveUnder moves an [Organization] by its unique identifier under the [Organization] identified by parentID.
+func (t TransactionalManager) MoveUnder(ctx context.Context, id, parentID string) (Organization, error) {
+	var org Organization
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		org, err = t.next.MoveUnder(scopedCtx, id, parentID)
+		return err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// Suspend suspends an active [Organization] by its unique identifier for the given reason.
+func (t TransactionalManager) Suspend(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	var org Organization
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		org, err = t.next.Suspend(scopedCtx, id, reason)
+		return err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// Reactivate reactivates a suspended [Organization] by its unique identifier for the given reason.
+func (t TransactionalManager) Reactivate(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	var org Organization
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		org, err = t.next.Reactivate(scopedCtx, id, reason)
+		return err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// Archive permanently archives an active or suspended [Organization] by its unique identifier for the given
+// reason.
+func (t TransactionalManager) Archive(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	var org Organization
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		org, err = t.next.Archive(scopedCtx, id, reason)
+		return err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
 // -- Fetcher --
 
 // A Fetcher is the service that retrieves [Organization] information.
 type Fetcher interface {
 	// GetByID retrieves an [Organization] by its unique identifier.
-	GetByID(ctx context.Context, id string) (Organization, error)
+	//
+	// Deleted organizations are treated as not found unless [WithFetchDeleted] is given.
+	GetByID(ctx context.Context, id string, opts ...FetchOption) (Organization, error)
+	// GetBySlug retrieves an [Organization] by its slug (case-insensitive). Previous slugs of the [Organization]
+	// are resolved as well, use [Organization.Slug] to tell them apart from the current one.
+	//
+	// Deleted organizations are treated as not found unless [WithFetchDeleted] is given.
+	GetBySlug(ctx context.Context, slug string, opts ...FetchOption) (Organization, error)
+}
+
+// --- Option(s) ---
+type fetchOptions struct {
+	includeDeleted bool
+}
+
+// FetchOption represents an option for fetching [Organization] entities.
+type FetchOption func(*fetchOptions)
+
+// WithFetchDeleted sets the option to retrieve deleted entities as well.
+func WithFetchDeleted() FetchOption {
+	return func(o *fetchOptions) {
+		o.includeDeleted = true
+	}
 }
 
 // --- Implementation(s) ---
@@ -183,8 +698,24 @@ func NewLocalFetcher(r ReadRepository) LocalFetcher {
 }
 
 // GetByID retrieves an [Organization] by its unique identifier.
-func (l LocalFetcher) GetByID(ctx context.Context, id string) (Organization, error) {
-	return getByID(ctx, l.repository, id)
+func (l LocalFetcher) GetByID(ctx context.Context, id string, opts ...FetchOption) (Organization, error) {
+	return getByID(ctx, l.repository, id, opts...)
+}
+
+// GetBySlug retrieves an [Organization] by its slug.
+func (l LocalFetcher) GetBySlug(ctx context.Context, slug string, opts ...FetchOption) (Organization, error) {
+	options := fetchOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	org, err := l.repository.FindBySlug(ctx, slug)
+	if err != nil {
+		return Organization{}, err
+	} else if org == nil || (org.IsDeleted() && !options.includeDeleted) {
+		return Organization{}, ErrNotFound
+	}
+	return *org, nil
 }
 
 // -- Lister --
@@ -193,15 +724,49 @@ func (l LocalFetcher) GetByID(ctx context.Context, id string) (Organization, err
 type Lister interface {
 	// List retrieves a list of [Organization] entities.
 	List(ctx context.Context, opts ...ListOption) (*paging.Page[Organization], error)
+	// ListAncestors retrieves the ancestors of an [Organization] by its unique identifier, from its parent up to
+	// the root.
+	ListAncestors(ctx context.Context, id string) ([]Organization, error)
 }
 
 // --- Option(s) ---
+
+// ListSortField is a field [Organization] lists can be sorted by.
+type ListSortField string
+
+const (
+	// ListSortByCreateTime sorts [Organization] lists by creation time. This is the default sort field.
+	ListSortByCreateTime ListSortField = "create_time"
+	// ListSortByName sorts [Organization] lists by name.
+	ListSortByName ListSortField = "name"
+)
+
+// IsValid checks whether f is a supported sort field.
+func (f ListSortField) IsValid() bool {
+	return f == ListSortByCreateTime || f == ListSortByName
+}
+
 type listOptions struct {
 	pageOpts           paging.Options
 	findNonDeletedOnly bool
+	// sortField is empty if no sort order was requested.
+	sortField       ListSortField
+	sortDescending  bool
+	createBy        string
+	createTimeStart time.Time
+	createTimeEnd   time.Time
+	namePrefix      string
+	parentID        string
+	status          Status
+	labelSelector   LabelSelector
+	memberUserID    string
 }
 
 // ListOption represents an option for listing [Organization] entities.
+//
+// Filters cannot change mid-pagination, listing with a page token created using different filters fails with
+// [ErrPageTokenMismatch]. Filters left unset are taken from the page token, except [WithListNonDeletedOnly]
+// which must be set on every page if it was set on the first one.
 type ListOption func(*listOptions)
 
 // WithListPageOptions sets the pagination options ([paging.Option]) for the list operation.
@@ -220,6 +785,76 @@ func WithListNonDeletedOnly() ListOption {
 	}
 }
 
+// WithListSort sets the sort order of the list operation. Entities are sorted by creation time in
+// ascending order by default.
+//
+// Sort order cannot change mid-pagination, listing with a page token created using a different sort order fails
+// with [ErrPageTokenMismatch].
+func WithListSort(field ListSortField, descending bool) ListOption {
+	return func(o *listOptions) {
+		o.sortField = field
+		o.sortDescending = descending
+	}
+}
+
+// WithListCreateBy sets the option to find only entities created by the given principal.
+func WithListCreateBy(principal string) ListOption {
+	return func(o *listOptions) {
+		o.createBy = principal
+	}
+}
+
+// WithListCreateTimeRange sets the option to find only entities created within [start, end). Zero values
+// leave the corresponding bound open.
+func WithListCreateTimeRange(start, end time.Time) ListOption {
+	return func(o *listOptions) {
+		o.createTimeStart = start
+		o.createTimeEnd = end
+	}
+}
+
+// WithListNamePrefix sets the option to find only entities whose name starts with prefix (case-insensitive).
+func WithListNamePrefix(prefix string) ListOption {
+	return func(o *listOptions) {
+		o.namePrefix = prefix
+	}
+}
+
+// WithListParent sets the option to find only the children of the organization identified by parentID.
+//
+// The parent cannot change mid-pagination, listing with a page token created using a different parent fails
+// with [ErrPageTokenMismatch].
+func WithListParent(parentID string) ListOption {
+	return func(o *listOptions) {
+		o.parentID = parentID
+	}
+}
+
+// WithListStatus sets the option to find only entities in the given status.
+func WithListStatus(status Status) ListOption {
+	return func(o *listOptions) {
+		o.status = status
+	}
+}
+
+// WithListLabelSelector sets the option to find only entities whose labels match selector (see
+// [ParseLabelSelector]).
+func WithListLabelSelector(selector LabelSelector) ListOption {
+	return func(o *listOptions) {
+		o.labelSelector = selector
+	}
+}
+
+// WithListMember sets the option to find only the organizations the user identified by userID is a member of.
+//
+// The user cannot change mid-pagination, listing with a page token created for another user fails with
+// [ErrPageTokenMismatch].
+func WithListMember(userID string) ListOption {
+	return func(o *listOptions) {
+		o.memberUserID = userID
+	}
+}
+
 // --- Implementation(s) ---
 
 // LocalLister is a concrete implementation of the [Lister] interface that uses local resources (from the service
@@ -240,3 +875,85 @@ func NewLocalLister(r ReadRepository) LocalLister {
 func (l LocalLister) List(ctx context.Context, opts ...ListOption) (*paging.Page[Organization], error) {
 	return l.repository.FindAll(ctx, opts...)
 }
+
+// ListAncestors retrieves the ancestors of an [Organization] by its unique identifier.
+func (l LocalLister) ListAncestors(ctx context.Context, id string) ([]Organization, error) {
+	if _, err := getByID(ctx, l.repository, id); err != nil {
+		return nil, err
+	}
+	return l.repository.FindAncestors(ctx, id)
+}
+
+// -- Searcher --
+
+// A Searcher is the service that searches [Organization] entities by relevance.
+type Searcher interface {
+	// Search retrieves the [Organization] entities matching query, most relevant first.
+	//
+	// Matching is typo-tolerant and the last term of query is treated as a prefix (autocomplete).
+	Search(ctx context.Context, query string, opts ...SearchOption) (*paging.Page[SearchResult], error)
+}
+
+// SearchResult is an [Organization] matching a search query.
+type SearchResult struct {
+	Organization Organization
+	// Score is the relevance of the match, higher is better.
+	Score float64
+	// Highlight is the name of the [Organization] with matching terms wrapped in <mark> tags.
+	Highlight string
+}
+
+// --- Option(s) ---
+type searchOptions struct {
+	pageOpts     paging.Options
+	memberUserID string
+}
+
+// SearchOption represents an option for searching [Organization] entities.
+type SearchOption func(*searchOptions)
+
+// WithSearchPageOptions sets the pagination options ([paging.Option]) for the search operation.
+func WithSearchPageOptions(opts ...paging.Option) SearchOption {
+	return func(o *searchOptions) {
+		for _, opt := range opts {
+			opt(&o.pageOpts)
+		}
+	}
+}
+
+// WithSearchMember sets the option to find only the organizations the user identified by userID is a member of.
+//
+// The user cannot change mid-pagination, searching with a page token created for another user fails with
+// [ErrPageTokenMismatch].
+func WithSearchMember(userID string) SearchOption {
+	return func(o *searchOptions) {
+		o.memberUserID = userID
+	}
+}
+
+// --- Implementation(s) ---
+
+// LocalSearcher is a concrete implementation of the [Searcher] interface that uses local resources (from the service
+// perspective).
+type LocalSearcher struct {
+	repository SearchRepository
+}
+
+// compile-time assertion
+var _ Searcher = (*LocalSearcher)(nil)
+
+// NewLocalSearcher creates a new [LocalSearcher] instance.
+func NewLocalSearcher(r SearchRepository) LocalSearcher {
+	return LocalSearcher{repository: r}
+}
+
+// Search retrieves the [Organization] entities matching query, most relevant first.
+func (l LocalSearcher) Search(ctx context.Context, query string, opts ...SearchOption) (*paging.Page[SearchResult],
+	error) {
+	if strings.TrimSpace(query) == "" {
+		return &paging.Page[SearchResult]{
+			Items: []SearchResult{},
+		}, nil
+	}
+	return l.repository.Search(ctx, query, opts...)
+}
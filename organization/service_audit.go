@@ -0,0 +1,217 @@
+package organization
+
+import (
+	"context"
+	"time"
+
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/security/identity"
+
+	"github.com/hadroncorp/service-template/internal/requestid"
+)
+
+// DEV-NOTE: audit.Auditable only keeps the latest change of an organization, the audit trail keeps every change
+// along the state of the organization before and after it (e.g. to answer who renamed an organization and when).
+
+// -- Manager --
+
+// AuditedManager is a [Manager] decorator appending a [HistoryRecord] every time an operation of the underlying
+// [Manager] changes an organization. Operations changing nothing (no-op) are not recorded.
+//
+// Place it within a [TransactionalManager], so records are appended in the same transaction as the changes
+// they audit. The state before a change is read with the organization locked until the transaction ends, so
+// the underlying [Manager] changes the very state recorded (concurrent changes wait or conflict instead).
+type AuditedManager struct {
+	next       Manager
+	repository Repository
+	history    HistoryRepository
+}
+
+// compile-time assertion
+var _ Manager = (*AuditedManager)(nil)
+
+// NewAuditedManager creates a new [AuditedManager] instance.
+func NewAuditedManager(next Manager, r Repository, h HistoryRepository) AuditedManager {
+	return AuditedManager{next: next, repository: r, history: h}
+}
+
+// Register creates a new [Organization].
+func (a AuditedManager) Register(ctx context.Context, args RegisterArguments) (Organization, error) {
+	org, err := a.next.Register(ctx, args)
+	if err != nil {
+		return Organization{}, err
+	}
+	return org, a.append(ctx, OperationRegister, org.ID(), nil, &org)
+}
+
+// ModifyByID modifies an [Organization] by its unique identifier.
+func (a AuditedManager) ModifyByID(ctx context.Context, id string, opts ...UpdateOption) (Organization, error) {
+	return a.audit(ctx, OperationModify, id, func() (Organization, error) {
+		return a.next.ModifyByID(ctx, id, opts...)
+	})
+}
+
+// DeleteByID deletes an [Organization] by its unique identifier.
+func (a AuditedManager) DeleteByID(ctx context.Context, id string, opts ...DeleteOption) error {
+	before, err := a.repository.LockByKey(ctx, id)
+	if err != nil {
+		return err
+	} else if err = a.next.DeleteByID(ctx, id, opts...); err != nil {
+		return err
+	}
+	after, err := a.repository.FindByKey(ctx, id)
+	if err != nil {
+		return err
+	}
+	return a.append(ctx, OperationDelete, id, before, after)
+}
+
+// RestoreByID restores a deleted [Organization] by its unique identifier.
+func (a AuditedManager) RestoreByID(ctx context.Context, id string) (Organization, error) {
+	return a.audit(ctx, OperationRestore, id, func() (Organization, error) {
+		return a.next.RestoreByID(ctx, id)
+	})
+}
+
+// PurgeByID permanently erases an [Organization] by its unique identifier.
+func (a AuditedManager) PurgeByID(ctx context.Context, id string) error {
+	before, err := a.repository.LockByKey(ctx, id)
+	if err != nil {
+		return err
+	} else if err = a.next.PurgeByID(ctx, id); err != nil {
+		return err
+	}
+	return a.append(ctx, OperationPurge, id, before, nil)
+}
+
+// MoveUnder moves an [Organization] by its unique identifier under the [Organization] identified by parentID.
+func (a AuditedManager) MoveUnder(ctx context.Context, id, parentID string) (Organization, error) {
+	return a.audit(ctx, OperationMove, id, func() (Organization, error) {
+		return a.next.MoveUnder(ctx, id, parentID)
+	})
+}
+
+// Suspend suspends an active [Organization] by its unique identifier for the given reason.
+func (a AuditedManager) Suspend(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	return a.audit(ctx, OperationSuspend, id, func() (Organization, error) {
+		return a.next.Suspend(ctx, id, reason)
+	})
+}
+
+// Reactivate reactivates a suspended [Organization] by its unique identifier for the given reason.
+func (a AuditedManager) Reactivate(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	return a.audit(ctx, OperationReactivate, id, func() (Organization, error) {
+		return a.next.Reactivate(ctx, id, reason)
+	})
+}
+
+// Archive permanently archives an active or suspended [Organization] by its unique identifier for the given
+// reason.
+func (a AuditedManager) Archive(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	return a.audit(ctx, OperationArchive, id, func() (Organization, error) {
+		return a.next.Archive(ctx, id, reason)
+	})
+}
+
+// audit performs operation on the [Organization] identified by id and appends its record.
+func (a AuditedManager) audit(ctx context.Context, operation Operation, id string,
+	perform func() (Organization, error)) (Organization, error) {
+	before, err := a.repository.LockByKey(ctx, id)
+	if err != nil {
+		return Organization{}, err
+	}
+	org, err := perform()
+	if err != nil {
+		return Organization{}, err
+	} else if err = a.append(ctx, operation, id, before, &org); err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// append appends the record of operation on the [Organization] identified by id, unless the organization did not
+// change (i.e. its version is the same before and after the operation).
+func (a AuditedManager) append(ctx context.Context, operation Operation, id string,
+	before, after *Organization) error {
+	if before == nil && after == nil {
+		return nil // no-op, organization never existed
+	} else if before != nil && after != nil && before.Version() == after.Version() {
+		return nil // no-op
+	}
+
+	record := HistoryRecord{
+		OrganizationID: id,
+		Operation:      operation,
+		RequestID:      requestid.FromContext(ctx),
+		Time:           time.Now().UTC(),
+	}
+	if principal, ok := identity.GetPrincipal(ctx); ok {
+		record.Actor = principal.ID()
+	}
+	if before != nil {
+		record.Before = newSnapshot(*before)
+	}
+	if after != nil {
+		record.After = newSnapshot(*after)
+	}
+	return a.history.Append(ctx, record)
+}
+
+// -- History Lister --
+
+// A HistoryLister is the service that lists the audit trail ([HistoryRecord]) of organizations.
+type HistoryLister interface {
+	// List retrieves the records of the organization identified by organizationID, newest first. Records of
+	// purged organizations are kept.
+	List(ctx context.Context, organizationID string, opts ...HistoryOption) (*paging.Page[HistoryRecord], error)
+}
+
+// --- Option(s) ---
+type historyOptions struct {
+	pageOpts  paging.Options
+	startTime time.Time
+	endTime   time.Time
+}
+
+// HistoryOption represents an option for listing [HistoryRecord] entries.
+type HistoryOption func(*historyOptions)
+
+// WithHistoryPageOptions sets the pagination options ([paging.Option]) for the list operation.
+func WithHistoryPageOptions(opts ...paging.Option) HistoryOption {
+	return func(o *historyOptions) {
+		for _, opt := range opts {
+			opt(&o.pageOpts)
+		}
+	}
+}
+
+// WithHistoryTimeRange sets the option to find only records appended from start (inclusive) until end
+// (exclusive). Zero times leave the range open on their side.
+func WithHistoryTimeRange(start, end time.Time) HistoryOption {
+	return func(o *historyOptions) {
+		o.startTime = start
+		o.endTime = end
+	}
+}
+
+// --- Implementation(s) ---
+
+// LocalHistoryLister is a concrete implementation of the [HistoryLister] interface that uses local resources
+// (from the service perspective).
+type LocalHistoryLister struct {
+	repository HistoryRepository
+}
+
+// compile-time assertion
+var _ HistoryLister = (*LocalHistoryLister)(nil)
+
+// NewLocalHistoryLister creates a new [LocalHistoryLister] instance.
+func NewLocalHistoryLister(r HistoryRepository) LocalHistoryLister {
+	return LocalHistoryLister{repository: r}
+}
+
+// List retrieves the records of the organization identified by organizationID, newest first.
+func (l LocalHistoryLister) List(ctx context.Context, organizationID string, opts ...HistoryOption) (
+	*paging.Page[HistoryRecord], error) {
+	return l.repository.FindAll(ctx, organizationID, opts...)
+}
@@ -0,0 +1,123 @@
+package organization_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/internal/requestid"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+type auditedManagerSuite struct {
+	suite.Suite
+
+	next       *organizationmock.MockManager
+	repository *organizationmock.MockRepository
+	history    *organizationmock.MockHistoryRepository
+	manager    organization.AuditedManager
+	baseCtx    context.Context
+}
+
+func TestAuditedManagerSuite(t *testing.T) {
+	suite.Run(t, new(auditedManagerSuite))
+}
+
+func (s *auditedManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.next = organizationmock.NewMockManager(ctrl)
+	s.repository = organizationmock.NewMockRepository(ctrl)
+	s.history = organizationmock.NewMockHistoryRepository(ctrl)
+	s.manager = organization.NewAuditedManager(s.next, s.repository, s.history)
+	s.baseCtx = requestid.WithRequestID(
+		identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user")), "req-1")
+}
+
+func (s *auditedManagerSuite) TestAuditedManager_ModifyByID() {
+	// arrange
+	before := organization.New(s.baseCtx, "1", "foo")
+	after := before
+	after.Update(s.baseCtx, organization.WithUpdatedName(lo.ToPtr("bar")))
+	s.repository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&before, error(nil))
+	s.next.EXPECT().
+		ModifyByID(s.baseCtx, "1", gomock.Any()).
+		Times(1).
+		Return(after, error(nil))
+	var record organization.HistoryRecord
+	s.history.EXPECT().
+		Append(s.baseCtx, gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, r organization.HistoryRecord) error {
+			record = r
+			return nil
+		})
+
+	// act
+	out, err := s.manager.ModifyByID(s.baseCtx, "1", organization.WithUpdatedName(lo.ToPtr("bar")))
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal("bar", out.Name())
+	s.Assert().Equal(organization.OperationModify, record.Operation)
+	s.Assert().Equal("some-user", record.Actor)
+	s.Assert().Equal("req-1", record.RequestID)
+	if s.Assert().NotNil(record.Before) && s.Assert().NotNil(record.After) {
+		s.Assert().Equal("foo", record.Before.Name)
+		s.Assert().Equal("bar", record.After.Name)
+	}
+}
+
+func (s *auditedManagerSuite) TestAuditedManager_ModifyByID_Noop() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.repository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.next.EXPECT().
+		ModifyByID(s.baseCtx, "1").
+		Times(1).
+		Return(org, error(nil))
+	s.history.EXPECT().
+		Append(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	// act
+	_, err := s.manager.ModifyByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *auditedManagerSuite) TestAuditedManager_PurgeByID() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.repository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.next.EXPECT().
+		PurgeByID(s.baseCtx, "1").
+		Times(1).
+		Return(error(nil))
+	s.history.EXPECT().
+		Append(s.baseCtx, gomock.Cond(func(r organization.HistoryRecord) bool {
+			return r.Operation == organization.OperationPurge && r.Before != nil && r.After == nil
+		})).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	err := s.manager.PurgeByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+}
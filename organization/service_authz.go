@@ -0,0 +1,316 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/security/identity"
+
+	"github.com/hadroncorp/service-template/authz"
+)
+
+const (
+	// PermissionCreate is the permission to register organizations.
+	PermissionCreate authz.Permission = "org:create"
+	// PermissionGet is the permission to retrieve an organization.
+	PermissionGet authz.Permission = "org:get"
+	// PermissionList is the permission to list and search the organizations callers are members of.
+	PermissionList authz.Permission = "org:list"
+	// PermissionListAll is the permission to list and search every organization, members or not.
+	PermissionListAll authz.Permission = "org:list_all"
+	// PermissionUpdate is the permission to modify an organization.
+	PermissionUpdate authz.Permission = "org:update"
+	// PermissionDelete is the permission to delete an organization.
+	PermissionDelete authz.Permission = "org:delete"
+	// PermissionUndelete is the permission to restore a deleted organization.
+	PermissionUndelete authz.Permission = "org:undelete"
+	// PermissionPurge is the permission to permanently erase an organization.
+	PermissionPurge authz.Permission = "org:purge"
+	// PermissionSuspend is the permission to suspend an organization.
+	PermissionSuspend authz.Permission = "org:suspend"
+	// PermissionReactivate is the permission to reactivate a suspended organization.
+	PermissionReactivate authz.Permission = "org:reactivate"
+	// PermissionArchive is the permission to archive an organization.
+	PermissionArchive authz.Permission = "org:archive"
+	// PermissionHistory is the permission to list the audit trail of an organization.
+	PermissionHistory authz.Permission = "org:history"
+)
+
+// DefaultPolicy returns the roles granting the organization permissions unless configured otherwise (see
+// [authz.Config]).
+func DefaultPolicy() authz.Policy {
+	return authz.Policy{
+		PermissionCreate:     {authz.RoleAny},
+		PermissionGet:        {authz.RoleOwner, authz.RoleAdmin, authz.RoleMember, authz.RoleIAMAdmin},
+		PermissionList:       {authz.RoleAny},
+		PermissionListAll:    {authz.RoleIAMAdmin},
+		PermissionUpdate:     {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+		PermissionDelete:     {authz.RoleOwner, authz.RoleIAMAdmin},
+		PermissionUndelete:   {authz.RoleOwner, authz.RoleIAMAdmin},
+		PermissionPurge:      {authz.RoleIAMAdmin},
+		PermissionSuspend:    {authz.RoleIAMAdmin},
+		PermissionReactivate: {authz.RoleIAMAdmin},
+		PermissionArchive:    {authz.RoleOwner, authz.RoleIAMAdmin},
+		PermissionHistory:    {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+	}
+}
+
+// AuthorizedManager is a [Manager] decorator allowing callers to perform an operation of the underlying
+// [Manager] only if the [authz.Authorizer] grants them its permission.
+type AuthorizedManager struct {
+	next       Manager
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Manager = (*AuthorizedManager)(nil)
+
+// NewAuthorizedManager creates a new [AuthorizedManager] instance.
+func NewAuthorizedManager(next Manager, a authz.Authorizer) AuthorizedManager {
+	return AuthorizedManager{next: next, authorizer: a}
+}
+
+// Register creates a new [Organization].
+//
+// Registering an organization under a parent also requires [PermissionUpdate] on the parent.
+func (a AuthorizedManager) Register(ctx context.Context, args RegisterArguments) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionCreate, ""); err != nil {
+		return Organization{}, err
+	}
+	if args.ParentID != "" {
+		if err := a.authorizer.Authorize(ctx, PermissionUpdate, args.ParentID); err != nil {
+			return Organization{}, err
+		}
+	}
+	return a.next.Register(ctx, args)
+}
+
+// ModifyByID modifies an [Organization] by its unique identifier.
+func (a AuthorizedManager) ModifyByID(ctx context.Context, id string, opts ...UpdateOption) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionUpdate, id); err != nil {
+		return Organization{}, err
+	}
+	return a.next.ModifyByID(ctx, id, opts...)
+}
+
+// DeleteByID deletes an [Organization] by its unique identifier.
+func (a AuthorizedManager) DeleteByID(ctx context.Context, id string, opts ...DeleteOption) error {
+	if err := a.authorizer.Authorize(ctx, PermissionDelete, id); err != nil {
+		return err
+	}
+	return a.next.DeleteByID(ctx, id, opts...)
+}
+
+// RestoreByID restores a deleted [Organization] by its unique identifier.
+func (a AuthorizedManager) RestoreByID(ctx context.Context, id string) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionUndelete, id); err != nil {
+		return Organization{}, err
+	}
+	return a.next.RestoreByID(ctx, id)
+}
+
+// PurgeByID permanently erases an [Organization] by its unique identifier.
+func (a AuthorizedManager) PurgeByID(ctx context.Context, id string) error {
+	if err := a.authorizer.Authorize(ctx, PermissionPurge, id); err != nil {
+		return err
+	}
+	return a.next.PurgeByID(ctx, id)
+}
+
+// MoveUnder moves an [Organization] by its unique identifier under the [Organization] identified by parentID.
+//
+// Both organizations require [PermissionUpdate], so callers cannot place organizations under parents they
+// do not manage.
+func (a AuthorizedManager) MoveUnder(ctx context.Context, id, parentID string) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionUpdate, id); err != nil {
+		return Organization{}, err
+	}
+	if parentID != "" {
+		if err := a.authorizer.Authorize(ctx, PermissionUpdate, parentID); err != nil {
+			return Organization{}, err
+		}
+	}
+	return a.next.MoveUnder(ctx, id, parentID)
+}
+
+// Suspend suspends an active [Organization] by its unique identifier for the given reason.
+func (a AuthorizedManager) Suspend(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionSuspend, id); err != nil {
+		return Organization{}, err
+	}
+	return a.next.Suspend(ctx, id, reason)
+}
+
+// Reactivate reactivates a suspended [Organization] by its unique identifier for the given reason.
+func (a AuthorizedManager) Reactivate(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionReactivate, id); err != nil {
+		return Organization{}, err
+	}
+	return a.next.Reactivate(ctx, id, reason)
+}
+
+// Archive permanently archives an active or suspended [Organization] by its unique identifier for the given
+// reason.
+func (a AuthorizedManager) Archive(ctx context.Context, id string, reason StatusReason) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionArchive, id); err != nil {
+		return Organization{}, err
+	}
+	return a.next.Archive(ctx, id, reason)
+}
+
+// AuthorizedFetcher is a [Fetcher] decorator allowing callers to retrieve an organization only if the
+// [authz.Authorizer] grants them [PermissionGet].
+type AuthorizedFetcher struct {
+	next       Fetcher
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Fetcher = (*AuthorizedFetcher)(nil)
+
+// NewAuthorizedFetcher creates a new [AuthorizedFetcher] instance.
+func NewAuthorizedFetcher(next Fetcher, a authz.Authorizer) AuthorizedFetcher {
+	return AuthorizedFetcher{next: next, authorizer: a}
+}
+
+// GetByID retrieves an [Organization] by its unique identifier.
+func (a AuthorizedFetcher) GetByID(ctx context.Context, id string, opts ...FetchOption) (Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionGet, id); err != nil {
+		return Organization{}, err
+	}
+	return a.next.GetByID(ctx, id, opts...)
+}
+
+// GetBySlug retrieves an [Organization] by its slug.
+func (a AuthorizedFetcher) GetBySlug(ctx context.Context, slug string, opts ...FetchOption) (Organization, error) {
+	// DEV-NOTE: Permissions are granted on organization IDs, so the slug is resolved before authorizing.
+	org, err := a.next.GetBySlug(ctx, slug, opts...)
+	if err != nil {
+		return Organization{}, err
+	}
+	if err = a.authorizer.Authorize(ctx, PermissionGet, org.ID()); err != nil {
+		return Organization{}, err
+	}
+	return org, nil
+}
+
+// DEV-NOTE: Lists span several organizations, so their permission is checked against platform-wide roles only.
+// Callers see the organizations they are members of, unless they are granted PermissionListAll. Children lists
+// are the exception, they are scoped to a single parent which is authorized instead.
+
+// memberOf returns the unique identifier of the caller if lists must be narrowed down to its memberships (i.e.
+// the caller is not granted [PermissionListAll]), an empty string otherwise.
+func memberOf(ctx context.Context, a authz.Authorizer) (string, error) {
+	if isGranted, err := a.IsGranted(ctx, PermissionListAll, ""); err != nil || isGranted {
+		return "", err
+	}
+	principal, _ := identity.GetPrincipal(ctx)
+	return principal.ID(), nil
+}
+
+// AuthorizedLister is a [Lister] decorator allowing callers to list organizations only if the
+// [authz.Authorizer] grants them [PermissionList]. Callers not granted [PermissionListAll] only list the
+// organizations they are members of.
+type AuthorizedLister struct {
+	next       Lister
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Lister = (*AuthorizedLister)(nil)
+
+// NewAuthorizedLister creates a new [AuthorizedLister] instance.
+func NewAuthorizedLister(next Lister, a authz.Authorizer) AuthorizedLister {
+	return AuthorizedLister{next: next, authorizer: a}
+}
+
+// List retrieves a list of [Organization] entities.
+//
+// Listing the children of an organization (see [WithListParent]) requires [PermissionGet] on the parent instead,
+// every child is listed then.
+func (a AuthorizedLister) List(ctx context.Context, opts ...ListOption) (*paging.Page[Organization], error) {
+	options := listOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.parentID != "" {
+		if err := a.authorizer.Authorize(ctx, PermissionGet, options.parentID); err != nil {
+			return nil, err
+		}
+		return a.next.List(ctx, opts...)
+	}
+
+	if err := a.authorizer.Authorize(ctx, PermissionList, ""); err != nil {
+		return nil, err
+	}
+	memberUserID, err := memberOf(ctx, a.authorizer)
+	if err != nil {
+		return nil, err
+	} else if memberUserID != "" {
+		opts = append(opts, WithListMember(memberUserID))
+	}
+	return a.next.List(ctx, opts...)
+}
+
+// ListAncestors retrieves the ancestors of an [Organization] by its unique identifier.
+func (a AuthorizedLister) ListAncestors(ctx context.Context, id string) ([]Organization, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionGet, id); err != nil {
+		return nil, err
+	}
+	return a.next.ListAncestors(ctx, id)
+}
+
+// AuthorizedSearcher is a [Searcher] decorator allowing callers to search organizations only if the
+// [authz.Authorizer] grants them [PermissionList]. Callers not granted [PermissionListAll] only search the
+// organizations they are members of.
+type AuthorizedSearcher struct {
+	next       Searcher
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Searcher = (*AuthorizedSearcher)(nil)
+
+// NewAuthorizedSearcher creates a new [AuthorizedSearcher] instance.
+func NewAuthorizedSearcher(next Searcher, a authz.Authorizer) AuthorizedSearcher {
+	return AuthorizedSearcher{next: next, authorizer: a}
+}
+
+// Search retrieves the [Organization] entities matching query, most relevant first.
+func (a AuthorizedSearcher) Search(ctx context.Context, query string, opts ...SearchOption) (
+	*paging.Page[SearchResult], error) {
+	if err := a.authorizer.Authorize(ctx, PermissionList, ""); err != nil {
+		return nil, err
+	}
+	memberUserID, err := memberOf(ctx, a.authorizer)
+	if err != nil {
+		return nil, err
+	} else if memberUserID != "" {
+		opts = append(opts, WithSearchMember(memberUserID))
+	}
+	return a.next.Search(ctx, query, opts...)
+}
+
+// AuthorizedHistoryLister is a [HistoryLister] decorator allowing callers to list the audit trail of an
+// organization only if the [authz.Authorizer] grants them [PermissionHistory] on the organization.
+type AuthorizedHistoryLister struct {
+	next       HistoryLister
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ HistoryLister = (*AuthorizedHistoryLister)(nil)
+
+// NewAuthorizedHistoryLister creates a new [AuthorizedHistoryLister] instance.
+func NewAuthorizedHistoryLister(next HistoryLister, a authz.Authorizer) AuthorizedHistoryLister {
+	return AuthorizedHistoryLister{next: next, authorizer: a}
+}
+
+// List retrieves the records of the organization identified by organizationID, newest first.
+func (a AuthorizedHistoryLister) List(ctx context.Context, organizationID string, opts ...HistoryOption) (
+	*paging.Page[HistoryRecord], error) {
+	if err := a.authorizer.Authorize(ctx, PermissionHistory, organizationID); err != nil {
+		return nil, err
+	}
+	return a.next.List(ctx, organizationID, opts...)
+}
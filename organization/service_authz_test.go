@@ -0,0 +1,187 @@
+package organization_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/hadroncorp/geck/persistence/paging"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/hadroncorp/geck/syserr"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/authn"
+	"github.com/hadroncorp/service-template/authz"
+	"github.com/hadroncorp/service-template/authzmock"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+type authorizedManagerSuite struct {
+	suite.Suite
+
+	next     *organizationmock.MockManager
+	resolver *authzmock.MockRoleResolver
+	manager  organization.AuthorizedManager
+	baseCtx  context.Context
+}
+
+func TestAuthorizedManagerSuite(t *testing.T) {
+	suite.Run(t, new(authorizedManagerSuite))
+}
+
+func (s *authorizedManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.next = organizationmock.NewMockManager(ctrl)
+	s.resolver = authzmock.NewMockRoleResolver(ctrl)
+	authorizer := authz.NewAuthorizer(authz.Config{
+		Enabled: true,
+		Policies: map[string]string{
+			string(organization.PermissionCreate): "*",
+			string(organization.PermissionDelete): "owner",
+		},
+	}, s.resolver, slog.Default())
+	s.manager = organization.NewAuthorizedManager(s.next, authorizer)
+	s.baseCtx = identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_Register() {
+	// arrange
+	s.next.EXPECT().
+		Register(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(organization.Organization{}, error(nil))
+
+	// act
+	_, err := s.manager.Register(s.baseCtx, organization.RegisterArguments{ID: "1", Name: "foo"})
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_DeleteByID_Owner() {
+	// arrange
+	s.resolver.EXPECT().
+		ResolveRoles(s.baseCtx, "some-user", "1").
+		Times(1).
+		Return([]authz.Role{authz.RoleOwner}, error(nil))
+	s.next.EXPECT().
+		DeleteByID(s.baseCtx, "1").
+		Times(1).
+		Return(error(nil))
+
+	// act
+	err := s.manager.DeleteByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_DeleteByID_Forbidden() {
+	// arrange
+	s.resolver.EXPECT().
+		ResolveRoles(s.baseCtx, "some-user", "1").
+		Times(1).
+		Return([]authz.Role{authz.RoleMember}, error(nil))
+
+	// act
+	err := s.manager.DeleteByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().ErrorIs(err, authz.ErrPermissionDenied)
+	s.Assert().ErrorIs(err, syserr.ErrForbidden)
+}
+
+func (s *authorizedManagerSuite) TestAuthorizedManager_PurgeByID_Undeclared() {
+	// act
+	err := s.manager.PurgeByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().ErrorIs(err, authz.ErrPermissionDenied)
+}
+
+type authorizedListerSuite struct {
+	suite.Suite
+
+	next     *organizationmock.MockLister
+	resolver *authzmock.MockRoleResolver
+	lister   organization.AuthorizedLister
+}
+
+func TestAuthorizedListerSuite(t *testing.T) {
+	suite.Run(t, new(authorizedListerSuite))
+}
+
+func (s *authorizedListerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.next = organizationmock.NewMockLister(ctrl)
+	s.resolver = authzmock.NewMockRoleResolver(ctrl)
+	authorizer := authz.NewAuthorizer(authz.Config{Enabled: true}, s.resolver, slog.Default(),
+		organization.DefaultPolicy())
+	s.lister = organization.NewAuthorizedLister(s.next, authorizer)
+}
+
+func (s *authorizedListerSuite) TestAuthorizedLister_List_Member() {
+	// arrange
+	ctx := identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+	s.next.EXPECT().
+		List(ctx, gomock.Len(2)). // page options and memberships of the caller
+		Times(1).
+		Return(&paging.Page[organization.Organization]{}, error(nil))
+
+	// act
+	_, err := s.lister.List(ctx, organization.WithListPageOptions())
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *authorizedListerSuite) TestAuthorizedLister_List_Parent() {
+	// arrange
+	ctx := identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+	s.resolver.EXPECT().
+		ResolveRoles(ctx, "some-user", "1").
+		Times(1).
+		Return([]authz.Role{authz.RoleMember}, error(nil))
+	s.next.EXPECT().
+		List(ctx, gomock.Len(2)). // parent and page options, children are not narrowed to memberships
+		Times(1).
+		Return(&paging.Page[organization.Organization]{}, error(nil))
+
+	// act
+	_, err := s.lister.List(ctx, organization.WithListParent("1"), organization.WithListPageOptions())
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *authorizedListerSuite) TestAuthorizedLister_List_Parent_Forbidden() {
+	// arrange
+	ctx := identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+	s.resolver.EXPECT().
+		ResolveRoles(ctx, "some-user", "1").
+		Times(1).
+		Return([]authz.Role(nil), error(nil))
+
+	// act
+	_, err := s.lister.List(ctx, organization.WithListParent("1"))
+
+	// assert
+	s.Assert().ErrorIs(err, authz.ErrPermissionDenied)
+}
+
+func (s *authorizedListerSuite) TestAuthorizedLister_List_IAM_Admin() {
+	// arrange
+	ctx := identity.WithPrincipal(context.Background(), authn.NewPrincipal("some-user", string(authz.RoleIAMAdmin)))
+	s.next.EXPECT().
+		List(ctx, gomock.Len(1)).
+		Times(1).
+		Return(&paging.Page[organization.Organization]{}, error(nil))
+
+	// act
+	_, err := s.lister.List(ctx, organization.WithListPageOptions())
+
+	// assert
+	s.Assert().NoError(err)
+}
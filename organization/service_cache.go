@@ -0,0 +1,280 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
+)
+
+const _meterName = "github.com/hadroncorp/service-template/organization"
+
+// CacheConfig is the configuration of the [CachedFetcher].
+type CacheConfig struct {
+	// Size is the maximum number of organizations kept in memory. Least recently used organizations are
+	// evicted first.
+	Size int `env:"ORGANIZATION_CACHE_SIZE" envDefault:"10000"`
+	// TTL is the time an organization is kept in memory.
+	TTL time.Duration `env:"ORGANIZATION_CACHE_TTL" envDefault:"1m"`
+	// NegativeTTL is the time a not found lookup is kept in memory.
+	NegativeTTL time.Duration `env:"ORGANIZATION_CACHE_NEGATIVE_TTL" envDefault:"5s"`
+	// SeedBrokers are the Apache Kafka brokers [CacheReaderKafka] reads the events invalidating the cache from.
+	SeedBrokers []string `env:"KAFKA_BROKERS" envSeparator:","`
+}
+
+// NewCacheConfig creates a new [CacheConfig] instance from environment variables.
+func NewCacheConfig() (CacheConfig, error) {
+	return env.ParseAs[CacheConfig]()
+}
+
+// CachedFetcher is a [Fetcher] decorator keeping the organizations retrieved by the underlying [Fetcher] in
+// a bounded in-memory cache.
+//
+// Concurrent misses of the same organization are collapsed into a single call to the underlying [Fetcher].
+// Not found lookups are cached as well, for [CacheConfig.NegativeTTL]. Use [CachedFetcher.Invalidate]
+// to evict an organization once it changes (see [CacheInvalidatingManager] and [CacheReaderKafka]).
+type CachedFetcher struct {
+	next    Fetcher
+	entries *expirable.LRU[string, Organization]
+	missing *expirable.LRU[string, struct{}]
+	group   *singleflight.Group
+	metrics cacheMetrics
+	// generation is increased on every invalidation so lookups started before it are not cached, as they
+	// may have read the organization before its change.
+	generation *atomic.Uint64
+}
+
+type cacheMetrics struct {
+	hits          metric.Int64Counter
+	misses        metric.Int64Counter
+	invalidations metric.Int64Counter
+}
+
+// compile-time assertion
+var _ Fetcher = (*CachedFetcher)(nil)
+
+// NewCachedFetcher creates a new [CachedFetcher] instance.
+func NewCachedFetcher(config CacheConfig, next Fetcher) (CachedFetcher, error) {
+	metrics, err := newCacheMetrics(otel.Meter(_meterName))
+	if err != nil {
+		return CachedFetcher{}, err
+	}
+	return CachedFetcher{
+		next:       next,
+		entries:    expirable.NewLRU[string, Organization](config.Size, nil, config.TTL),
+		missing:    expirable.NewLRU[string, struct{}](config.Size, nil, config.NegativeTTL),
+		group:      &singleflight.Group{},
+		metrics:    metrics,
+		generation: &atomic.Uint64{},
+	}, nil
+}
+
+func newCacheMetrics(meter metric.Meter) (cacheMetrics, error) {
+	hits, err := meter.Int64Counter("organization.cache.hits",
+		metric.WithDescription("Number of organization lookups served from the cache."),
+		metric.WithUnit("{lookup}"))
+	if err != nil {
+		return cacheMetrics{}, err
+	}
+	misses, err := meter.Int64Counter("organization.cache.misses",
+		metric.WithDescription("Number of organization lookups forwarded to the underlying fetcher."),
+		metric.WithUnit("{lookup}"))
+	if err != nil {
+		return cacheMetrics{}, err
+	}
+	invalidations, err := meter.Int64Counter("organization.cache.invalidations",
+		metric.WithDescription("Number of organizations evicted from the cache due to a change."),
+		metric.WithUnit("{organization}"))
+	if err != nil {
+		return cacheMetrics{}, err
+	}
+	return cacheMetrics{
+		hits:          hits,
+		misses:        misses,
+		invalidations: invalidations,
+	}, nil
+}
+
+// newCacheKey returns the cache key of an organization lookup. Lookups including deleted organizations are
+// cached apart as their outcome differs for deleted organizations.
+func newCacheKey(id string, includeDeleted bool) string {
+	return strconv.FormatBool(includeDeleted) + "/" + id
+}
+
+// GetByID retrieves an [Organization] by its unique identifier.
+func (c CachedFetcher) GetByID(ctx context.Context, id string, opts ...FetchOption) (Organization, error) {
+	options := fetchOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	key := newCacheKey(id, options.includeDeleted)
+	if org, ok := c.entries.Get(key); ok {
+		c.metrics.hits.Add(ctx, 1, metric.WithAttributes(attribute.Bool("negative", false)))
+		return org, nil
+	} else if _, ok = c.missing.Get(key); ok {
+		c.metrics.hits.Add(ctx, 1, metric.WithAttributes(attribute.Bool("negative", true)))
+		return Organization{}, ErrNotFound
+	}
+
+	c.metrics.misses.Add(ctx, 1)
+	// DEV-NOTE: The lookup is shared by every concurrent caller, so it must not be canceled when the caller
+	// starting it goes away.
+	res, err, _ := c.group.Do(key, func() (any, error) {
+		// callers missing the cache right before a previous lookup filled it start a lookup of their own
+		if org, ok := c.entries.Get(key); ok {
+			return org, nil
+		} else if _, ok = c.missing.Get(key); ok {
+			return Organization{}, ErrNotFound
+		}
+		generation := c.generation.Load()
+		org, err := c.next.GetByID(context.WithoutCancel(ctx), id, opts...)
+		if c.generation.Load() != generation {
+			return org, err
+		}
+		if errors.Is(err, ErrNotFound) {
+			c.missing.Add(key, struct{}{})
+		} else if err == nil {
+			c.entries.Add(key, org)
+		}
+		return org, err
+	})
+	if err != nil {
+		return Organization{}, err
+	}
+	return res.(Organization), nil
+}
+
+// GetBySlug retrieves an [Organization] by its slug.
+func (c CachedFetcher) GetBySlug(ctx context.Context, slug string, opts ...FetchOption) (Organization, error) {
+	// DEV-NOTE: Slug lookups are not cached, slugs change independently of the organization ID the cache is
+	// invalidated by, and previous slugs might be taken by other organizations at any time.
+	return c.next.GetBySlug(ctx, slug, opts...)
+}
+
+// Invalidate evicts the [Organization] identified by id from the cache.
+func (c CachedFetcher) Invalidate(ctx context.Context, id string) {
+	c.generation.Add(1)
+	for _, includeDeleted := range []bool{false, true} {
+		key := newCacheKey(id, includeDeleted)
+		c.entries.Remove(key)
+		c.missing.Remove(key)
+		c.group.Forget(key)
+	}
+	c.metrics.invalidations.Add(ctx, 1)
+}
+
+// -- Manager --
+
+// CacheInvalidatingManager is a [Manager] decorator evicting the organizations changed by the underlying [Manager]
+// from the [CachedFetcher] of the current instance once changes succeed.
+//
+// Place it outside a [TransactionalManager], so organizations are evicted once changes are committed. Other
+// instances evict their copies when reading the organization events (see [ControllerKafka]).
+type CacheInvalidatingManager struct {
+	next  Manager
+	cache CachedFetcher
+}
+
+// compile-time assertion
+var _ Manager = (*CacheInvalidatingManager)(nil)
+
+// NewCacheInvalidatingManager creates a new [CacheInvalidatingManager] instance.
+func NewCacheInvalidatingManager(next Manager, cache CachedFetcher) CacheInvalidatingManager {
+	return CacheInvalidatingManager{next: next, cache: cache}
+}
+
+// Register creates a new [Organization].
+func (c CacheInvalidatingManager) Register(ctx context.Context, args RegisterArguments) (Organization, error) {
+	org, err := c.next.Register(ctx, args)
+	if err != nil {
+		return Organization{}, err
+	}
+	// evicts not found lookups cached before registration
+	c.cache.Invalidate(ctx, org.ID())
+	return org, nil
+}
+
+// ModifyByID modifies an [Organization] by its unique identifier.
+func (c CacheInvalidatingManager) ModifyByID(ctx context.Context, id string,
+	opts ...UpdateOption) (Organization, error) {
+	return c.invalidate(ctx, id, func() (Organization, error) {
+		return c.next.ModifyByID(ctx, id, opts...)
+	})
+}
+
+// DeleteByID deletes an [Organization] by its unique identifier.
+func (c CacheInvalidatingManager) DeleteByID(ctx context.Context, id string, opts ...DeleteOption) error {
+	if err := c.next.DeleteByID(ctx, id, opts...); err != nil {
+		return err
+	}
+	c.cache.Invalidate(ctx, id)
+	return nil
+}
+
+// RestoreByID restores a deleted [Organization] by its unique identifier.
+func (c CacheInvalidatingManager) RestoreByID(ctx context.Context, id string) (Organization, error) {
+	return c.invalidate(ctx, id, func() (Organization, error) {
+		return c.next.RestoreByID(ctx, id)
+	})
+}
+
+// PurgeByID permanently erases an [Organization] by its unique identifier.
+func (c CacheInvalidatingManager) PurgeByID(ctx context.Context, id string) error {
+	if err := c.next.PurgeByID(ctx, id); err != nil {
+		return err
+	}
+	c.cache.Invalidate(ctx, id)
+	return nil
+}
+
+// MoveUnder moves an [Organization] by its unique identifier under the [Organization] identified by parentID.
+func (c CacheInvalidatingManager) MoveUnder(ctx context.Context, id, parentID string) (Organization, error) {
+	return c.invalidate(ctx, id, func() (Organization, error) {
+		return c.next.MoveUnder(ctx, id, parentID)
+	})
+}
+
+// Suspend suspends an active [Organization] by its unique identifier for the given reason.
+func (c CacheInvalidatingManager) Suspend(ctx context.Context, id string,
+	reason StatusReason) (Organization, error) {
+	return c.invalidate(ctx, id, func() (Organization, error) {
+		return c.next.Suspend(ctx, id, reason)
+	})
+}
+
+// Reactivate reactivates a suspended [Organization] by its unique identifier for the given reason.
+func (c CacheInvalidatingManager) Reactivate(ctx context.Context, id string,
+	reason StatusReason) (Organization, error) {
+	return c.invalidate(ctx, id, func() (Organization, error) {
+		return c.next.Reactivate(ctx, id, reason)
+	})
+}
+
+// Archive permanently archives an active or suspended [Organization] by its unique identifier for the given
+// reason.
+func (c CacheInvalidatingManager) Archive(ctx context.Context, id string,
+	reason StatusReason) (Organization, error) {
+	return c.invalidate(ctx, id, func() (Organization, error) {
+		return c.next.Archive(ctx, id, reason)
+	})
+}
+
+// invalidate runs change and evicts the organization identified by id if it succeeds.
+func (c CacheInvalidatingManager) invalidate(ctx context.Context, id string,
+	change func() (Organization, error)) (Organization, error) {
+	org, err := change()
+	if err != nil {
+		return Organization{}, err
+	}
+	c.cache.Invalidate(ctx, id)
+	return org, nil
+}
@@ -0,0 +1,290 @@
+package organization_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+)
+
+type cachedFetcherSuite struct {
+	suite.Suite
+
+	next    *organizationmock.MockFetcher
+	fetcher organization.CachedFetcher
+}
+
+func TestCachedFetcherSuite(t *testing.T) {
+	suite.Run(t, new(cachedFetcherSuite))
+}
+
+func (s *cachedFetcherSuite) SetupTest() {
+	s.next = organizationmock.NewMockFetcher(gomock.NewController(s.T()))
+	var err error
+	s.fetcher, err = organization.NewCachedFetcher(organization.CacheConfig{
+		Size:        10,
+		TTL:         time.Minute,
+		NegativeTTL: time.Minute,
+	}, s.next)
+	s.Require().NoError(err)
+}
+
+func (s *cachedFetcherSuite) TestCachedFetcher_GetByID_Hit() {
+	// arrange
+	ctx := context.Background()
+	s.next.EXPECT().
+		GetByID(gomock.Any(), "1").
+		Times(1).
+		Return(organization.New(ctx, "1", "foo"), error(nil))
+
+	// act
+	first, errFirst := s.fetcher.GetByID(ctx, "1")
+	second, errSecond := s.fetcher.GetByID(ctx, "1")
+
+	// assert
+	s.Require().NoError(errFirst)
+	s.Require().NoError(errSecond)
+	s.Assert().Equal("foo", first.Name())
+	s.Assert().Equal(first.ID(), second.ID())
+}
+
+func (s *cachedFetcherSuite) TestCachedFetcher_GetByID_Not_Found() {
+	// arrange
+	s.next.EXPECT().
+		GetByID(gomock.Any(), "1").
+		Times(1).
+		Return(organization.Organization{}, organization.ErrNotFound)
+
+	// act
+	_, errFirst := s.fetcher.GetByID(context.Background(), "1")
+	_, errSecond := s.fetcher.GetByID(context.Background(), "1")
+
+	// assert
+	s.Assert().ErrorIs(errFirst, organization.ErrNotFound)
+	s.Assert().ErrorIs(errSecond, organization.ErrNotFound)
+}
+
+func (s *cachedFetcherSuite) TestCachedFetcher_GetByID_Error() {
+	// arrange
+	errUnexpected := errors.New("connection refused")
+	s.next.EXPECT().
+		GetByID(gomock.Any(), "1").
+		Times(2).
+		Return(organization.Organization{}, errUnexpected)
+
+	// act
+	_, errFirst := s.fetcher.GetByID(context.Background(), "1")
+	_, errSecond := s.fetcher.GetByID(context.Background(), "1")
+
+	// assert
+	s.Assert().ErrorIs(errFirst, errUnexpected)
+	s.Assert().ErrorIs(errSecond, errUnexpected)
+}
+
+func (s *cachedFetcherSuite) TestCachedFetcher_GetByID_Fetch_Deleted() {
+	// arrange
+	ctx := context.Background()
+	s.next.EXPECT().
+		GetByID(gomock.Any(), "1").
+		Times(1).
+		Return(organization.Organization{}, organization.ErrNotFound)
+	s.next.EXPECT().
+		GetByID(gomock.Any(), "1", gomock.Any()).
+		Times(1).
+		Return(organization.New(ctx, "1", "foo"), error(nil))
+
+	// act
+	_, errActive := s.fetcher.GetByID(ctx, "1")
+	org, errDeleted := s.fetcher.GetByID(ctx, "1", organization.WithFetchDeleted())
+
+	// assert
+	s.Assert().ErrorIs(errActive, organization.ErrNotFound)
+	s.Require().NoError(errDeleted)
+	s.Assert().Equal("1", org.ID())
+}
+
+func (s *cachedFetcherSuite) TestCachedFetcher_GetByID_Concurrent() {
+	// arrange
+	ctx := context.Background()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	s.next.EXPECT().
+		GetByID(gomock.Any(), "1").
+		Times(1).
+		DoAndReturn(func(ctx context.Context, id string, _ ...organization.FetchOption) (
+			organization.Organization, error) {
+			close(started)
+			<-release
+			return organization.New(ctx, id, "foo"), nil
+		})
+	const callers = 8
+	ready := sync.WaitGroup{}
+	ready.Add(callers)
+	wg := sync.WaitGroup{}
+	errs := make(chan error, callers)
+
+	// act
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ready.Done()
+			_, err := s.fetcher.GetByID(ctx, "1")
+			errs <- err
+		}()
+	}
+	ready.Wait()
+	<-started
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	// assert
+	for err := range errs {
+		s.Assert().NoError(err)
+	}
+}
+
+func (s *cachedFetcherSuite) TestCachedFetcher_Invalidate() {
+	// arrange
+	ctx := context.Background()
+	gomock.InOrder(
+		s.next.EXPECT().
+			GetByID(gomock.Any(), "1").
+			Times(1).
+			Return(organization.New(ctx, "1", "foo"), error(nil)),
+		s.next.EXPECT().
+			GetByID(gomock.Any(), "1").
+			Times(1).
+			Return(organization.New(ctx, "1", "bar"), error(nil)),
+	)
+
+	// act
+	_, err := s.fetcher.GetByID(ctx, "1")
+	s.Require().NoError(err)
+	s.fetcher.Invalidate(ctx, "1")
+	org, err := s.fetcher.GetByID(ctx, "1")
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal("bar", org.Name())
+}
+
+type cacheInvalidatingManagerSuite struct {
+	suite.Suite
+
+	next    *organizationmock.MockManager
+	fetcher *organizationmock.MockFetcher
+	cache   organization.CachedFetcher
+	manager organization.CacheInvalidatingManager
+}
+
+func TestCacheInvalidatingManagerSuite(t *testing.T) {
+	suite.Run(t, new(cacheInvalidatingManagerSuite))
+}
+
+func (s *cacheInvalidatingManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.next = organizationmock.NewMockManager(ctrl)
+	s.fetcher = organizationmock.NewMockFetcher(ctrl)
+	var err error
+	s.cache, err = organization.NewCachedFetcher(organization.CacheConfig{
+		Size:        10,
+		TTL:         time.Minute,
+		NegativeTTL: time.Minute,
+	}, s.fetcher)
+	s.Require().NoError(err)
+	s.manager = organization.NewCacheInvalidatingManager(s.next, s.cache)
+}
+
+func (s *cacheInvalidatingManagerSuite) TestCacheInvalidatingManager_ModifyByID() {
+	// arrange
+	ctx := context.Background()
+	gomock.InOrder(
+		s.fetcher.EXPECT().
+			GetByID(gomock.Any(), "1").
+			Times(1).
+			Return(organization.New(ctx, "1", "foo"), error(nil)),
+		s.fetcher.EXPECT().
+			GetByID(gomock.Any(), "1").
+			Times(1).
+			Return(organization.New(ctx, "1", "bar"), error(nil)),
+	)
+	s.next.EXPECT().
+		ModifyByID(ctx, "1", gomock.Any()).
+		Times(1).
+		Return(organization.New(ctx, "1", "bar"), error(nil))
+	_, err := s.cache.GetByID(ctx, "1")
+	s.Require().NoError(err)
+
+	// act
+	_, err = s.manager.ModifyByID(ctx, "1", organization.WithUpdatedName(lo.ToPtr("bar")))
+	s.Require().NoError(err)
+	org, err := s.cache.GetByID(ctx, "1")
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal("bar", org.Name())
+}
+
+func (s *cacheInvalidatingManagerSuite) TestCacheInvalidatingManager_Register() {
+	// arrange
+	ctx := context.Background()
+	gomock.InOrder(
+		s.fetcher.EXPECT().
+			GetByID(gomock.Any(), "1").
+			Times(1).
+			Return(organization.Organization{}, organization.ErrNotFound),
+		s.fetcher.EXPECT().
+			GetByID(gomock.Any(), "1").
+			Times(1).
+			Return(organization.New(ctx, "1", "foo"), error(nil)),
+	)
+	s.next.EXPECT().
+		Register(ctx, organization.RegisterArguments{ID: "1", Name: "foo"}).
+		Times(1).
+		Return(organization.New(ctx, "1", "foo"), error(nil))
+	_, err := s.cache.GetByID(ctx, "1")
+	s.Require().ErrorIs(err, organization.ErrNotFound)
+
+	// act
+	_, err = s.manager.Register(ctx, organization.RegisterArguments{ID: "1", Name: "foo"})
+	s.Require().NoError(err)
+	org, err := s.cache.GetByID(ctx, "1")
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal("foo", org.Name())
+}
+
+func (s *cacheInvalidatingManagerSuite) TestCacheInvalidatingManager_Error() {
+	// arrange
+	ctx := context.Background()
+	s.fetcher.EXPECT().
+		GetByID(gomock.Any(), "1").
+		Times(1).
+		Return(organization.New(ctx, "1", "foo"), error(nil))
+	s.next.EXPECT().
+		Suspend(ctx, "1", gomock.Any()).
+		Times(1).
+		Return(organization.Organization{}, organization.ErrNotActive)
+	_, err := s.cache.GetByID(ctx, "1")
+	s.Require().NoError(err)
+
+	// act
+	_, err = s.manager.Suspend(ctx, "1", organization.ReasonNonPayment)
+	org, errCached := s.cache.GetByID(ctx, "1")
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
+	s.Require().NoError(errCached)
+	s.Assert().Equal("foo", org.Name())
+}
@@ -80,7 +80,7 @@ func (s *managerIntegrationSuite) SetupSuite() {
 	// Start manager
 	eventPublisher := event.NewStreamPublisher(streamWriter, identifier.FactoryKSUID{})
 	repo := organization.NewPostgresRepository(s.dbClient)
-	s.manager = organization.NewLocalManager(repo, eventPublisher)
+	s.manager = organization.NewLocalManager(organization.HierarchyConfig{MaxDepth: 5}, repo, eventPublisher)
 }
 
 func (s *managerIntegrationSuite) execSeed(ctx context.Context) error {
@@ -119,6 +119,8 @@ func (s *managerIntegrationSuite) execSeed(ctx context.Context) error {
 	}()
 	qtx := s.queryer.WithTx(tx)
 	for _, cmd := range createCommands {
+		// slugs mirror IDs, as for organizations created before slugs existed
+		cmd.Slug = cmd.OrganizationID
 		if err = qtx.CreateOrganization(ctx, cmd); err != nil {
 			return err
 		}
@@ -264,11 +266,11 @@ func (s *managerIntegrationSuite) TestDeleteOrganization() {
 	// Assert
 	s.Assert().NoError(err)
 
-	var exists bool
-	err = s.dbClient.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM organizations WHERE organization_id = $1 LIMIT 1)", "2").
-		Scan(&exists)
+	var isDeleted bool
+	err = s.dbClient.QueryRowContext(ctx, "SELECT is_deleted FROM organizations WHERE organization_id = $1 LIMIT 1", "2").
+		Scan(&isDeleted)
 	s.Assert().NoError(err)
-	s.Assert().False(exists)
+	s.Assert().True(isDeleted)
 
 	select {
 	case <-ctx.Done():
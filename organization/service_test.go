@@ -15,6 +15,8 @@ import (
 	"github.com/hadroncorp/service-template/organizationmock"
 )
 
+var _hierarchyConfig = organization.HierarchyConfig{MaxDepth: 3}
+
 type localManagerSuite struct {
 	suite.Suite
 
@@ -40,7 +42,11 @@ func (s *localManagerSuite) TestLocalManager_Register_Valid() {
 	ctrl := gomock.NewController(s.T())
 	repository := organizationmock.NewMockRepository(ctrl)
 	repository.EXPECT().
-		ExistsByName(s.baseCtx, "foo").
+		ExistsByName(s.baseCtx, "foo", "").
+		Times(1).
+		Return(false, error(nil))
+	repository.EXPECT().
+		ExistsBySlug(s.baseCtx, "foo", "1").
 		Times(1).
 		Return(false, error(nil))
 	repository.EXPECT().
@@ -54,7 +60,7 @@ func (s *localManagerSuite) TestLocalManager_Register_Valid() {
 		Return(error(nil))
 
 	var manager organization.Manager
-	manager = organization.NewLocalManager(repository, eventPublisher)
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
 	out, err := manager.Register(s.baseCtx, organization.RegisterArguments{
@@ -66,21 +72,62 @@ func (s *localManagerSuite) TestLocalManager_Register_Valid() {
 	s.Assert().NoError(err)
 	s.Assert().Equal("1", out.ID())
 	s.Assert().Equal("foo", out.Name())
+	s.Assert().Equal("foo", out.Slug())
 	s.Assert().NotZero(out.CreateTime())
 	s.Assert().Equal("some-user", out.CreateBy())
 }
 
+func (s *localManagerSuite) TestLocalManager_Register_Slug_Taken() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		ExistsByName(s.baseCtx, "Acme Inc.", "").
+		Times(1).
+		Return(false, error(nil))
+	repository.EXPECT().
+		ExistsBySlug(s.baseCtx, "acme-inc", "1").
+		Times(1).
+		Return(true, error(nil))
+	repository.EXPECT().
+		ExistsBySlug(s.baseCtx, "acme-inc-2", "1").
+		Times(1).
+		Return(false, error(nil))
+	repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	out, err := manager.Register(s.baseCtx, organization.RegisterArguments{
+		ID:   "1",
+		Name: "Acme Inc.",
+	})
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal("acme-inc-2", out.Slug())
+}
+
 func (s *localManagerSuite) TestLocalManager_Register_Already_Exists() {
 	// arrange
 	ctrl := gomock.NewController(s.T())
 	repository := organizationmock.NewMockRepository(ctrl)
 	repository.EXPECT().
-		ExistsByName(s.baseCtx, "foo").
+		ExistsByName(s.baseCtx, "foo", "").
 		Times(1).
 		Return(true, error(nil))
 	eventPublisher := eventmock.NewMockPublisher(ctrl)
 	var manager organization.Manager
-	manager = organization.NewLocalManager(repository, eventPublisher)
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
 	out, err := manager.Register(s.baseCtx, organization.RegisterArguments{
@@ -96,13 +143,54 @@ func (s *localManagerSuite) TestLocalManager_Register_Already_Exists() {
 	s.Assert().Zero(out.CreateBy())
 }
 
+func (s *localManagerSuite) TestLocalManager_Register_Invalid_Profile() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	_, err := manager.Register(s.baseCtx, organization.RegisterArguments{
+		ID:   "1",
+		Name: "foo",
+		Profile: organization.Profile{
+			Country: "mx",
+			TaxID:   "not-an-rfc",
+		},
+	})
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrInvalidProfile)
+}
+
+func (s *localManagerSuite) TestLocalManager_Register_Invalid_Labels() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	_, err := manager.Register(s.baseCtx, organization.RegisterArguments{
+		ID:     "1",
+		Name:   "foo",
+		Labels: organization.Labels{"tier gold": "x"},
+	})
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrInvalidLabels)
+}
+
 func (s *localManagerSuite) TestLocalManager_ModifyByID_Noop() {
 	// arrange
 	ctrl := gomock.NewController(s.T())
 	repository := organizationmock.NewMockRepository(ctrl)
 	eventPublisher := eventmock.NewMockPublisher(ctrl)
 	var manager organization.Manager
-	manager = organization.NewLocalManager(repository, eventPublisher)
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
 	out, err := manager.ModifyByID(s.baseCtx, "1")
@@ -122,7 +210,7 @@ func (s *localManagerSuite) TestLocalManager_ModifyByID_Modified() {
 		Times(1).
 		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
 	repository.EXPECT().
-		ExistsByName(ctx, "bar").
+		ExistsByName(ctx, "bar", "1").
 		Times(1).
 		Return(false, error(nil))
 	repository.EXPECT().
@@ -136,7 +224,7 @@ func (s *localManagerSuite) TestLocalManager_ModifyByID_Modified() {
 		Return(error(nil))
 
 	var manager organization.Manager
-	manager = organization.NewLocalManager(repository, eventPublisher)
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
 	out, err := manager.ModifyByID(ctx, "1", organization.WithUpdatedName(lo.ToPtr("bar")))
@@ -151,6 +239,36 @@ func (s *localManagerSuite) TestLocalManager_ModifyByID_Modified() {
 	s.Assert().Equal("some-other-user", out.LastUpdateBy())
 }
 
+func (s *localManagerSuite) TestLocalManager_ModifyByID_Same_Name() {
+	// arrange
+	ctx := identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-other-user"))
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(ctx, "1").
+		Times(1).
+		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
+	repository.EXPECT().
+		Save(ctx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	eventPublisher.EXPECT().
+		Publish(ctx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	out, err := manager.ModifyByID(ctx, "1", organization.WithUpdatedName(lo.ToPtr("foo")))
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal("foo", out.Name())
+}
+
 func (s *localManagerSuite) TestLocalManager_ModifyByID_Already_Exists() {
 	// arrange
 	ctx := identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-other-user"))
@@ -161,17 +279,63 @@ func (s *localManagerSuite) TestLocalManager_ModifyByID_Already_Exists() {
 		Times(1).
 		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
 	repository.EXPECT().
-		ExistsByName(ctx, "bar").
+		ExistsByName(ctx, "bar", "1").
 		Times(1).
 		Return(true, error(nil))
 	eventPublisher := eventmock.NewMockPublisher(ctrl)
 
 	var manager organization.Manager
-	manager = organization.NewLocalManager(repository, eventPublisher)
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 	_, err := manager.ModifyByID(ctx, "1", organization.WithUpdatedName(lo.ToPtr("bar")))
 	s.Assert().ErrorAs(err, &organization.ErrAlreadyExists)
 }
 
+func (s *localManagerSuite) TestLocalManager_ModifyByID_Slug_Already_Exists() {
+	// arrange
+	ctx := identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-other-user"))
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(ctx, "1").
+		Times(1).
+		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
+	repository.EXPECT().
+		ExistsBySlug(ctx, "bar", "1").
+		Times(1).
+		Return(true, error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	_, err := manager.ModifyByID(ctx, "1", organization.WithUpdatedSlug(lo.ToPtr("Bar")))
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrSlugAlreadyExists)
+}
+
+func (s *localManagerSuite) TestLocalManager_ModifyByID_Invalid_Slug() {
+	// arrange
+	ctx := identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-other-user"))
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(ctx, "1").
+		Times(1).
+		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	_, err := manager.ModifyByID(ctx, "1", organization.WithUpdatedSlug(lo.ToPtr("foo--bar")))
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrInvalidSlug)
+}
+
 func (s *localManagerSuite) TestLocalManager_DeleteByID_Found() {
 	// arrange
 	ctrl := gomock.NewController(s.T())
@@ -191,7 +355,7 @@ func (s *localManagerSuite) TestLocalManager_DeleteByID_Found() {
 		Return(error(nil))
 
 	var manager organization.Manager
-	manager = organization.NewLocalManager(repository, eventPublisher)
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
 	err := manager.DeleteByID(s.baseCtx, "1")
@@ -211,7 +375,7 @@ func (s *localManagerSuite) TestLocalManager_DeleteByID_Noop() {
 	eventPublisher := eventmock.NewMockPublisher(ctrl)
 
 	var manager organization.Manager
-	manager = organization.NewLocalManager(repository, eventPublisher)
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
 	err := manager.DeleteByID(s.baseCtx, "1")
@@ -220,71 +384,709 @@ func (s *localManagerSuite) TestLocalManager_DeleteByID_Noop() {
 	s.Assert().NoError(err)
 }
 
-type localFetcherSuite struct {
-	suite.Suite
+func (s *localManagerSuite) TestLocalManager_DeleteByID_Already_Deleted() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	org.Delete(s.baseCtx)
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	err := manager.DeleteByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
 }
 
-func TestLocalFetcherSuite(t *testing.T) {
-	suite.Run(t, new(localFetcherSuite))
+func (s *localManagerSuite) TestLocalManager_DeleteByID_Not_Active() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.Require().NoError(org.Suspend(s.baseCtx, organization.ReasonPolicyViolation))
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventmock.NewMockPublisher(ctrl))
+
+	// act
+	err := manager.DeleteByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
 }
 
-func (s *localFetcherSuite) TestLocalFetcher_FindByID_Found() {
+func (s *localManagerSuite) TestLocalManager_RestoreByID_Restored() {
 	// arrange
 	ctrl := gomock.NewController(s.T())
-	repository := organizationmock.NewMockReadRepository(ctrl)
+	org := organization.New(s.baseCtx, "1", "foo")
+	org.Delete(s.baseCtx)
+	repository := organizationmock.NewMockRepository(ctrl)
 	repository.EXPECT().
-		FindByKey(gomock.Any(), "1").
+		FindByKey(s.baseCtx, "1").
 		Times(1).
-		Return(lo.ToPtr(organization.New(context.Background(), "1", "foo")), error(nil))
+		Return(&org, error(nil))
+	repository.EXPECT().
+		ExistsByName(s.baseCtx, "foo", "1").
+		Times(1).
+		Return(false, error(nil))
+	repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
 
-	var fetcher organization.Fetcher
-	fetcher = organization.NewLocalFetcher(repository)
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
-	out, err := fetcher.GetByID(context.Background(), "1")
+	out, err := manager.RestoreByID(s.baseCtx, "1")
 
 	// assert
 	s.Assert().NoError(err)
 	s.Assert().Equal("1", out.ID())
-	s.Assert().Equal("foo", out.Name())
+	s.Assert().False(out.IsDeleted())
 }
 
-type localListerSuite struct {
-	suite.Suite
+func (s *localManagerSuite) TestLocalManager_RestoreByID_Name_Taken() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	org.Delete(s.baseCtx)
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	repository.EXPECT().
+		ExistsByName(s.baseCtx, "foo", "1").
+		Times(1).
+		Return(true, error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	_, err := manager.RestoreByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().ErrorAs(err, &organization.ErrAlreadyExists)
 }
 
-func TestLocalListerSuite(t *testing.T) {
-	suite.Run(t, new(localListerSuite))
+func (s *localManagerSuite) TestLocalManager_RestoreByID_Not_Active() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.Require().NoError(org.Archive(s.baseCtx, organization.ReasonPolicyViolation))
+	org.Delete(s.baseCtx)
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventmock.NewMockPublisher(ctrl))
+
+	// act
+	_, err := manager.RestoreByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
 }
 
-func (s *localListerSuite) TestLocalLister_List_Valid() {
+func (s *localManagerSuite) TestLocalManager_RestoreByID_Noop() {
 	// arrange
 	ctrl := gomock.NewController(s.T())
-	repository := organizationmock.NewMockReadRepository(ctrl)
+	repository := organizationmock.NewMockRepository(ctrl)
 	repository.EXPECT().
-		FindAll(gomock.Any(), gomock.Any()).
+		FindByKey(s.baseCtx, "1").
 		Times(1).
-		Return(&paging.Page[organization.Organization]{
-			TotalItems: 1,
-			Items: []organization.Organization{
-				organization.New(context.Background(), "1", "foo"),
-			},
-		}, error(nil))
+		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
 
-	var lister organization.Lister
-	lister = organization.NewLocalLister(repository)
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
 
 	// act
-	out, err := lister.List(context.Background(),
-		organization.WithListPageOptions(
-			paging.WithLimit(10),
-		),
-		organization.WithListNonDeletedOnly(),
-	)
+	out, err := manager.RestoreByID(s.baseCtx, "1")
 
 	// assert
 	s.Assert().NoError(err)
-	s.Assert().Len(out.Items, 1)
-	s.Assert().Equal("1", out.Items[0].ID())
-	s.Assert().Equal("foo", out.Items[0].Name())
+	s.Assert().Equal("1", out.ID())
+}
+
+func (s *localManagerSuite) TestLocalManager_PurgeByID_Found() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
+	repository.EXPECT().
+		PurgeByKey(s.baseCtx, "1").
+		Times(1).
+		Return(error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	err := manager.PurgeByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *localManagerSuite) TestLocalManager_PurgeByID_Noop() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return((*organization.Organization)(nil), error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	err := manager.PurgeByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+}
+
+func (s *localManagerSuite) TestLocalManager_Register_Under_Parent() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	parent := organization.New(s.baseCtx, "1", "foo")
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		ExistsByName(s.baseCtx, "bar", "").
+		Times(1).
+		Return(false, error(nil))
+	repository.EXPECT().
+		ExistsBySlug(s.baseCtx, "bar", "2").
+		Times(1).
+		Return(false, error(nil))
+	repository.EXPECT().
+		LockHierarchy(s.baseCtx).
+		Times(1).
+		Return(error(nil))
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&parent, error(nil))
+	repository.EXPECT().
+		FindAncestors(s.baseCtx, "1").
+		Times(1).
+		Return([]organization.Organization{}, error(nil))
+	repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	out, err := manager.Register(s.baseCtx, organization.RegisterArguments{
+		ID:       "2",
+		Name:     "bar",
+		ParentID: "1",
+	})
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal("1", out.ParentID())
+}
+
+func (s *localManagerSuite) TestLocalManager_MoveUnder_Moved() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	_ = org.PullEvents()
+	parent := organization.New(s.baseCtx, "2", "bar")
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		LockHierarchy(s.baseCtx).
+		Times(1).
+		Return(error(nil))
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	repository.EXPECT().
+		FindDescendants(s.baseCtx, "1").
+		Times(1).
+		Return([]organization.Organization{}, error(nil))
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&parent, error(nil))
+	repository.EXPECT().
+		FindAncestors(s.baseCtx, "2").
+		Times(1).
+		Return([]organization.Organization{}, error(nil))
+	repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	out, err := manager.MoveUnder(s.baseCtx, "1", "2")
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal("2", out.ParentID())
+}
+
+func (s *localManagerSuite) TestLocalManager_MoveUnder_Cycle() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	child := organization.New(s.baseCtx, "2", "bar", organization.WithParent("1"))
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		LockHierarchy(s.baseCtx).
+		Times(1).
+		Return(error(nil))
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	repository.EXPECT().
+		FindDescendants(s.baseCtx, "1").
+		Times(1).
+		Return([]organization.Organization{child}, error(nil))
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&child, error(nil))
+	repository.EXPECT().
+		FindAncestors(s.baseCtx, "2").
+		Times(1).
+		Return([]organization.Organization{org}, error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventmock.NewMockPublisher(ctrl))
+
+	// act
+	_, err := manager.MoveUnder(s.baseCtx, "1", "2")
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrHierarchyCycle)
+}
+
+func (s *localManagerSuite) TestLocalManager_MoveUnder_Max_Depth_Exceeded() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	child := organization.New(s.baseCtx, "3", "baz", organization.WithParent("1"))
+	grandparent := organization.New(s.baseCtx, "0", "qux")
+	parent := organization.New(s.baseCtx, "2", "bar", organization.WithParent("0"))
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		LockHierarchy(s.baseCtx).
+		Times(1).
+		Return(error(nil))
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	repository.EXPECT().
+		FindDescendants(s.baseCtx, "1").
+		Times(1).
+		Return([]organization.Organization{child}, error(nil))
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "2").
+		Times(1).
+		Return(&parent, error(nil))
+	repository.EXPECT().
+		FindAncestors(s.baseCtx, "2").
+		Times(1).
+		Return([]organization.Organization{grandparent}, error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventmock.NewMockPublisher(ctrl))
+
+	// act
+	_, err := manager.MoveUnder(s.baseCtx, "1", "2")
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrMaxDepthExceeded)
+}
+
+func (s *localManagerSuite) TestLocalManager_Suspend_Suspended() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(lo.ToPtr(organization.New(s.baseCtx, "1", "foo")), error(nil))
+	repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	eventPublisher := eventmock.NewMockPublisher(ctrl)
+	eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventPublisher)
+
+	// act
+	out, err := manager.Suspend(s.baseCtx, "1", organization.ReasonNonPayment)
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal(organization.StatusSuspended, out.Status())
+	s.Assert().Equal(organization.ReasonNonPayment, out.StatusReason())
+}
+
+func (s *localManagerSuite) TestLocalManager_Reactivate_Archived() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.Require().NoError(org.Archive(s.baseCtx, organization.ReasonOwnerRequest))
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventmock.NewMockPublisher(ctrl))
+
+	// act
+	_, err := manager.Reactivate(s.baseCtx, "1", organization.ReasonResolved)
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrInvalidStatusTransition)
+}
+
+func (s *localManagerSuite) TestLocalManager_ModifyByID_Not_Active() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.Require().NoError(org.Suspend(s.baseCtx, organization.ReasonPolicyViolation))
+	repository := organizationmock.NewMockRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+
+	var manager organization.Manager
+	manager = organization.NewLocalManager(_hierarchyConfig, repository, eventmock.NewMockPublisher(ctrl))
+
+	// act
+	_, err := manager.ModifyByID(s.baseCtx, "1", organization.WithUpdatedName(lo.ToPtr("bar")))
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
+}
+
+// txRunnerStub is a sqltx.Runner stub recording whether a transaction boundary was opened.
+type txRunnerStub struct {
+	calls int
+}
+
+func (r *txRunnerStub) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	r.calls++
+	return fn(ctx)
+}
+
+type transactionalManagerSuite struct {
+	suite.Suite
+
+	baseCtx context.Context
+}
+
+func TestTransactionalManagerSuite(t *testing.T) {
+	suite.Run(t, new(transactionalManagerSuite))
+}
+
+func (s *transactionalManagerSuite) SetupSuite() {
+	s.baseCtx = identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+}
+
+func (s *transactionalManagerSuite) TestTransactionalManager_Register() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	next := organizationmock.NewMockManager(ctrl)
+	next.EXPECT().
+		Register(s.baseCtx, organization.RegisterArguments{ID: "1", Name: "foo"}).
+		Times(1).
+		Return(organization.New(s.baseCtx, "1", "foo"), error(nil))
+	runner := &txRunnerStub{}
+
+	var manager organization.Manager
+	manager = organization.NewTransactionalManager(next, runner)
+
+	// act
+	out, err := manager.Register(s.baseCtx, organization.RegisterArguments{ID: "1", Name: "foo"})
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, runner.calls)
+	s.Assert().Equal("1", out.ID())
+	s.Assert().Equal("foo", out.Name())
+}
+
+func (s *transactionalManagerSuite) TestTransactionalManager_Register_Failed() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	next := organizationmock.NewMockManager(ctrl)
+	next.EXPECT().
+		Register(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(organization.New(s.baseCtx, "1", "foo"), organization.ErrAlreadyExists)
+	runner := &txRunnerStub{}
+
+	var manager organization.Manager
+	manager = organization.NewTransactionalManager(next, runner)
+
+	// act
+	out, err := manager.Register(s.baseCtx, organization.RegisterArguments{ID: "1", Name: "foo"})
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrAlreadyExists)
+	s.Assert().Equal(1, runner.calls)
+	s.Assert().Zero(out.ID())
+}
+
+func (s *transactionalManagerSuite) TestTransactionalManager_ModifyByID() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	next := organizationmock.NewMockManager(ctrl)
+	next.EXPECT().
+		ModifyByID(s.baseCtx, "1", gomock.Any()).
+		Times(1).
+		Return(organization.New(s.baseCtx, "1", "bar"), error(nil))
+	runner := &txRunnerStub{}
+
+	var manager organization.Manager
+	manager = organization.NewTransactionalManager(next, runner)
+
+	// act
+	out, err := manager.ModifyByID(s.baseCtx, "1", organization.WithUpdatedName(lo.ToPtr("bar")))
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, runner.calls)
+	s.Assert().Equal("bar", out.Name())
+}
+
+func (s *transactionalManagerSuite) TestTransactionalManager_DeleteByID() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	next := organizationmock.NewMockManager(ctrl)
+	next.EXPECT().
+		DeleteByID(s.baseCtx, "1").
+		Times(1).
+		Return(error(nil))
+	runner := &txRunnerStub{}
+
+	var manager organization.Manager
+	manager = organization.NewTransactionalManager(next, runner)
+
+	// act
+	err := manager.DeleteByID(s.baseCtx, "1")
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, runner.calls)
+}
+
+type localFetcherSuite struct {
+	suite.Suite
+}
+
+func TestLocalFetcherSuite(t *testing.T) {
+	suite.Run(t, new(localFetcherSuite))
+}
+
+func (s *localFetcherSuite) TestLocalFetcher_FindByID_Found() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockReadRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(gomock.Any(), "1").
+		Times(1).
+		Return(lo.ToPtr(organization.New(context.Background(), "1", "foo")), error(nil))
+
+	var fetcher organization.Fetcher
+	fetcher = organization.NewLocalFetcher(repository)
+
+	// act
+	out, err := fetcher.GetByID(context.Background(), "1")
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Equal("1", out.ID())
+	s.Assert().Equal("foo", out.Name())
+}
+
+func (s *localFetcherSuite) TestLocalFetcher_FindByID_Deleted() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	org := organization.New(context.Background(), "1", "foo")
+	org.Delete(context.Background())
+	repository := organizationmock.NewMockReadRepository(ctrl)
+	repository.EXPECT().
+		FindByKey(gomock.Any(), "1").
+		Times(2).
+		Return(&org, error(nil))
+
+	var fetcher organization.Fetcher
+	fetcher = organization.NewLocalFetcher(repository)
+
+	// act
+	_, err := fetcher.GetByID(context.Background(), "1")
+	out, errDeleted := fetcher.GetByID(context.Background(), "1", organization.WithFetchDeleted())
+
+	// assert
+	s.Assert().ErrorAs(err, &organization.ErrNotFound)
+	s.Assert().NoError(errDeleted)
+	s.Assert().Equal("1", out.ID())
+	s.Assert().True(out.IsDeleted())
+}
+
+type localListerSuite struct {
+	suite.Suite
+}
+
+func TestLocalListerSuite(t *testing.T) {
+	suite.Run(t, new(localListerSuite))
+}
+
+func (s *localListerSuite) TestLocalLister_List_Valid() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockReadRepository(ctrl)
+	repository.EXPECT().
+		FindAll(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(&paging.Page[organization.Organization]{
+			TotalItems: 1,
+			Items: []organization.Organization{
+				organization.New(context.Background(), "1", "foo"),
+			},
+		}, error(nil))
+
+	var lister organization.Lister
+	lister = organization.NewLocalLister(repository)
+
+	// act
+	out, err := lister.List(context.Background(),
+		organization.WithListPageOptions(
+			paging.WithLimit(10),
+		),
+		organization.WithListNonDeletedOnly(),
+	)
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Len(out.Items, 1)
+	s.Assert().Equal("1", out.Items[0].ID())
+	s.Assert().Equal("foo", out.Items[0].Name())
+}
+
+type localSearcherSuite struct {
+	suite.Suite
+}
+
+func TestLocalSearcherSuite(t *testing.T) {
+	suite.Run(t, new(localSearcherSuite))
+}
+
+func (s *localSearcherSuite) TestLocalSearcher_Search_Valid() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockSearchRepository(ctrl)
+	repository.EXPECT().
+		Search(gomock.Any(), "acme", gomock.Any()).
+		Times(1).
+		Return(&paging.Page[organization.SearchResult]{
+			TotalItems: 1,
+			Items: []organization.SearchResult{
+				{
+					Organization: organization.New(context.Background(), "1", "acme"),
+					Score:        0.5,
+					Highlight:    "<mark>acme</mark>",
+				},
+			},
+		}, error(nil))
+
+	var searcher organization.Searcher
+	searcher = organization.NewLocalSearcher(repository)
+
+	// act
+	out, err := searcher.Search(context.Background(), "acme",
+		organization.WithSearchPageOptions(
+			paging.WithLimit(10),
+		),
+	)
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Len(out.Items, 1)
+	s.Assert().Equal("1", out.Items[0].Organization.ID())
+	s.Assert().Equal("<mark>acme</mark>", out.Items[0].Highlight)
+}
+
+func (s *localSearcherSuite) TestLocalSearcher_Search_Blank() {
+	// arrange
+	ctrl := gomock.NewController(s.T())
+	repository := organizationmock.NewMockSearchRepository(ctrl)
+
+	var searcher organization.Searcher
+	searcher = organization.NewLocalSearcher(repository)
+
+	// act
+	out, err := searcher.Search(context.Background(), "  ")
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().Empty(out.Items)
 }
@@ -0,0 +1,64 @@
+package organization
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DEV-NOTE: Slugs are human-friendly handles of organizations used in URLs (e.g. /orgs/acme-inc). They are
+// generated from the name when the organization is registered, but they do not follow later renames so URLs
+// remain stable. Previous slugs are kept as redirects instead (see [ReadRepository.FindBySlug]).
+
+// MaxSlugLength is the maximum number of characters of a slug.
+const MaxSlugLength = 64
+
+// _slugRegexp matches valid slugs, lowercase alphanumeric words separated by single hyphens.
+var _slugRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// _slugReplacer transliterates letters not decomposed by Unicode normalization (NFKD) and symbols meaningful
+// in names.
+var _slugReplacer = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "Æ", "ae", "ø", "o", "Ø", "o", "œ", "oe", "Œ", "oe",
+	"ł", "l", "Ł", "l", "đ", "d", "Đ", "d", "þ", "th", "Þ", "th", "&", " and ",
+)
+
+// IsValidSlug checks whether slug is a valid [Organization] slug: lowercase letters and digits separated by
+// single hyphens, up to [MaxSlugLength] characters.
+func IsValidSlug(slug string) bool {
+	return len(slug) <= MaxSlugLength && _slugRegexp.MatchString(slug)
+}
+
+// NewSlug generates a slug from the name of an [Organization] (e.g. `Café & Co.` becomes `cafe-and-co`).
+//
+// Letters are transliterated to ASCII, any other character separates words. It returns an empty string if
+// name has no letters nor digits.
+func NewSlug(name string) string {
+	ascii, _, err := transform.String(transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn))),
+		_slugReplacer.Replace(name))
+	if err != nil {
+		ascii = name
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(ascii), func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+	return truncateSlug(strings.Join(words, "-"), MaxSlugLength)
+}
+
+// newSlugWithSuffix appends suffix to slug, truncating slug so the result fits in [MaxSlugLength].
+func newSlugWithSuffix(slug, suffix string) string {
+	return truncateSlug(slug, MaxSlugLength-len(suffix)-1) + "-" + suffix
+}
+
+// truncateSlug truncates slug to size characters at most, dropping trailing hyphens.
+func truncateSlug(slug string, size int) string {
+	if len(slug) > size {
+		slug = slug[:size]
+	}
+	return strings.TrimRight(slug, "-")
+}
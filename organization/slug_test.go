@@ -0,0 +1,81 @@
+package organization_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hadroncorp/service-template/organization"
+)
+
+func TestNewSlug(t *testing.T) {
+	tests := []struct {
+		name   string
+		inName string
+		exp    string
+	}{
+		{
+			name:   "ascii",
+			inName: "Acme Inc.",
+			exp:    "acme-inc",
+		},
+		{
+			name:   "accents",
+			inName: "Café Crème",
+			exp:    "cafe-creme",
+		},
+		{
+			name:   "special letters",
+			inName: "Straße Łódź Ærø",
+			exp:    "strasse-lodz-aero",
+		},
+		{
+			name:   "ampersand",
+			inName: "Smith & Sons",
+			exp:    "smith-and-sons",
+		},
+		{
+			name:   "separators",
+			inName: "  --foo__bar//baz--  ",
+			exp:    "foo-bar-baz",
+		},
+		{
+			name:   "no letters nor digits",
+			inName: "🚀 ★",
+			exp:    "",
+		},
+		{
+			name:   "too long",
+			inName: strings.Repeat("a", 60) + " " + strings.Repeat("b", 10),
+			exp:    strings.Repeat("a", 60) + "-bbb",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := organization.NewSlug(tt.inName)
+			assert.Equal(t, tt.exp, out)
+			assert.True(t, out == "" || organization.IsValidSlug(out))
+		})
+	}
+}
+
+func TestIsValidSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		exp  bool
+	}{
+		{name: "valid", in: "acme-inc-2", exp: true},
+		{name: "uppercase", in: "Acme", exp: false},
+		{name: "leading hyphen", in: "-acme", exp: false},
+		{name: "double hyphen", in: "acme--inc", exp: false},
+		{name: "empty", in: "", exp: false},
+		{name: "too long", in: strings.Repeat("a", organization.MaxSlugLength+1), exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, organization.IsValidSlug(tt.in))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package organization
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hadroncorp/geck/syserr"
+)
+
+var (
+	// ErrInvalidStatusTransition is returned when an organization cannot go from its current status to the
+	// requested one (e.g. archived organizations cannot be reactivated).
+	ErrInvalidStatusTransition = fmt.Errorf("organization: invalid status transition: %w", syserr.ErrResourceConflict)
+	// ErrInvalidStatusReason is returned when a status reason code is not supported.
+	ErrInvalidStatusReason = errors.New("organization: invalid status reason")
+	// ErrNotActive is returned when an organization is written (e.g. modified) while suspended or archived.
+	ErrNotActive = fmt.Errorf("organization: organization is not active: %w", syserr.ErrResourceConflict)
+)
+
+// Status is the stage of the lifecycle an [Organization] is in.
+type Status string
+
+const (
+	// StatusActive is the status of organizations in regular operation. This is the status of new organizations.
+	StatusActive Status = "active"
+	// StatusSuspended is the status of organizations temporarily frozen (e.g. due to non-payment), they are
+	// readable but not writable until reactivated.
+	StatusSuspended Status = "suspended"
+	// StatusArchived is the status of organizations no longer in operation. Archiving is permanent, archived
+	// organizations are read-only.
+	StatusArchived Status = "archived"
+)
+
+// _statusTransitions maps every status to the statuses an [Organization] can go to from it.
+var _statusTransitions = map[Status][]Status{
+	StatusActive:    {StatusSuspended, StatusArchived},
+	StatusSuspended: {StatusActive, StatusArchived},
+}
+
+// IsValid checks whether s is a supported status.
+func (s Status) IsValid() bool {
+	return s == StatusActive || s == StatusSuspended || s == StatusArchived
+}
+
+// CanTransitionTo checks whether an [Organization] can go from s to next.
+func (s Status) CanTransitionTo(next Status) bool {
+	return slices.Contains(_statusTransitions[s], next)
+}
+
+// StatusReason is the reason code of a status transition of an [Organization].
+type StatusReason string
+
+const (
+	// ReasonNonPayment is the reason of transitions due to unpaid invoices.
+	ReasonNonPayment StatusReason = "non_payment"
+	// ReasonPolicyViolation is the reason of transitions due to a breach of the terms of service.
+	ReasonPolicyViolation StatusReason = "policy_violation"
+	// ReasonSecurityIncident is the reason of transitions due to a compromised organization.
+	ReasonSecurityIncident StatusReason = "security_incident"
+	// ReasonOwnerRequest is the reason of transitions requested by the organization itself.
+	ReasonOwnerRequest StatusReason = "owner_request"
+	// ReasonInactivity is the reason of transitions due to a long period without activity.
+	ReasonInactivity StatusReason = "inactivity"
+	// ReasonResolved is the reason of transitions after the cause of a previous one was addressed (e.g. invoices
+	// were paid).
+	ReasonResolved StatusReason = "resolved"
+	// ReasonOther is the reason of transitions not covered by other reason codes.
+	ReasonOther StatusReason = "other"
+)
+
+// IsValid checks whether r is a supported reason code.
+func (r StatusReason) IsValid() bool {
+	switch r {
+	case ReasonNonPayment, ReasonPolicyViolation, ReasonSecurityIncident, ReasonOwnerRequest, ReasonInactivity,
+		ReasonResolved, ReasonOther:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,65 @@
+package organization_test
+
+import (
+	"testing"
+
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hadroncorp/service-template/organization"
+)
+
+func TestStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		name   string
+		inFrom organization.Status
+		inTo   organization.Status
+		exp    bool
+	}{
+		{name: "suspend active", inFrom: organization.StatusActive, inTo: organization.StatusSuspended, exp: true},
+		{name: "archive active", inFrom: organization.StatusActive, inTo: organization.StatusArchived, exp: true},
+		{name: "reactivate suspended", inFrom: organization.StatusSuspended, inTo: organization.StatusActive, exp: true},
+		{name: "archive suspended", inFrom: organization.StatusSuspended, inTo: organization.StatusArchived, exp: true},
+		{name: "reactivate archived", inFrom: organization.StatusArchived, inTo: organization.StatusActive, exp: false},
+		{name: "suspend archived", inFrom: organization.StatusArchived, inTo: organization.StatusSuspended, exp: false},
+		{name: "same status", inFrom: organization.StatusActive, inTo: organization.StatusActive, exp: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, tt.inFrom.CanTransitionTo(tt.inTo))
+		})
+	}
+}
+
+func TestOrganization_Suspend(t *testing.T) {
+	// arrange
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	org := organization.New(ctx, "1", "acme")
+	_ = org.PullEvents()
+
+	// act
+	err := org.Suspend(ctx, organization.ReasonNonPayment)
+
+	// assert
+	assert.NoError(t, err)
+	assert.False(t, org.IsActive())
+	assert.Equal(t, organization.StatusSuspended, org.Status())
+	assert.Equal(t, organization.ReasonNonPayment, org.StatusReason())
+	events := org.PullEvents()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, organization.TopicSuspended, events[0].Topic())
+	}
+}
+
+func TestOrganization_Suspend_Invalid_Reason(t *testing.T) {
+	// arrange
+	ctx := identity.WithPrincipal(t.Context(), identity.NewBasicPrincipal("foo"))
+	org := organization.New(ctx, "1", "acme")
+
+	// act
+	err := org.Suspend(ctx, "because")
+
+	// assert
+	assert.ErrorIs(t, err, organization.ErrInvalidStatusReason)
+	assert.True(t, org.IsActive())
+}
@@ -1,11 +1,17 @@
 package organizationfx
 
 import (
+	"context"
+
 	"github.com/hadroncorp/enclave/kafka/kafkafx"
 	"github.com/hadroncorp/geck/transportfx/httpfx"
 	"go.uber.org/fx"
 
+	"github.com/hadroncorp/service-template/authzfx"
+	"github.com/hadroncorp/service-template/grpcserverfx"
+	"github.com/hadroncorp/service-template/openapifx"
 	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/outboxfx"
 )
 
 var Module = fx.Module("hadron/iam/organization",
@@ -18,19 +24,131 @@ var Module = fx.Module("hadron/iam/organization",
 			organization.NewPostgresReadRepository,
 			fx.As(new(organization.ReadRepository)),
 		),
+		fx.Annotate(
+			organization.NewPostgresSearchRepository,
+			fx.As(new(organization.SearchRepository)),
+		),
+		organization.NewHierarchyConfig,
 		fx.Annotate(
 			organization.NewLocalManager,
+			fx.ParamTags(``, ``, `name:"`+outboxfx.PublisherName+`"`),
+			fx.ResultTags(`name:"organization_local_manager"`),
+			fx.As(new(organization.Manager)),
+		),
+		fx.Annotate(
+			organization.NewPostgresHistoryRepository,
+			fx.As(new(organization.HistoryRepository)),
+		),
+		fx.Annotate(
+			organization.NewAuditedManager,
+			fx.ParamTags(`name:"organization_local_manager"`),
+			fx.ResultTags(`name:"organization_audited_manager"`),
+			fx.As(new(organization.Manager)),
+		),
+		fx.Annotate(
+			organization.NewTransactionalManager,
+			// decorated by membershipfx, making callers the owner of the organizations they register
+			fx.ParamTags(`name:"organization_owned_manager"`),
+			fx.ResultTags(`name:"organization_transactional_manager"`),
+			fx.As(new(organization.Manager)),
+		),
+		fx.Annotate(
+			organization.NewCacheInvalidatingManager,
+			fx.ParamTags(`name:"organization_transactional_manager"`),
+			fx.ResultTags(`name:"organization_cache_invalidating_manager"`),
+			fx.As(new(organization.Manager)),
+		),
+		fx.Annotate(
+			organization.NewAuthorizedManager,
+			fx.ParamTags(`name:"organization_cache_invalidating_manager"`),
 			fx.As(new(organization.Manager)),
 		),
 		fx.Annotate(
 			organization.NewLocalFetcher,
+			fx.ResultTags(`name:"organization_local_fetcher"`),
+			fx.As(new(organization.Fetcher)),
+		),
+		organization.NewCacheConfig,
+		fx.Annotate(
+			organization.NewCachedFetcher,
+			fx.ParamTags(``, `name:"organization_local_fetcher"`),
+		),
+		fx.Annotate(
+			newCachedFetcher,
+			fx.ResultTags(`name:"organization_cached_fetcher"`),
+		),
+		fx.Annotate(
+			organization.NewAuthorizedFetcher,
+			fx.ParamTags(`name:"organization_cached_fetcher"`),
 			fx.As(new(organization.Fetcher)),
 		),
 		fx.Annotate(
 			organization.NewLocalLister,
+			fx.ResultTags(`name:"organization_local_lister"`),
 			fx.As(new(organization.Lister)),
 		),
+		fx.Annotate(
+			organization.NewAuthorizedLister,
+			fx.ParamTags(`name:"organization_local_lister"`),
+			fx.As(new(organization.Lister)),
+		),
+		fx.Annotate(
+			organization.NewLocalSearcher,
+			fx.ResultTags(`name:"organization_local_searcher"`),
+			fx.As(new(organization.Searcher)),
+		),
+		fx.Annotate(
+			organization.NewAuthorizedSearcher,
+			fx.ParamTags(`name:"organization_local_searcher"`),
+			fx.As(new(organization.Searcher)),
+		),
+		fx.Annotate(
+			organization.NewLocalHistoryLister,
+			fx.ResultTags(`name:"organization_local_history_lister"`),
+			fx.As(new(organization.HistoryLister)),
+		),
+		fx.Annotate(
+			organization.NewAuthorizedHistoryLister,
+			fx.ParamTags(`name:"organization_local_history_lister"`),
+			fx.As(new(organization.HistoryLister)),
+		),
+		authzfx.AsPolicy(organization.DefaultPolicy),
 		httpfx.AsController(organization.NewControllerHTTP),
+		openapifx.AsDescriber(organization.NewControllerHTTP),
+		grpcserverfx.AsController(organization.NewControllerGRPC),
 		kafkafx.AsController(organization.NewControllerKafka),
+		organization.NewCacheReaderKafka,
 	),
+	fx.Invoke(startCacheReader),
 )
+
+// newCachedFetcher exposes the [organization.CachedFetcher] as the [organization.Fetcher] to authorize, as
+// [organization.CacheReaderKafka] requires the concrete type to invalidate it.
+func newCachedFetcher(f organization.CachedFetcher) organization.Fetcher {
+	return f
+}
+
+// startCacheReader runs the [organization.CacheReaderKafka] in background during the application lifetime.
+func startCacheReader(lc fx.Lifecycle, reader organization.CacheReaderKafka) {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				reader.Run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancelFunc()
+			defer reader.Close()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
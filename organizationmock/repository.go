@@ -71,18 +71,48 @@ func (mr *MockRepositoryMockRecorder) DeleteByKey(ctx, key any) *gomock.Call {
 }
 
 // ExistsByName mocks base method.
-func (m *MockRepository) ExistsByName(ctx context.Context, name string) (bool, error) {
+func (m *MockRepository) ExistsByName(ctx context.Context, name, excludeKey string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExistsByName", ctx, name)
+	ret := m.ctrl.Call(m, "ExistsByName", ctx, name, excludeKey)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExistsByName indicates an expected call of ExistsByName.
-func (mr *MockRepositoryMockRecorder) ExistsByName(ctx, name any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) ExistsByName(ctx, name, excludeKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByName", reflect.TypeOf((*MockRepository)(nil).ExistsByName), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByName", reflect.TypeOf((*MockRepository)(nil).ExistsByName), ctx, name, excludeKey)
+}
+
+// ExistsBySlug mocks base method.
+func (m *MockRepository) ExistsBySlug(ctx context.Context, slug, excludeKey string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsBySlug", ctx, slug, excludeKey)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsBySlug indicates an expected call of ExistsBySlug.
+func (mr *MockRepositoryMockRecorder) ExistsBySlug(ctx, slug, excludeKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsBySlug", reflect.TypeOf((*MockRepository)(nil).ExistsBySlug), ctx, slug, excludeKey)
+}
+
+// FindAncestors mocks base method.
+func (m *MockRepository) FindAncestors(ctx context.Context, key string) ([]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAncestors", ctx, key)
+	ret0, _ := ret[0].([]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAncestors indicates an expected call of FindAncestors.
+func (mr *MockRepositoryMockRecorder) FindAncestors(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAncestors", reflect.TypeOf((*MockRepository)(nil).FindAncestors), ctx, key)
 }
 
 // FindByKey mocks base method.
@@ -100,6 +130,64 @@ func (mr *MockRepositoryMockRecorder) FindByKey(ctx, key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockRepository)(nil).FindByKey), ctx, key)
 }
 
+// FindDescendants mocks base method.
+func (m *MockRepository) FindDescendants(ctx context.Context, key string) ([]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDescendants", ctx, key)
+	ret0, _ := ret[0].([]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDescendants indicates an expected call of FindDescendants.
+func (mr *MockRepositoryMockRecorder) FindDescendants(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDescendants", reflect.TypeOf((*MockRepository)(nil).FindDescendants), ctx, key)
+}
+
+// LockByKey mocks base method.
+func (m *MockRepository) LockByKey(ctx context.Context, key string) (*organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByKey", ctx, key)
+	ret0, _ := ret[0].(*organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByKey indicates an expected call of LockByKey.
+func (mr *MockRepositoryMockRecorder) LockByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByKey", reflect.TypeOf((*MockRepository)(nil).LockByKey), ctx, key)
+}
+
+// LockHierarchy mocks base method.
+func (m *MockRepository) LockHierarchy(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockHierarchy", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockHierarchy indicates an expected call of LockHierarchy.
+func (mr *MockRepositoryMockRecorder) LockHierarchy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockHierarchy", reflect.TypeOf((*MockRepository)(nil).LockHierarchy), ctx)
+}
+
+// PurgeByKey mocks base method.
+func (m *MockRepository) PurgeByKey(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByKey indicates an expected call of PurgeByKey.
+func (mr *MockRepositoryMockRecorder) PurgeByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByKey", reflect.TypeOf((*MockRepository)(nil).PurgeByKey), ctx, key)
+}
+
 // Save mocks base method.
 func (m *MockRepository) Save(ctx context.Context, entity organization.Organization) error {
 	m.ctrl.T.Helper()
@@ -158,6 +246,21 @@ func (mr *MockReadRepositoryMockRecorder) FindAll(ctx any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReadRepository)(nil).FindAll), varargs...)
 }
 
+// FindAncestors mocks base method.
+func (m *MockReadRepository) FindAncestors(ctx context.Context, key string) ([]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAncestors", ctx, key)
+	ret0, _ := ret[0].([]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAncestors indicates an expected call of FindAncestors.
+func (mr *MockReadRepositoryMockRecorder) FindAncestors(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAncestors", reflect.TypeOf((*MockReadRepository)(nil).FindAncestors), ctx, key)
+}
+
 // FindByKey mocks base method.
 func (m *MockReadRepository) FindByKey(ctx context.Context, key string) (*organization.Organization, error) {
 	m.ctrl.T.Helper()
@@ -172,3 +275,189 @@ func (mr *MockReadRepositoryMockRecorder) FindByKey(ctx, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockReadRepository)(nil).FindByKey), ctx, key)
 }
+
+// FindBySlug mocks base method.
+func (m *MockReadRepository) FindBySlug(ctx context.Context, slug string) (*organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySlug", ctx, slug)
+	ret0, _ := ret[0].(*organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySlug indicates an expected call of FindBySlug.
+func (mr *MockReadRepositoryMockRecorder) FindBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySlug", reflect.TypeOf((*MockReadRepository)(nil).FindBySlug), ctx, slug)
+}
+
+// FindDescendants mocks base method.
+func (m *MockReadRepository) FindDescendants(ctx context.Context, key string) ([]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDescendants", ctx, key)
+	ret0, _ := ret[0].([]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDescendants indicates an expected call of FindDescendants.
+func (mr *MockReadRepositoryMockRecorder) FindDescendants(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDescendants", reflect.TypeOf((*MockReadRepository)(nil).FindDescendants), ctx, key)
+}
+
+// MockHierarchyReadRepository is a mock of HierarchyReadRepository interface.
+type MockHierarchyReadRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHierarchyReadRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHierarchyReadRepositoryMockRecorder is the mock recorder for MockHierarchyReadRepository.
+type MockHierarchyReadRepositoryMockRecorder struct {
+	mock *MockHierarchyReadRepository
+}
+
+// NewMockHierarchyReadRepository creates a new mock instance.
+func NewMockHierarchyReadRepository(ctrl *gomock.Controller) *MockHierarchyReadRepository {
+	mock := &MockHierarchyReadRepository{ctrl: ctrl}
+	mock.recorder = &MockHierarchyReadRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHierarchyReadRepository) EXPECT() *MockHierarchyReadRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAncestors mocks base method.
+func (m *MockHierarchyReadRepository) FindAncestors(ctx context.Context, key string) ([]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAncestors", ctx, key)
+	ret0, _ := ret[0].([]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAncestors indicates an expected call of FindAncestors.
+func (mr *MockHierarchyReadRepositoryMockRecorder) FindAncestors(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAncestors", reflect.TypeOf((*MockHierarchyReadRepository)(nil).FindAncestors), ctx, key)
+}
+
+// FindDescendants mocks base method.
+func (m *MockHierarchyReadRepository) FindDescendants(ctx context.Context, key string) ([]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDescendants", ctx, key)
+	ret0, _ := ret[0].([]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDescendants indicates an expected call of FindDescendants.
+func (mr *MockHierarchyReadRepositoryMockRecorder) FindDescendants(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDescendants", reflect.TypeOf((*MockHierarchyReadRepository)(nil).FindDescendants), ctx, key)
+}
+
+// MockSearchRepository is a mock of SearchRepository interface.
+type MockSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSearchRepositoryMockRecorder is the mock recorder for MockSearchRepository.
+type MockSearchRepositoryMockRecorder struct {
+	mock *MockSearchRepository
+}
+
+// NewMockSearchRepository creates a new mock instance.
+func NewMockSearchRepository(ctrl *gomock.Controller) *MockSearchRepository {
+	mock := &MockSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchRepository) EXPECT() *MockSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchRepository) Search(ctx context.Context, query string, opts ...organization.SearchOption) (*paging.Page[organization.SearchResult], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, query}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Search", varargs...)
+	ret0, _ := ret[0].(*paging.Page[organization.SearchResult])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchRepositoryMockRecorder) Search(ctx, query any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, query}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchRepository)(nil).Search), varargs...)
+}
+
+// MockHistoryRepository is a mock of HistoryRepository interface.
+type MockHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryRepositoryMockRecorder is the mock recorder for MockHistoryRepository.
+type MockHistoryRepositoryMockRecorder struct {
+	mock *MockHistoryRepository
+}
+
+// NewMockHistoryRepository creates a new mock instance.
+func NewMockHistoryRepository(ctrl *gomock.Controller) *MockHistoryRepository {
+	mock := &MockHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryRepository) EXPECT() *MockHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockHistoryRepository) Append(ctx context.Context, record organization.HistoryRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockHistoryRepositoryMockRecorder) Append(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockHistoryRepository)(nil).Append), ctx, record)
+}
+
+// FindAll mocks base method.
+func (m *MockHistoryRepository) FindAll(ctx context.Context, organizationID string, opts ...organization.HistoryOption) (*paging.Page[organization.HistoryRecord], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, organizationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAll", varargs...)
+	ret0, _ := ret[0].(*paging.Page[organization.HistoryRecord])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockHistoryRepositoryMockRecorder) FindAll(ctx, organizationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, organizationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockHistoryRepository)(nil).FindAll), varargs...)
+}
@@ -42,18 +42,38 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// Archive mocks base method.
+func (m *MockManager) Archive(ctx context.Context, id string, reason organization.StatusReason) (organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, id, reason)
+	ret0, _ := ret[0].(organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockManagerMockRecorder) Archive(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockManager)(nil).Archive), ctx, id, reason)
+}
+
 // DeleteByID mocks base method.
-func (m *MockManager) DeleteByID(ctx context.Context, id string) error {
+func (m *MockManager) DeleteByID(ctx context.Context, id string, opts ...organization.DeleteOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", ctx, id)
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteByID", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByID indicates an expected call of DeleteByID.
-func (mr *MockManagerMockRecorder) DeleteByID(ctx, id any) *gomock.Call {
+func (mr *MockManagerMockRecorder) DeleteByID(ctx, id any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockManager)(nil).DeleteByID), ctx, id)
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockManager)(nil).DeleteByID), varargs...)
 }
 
 // ModifyByID mocks base method.
@@ -76,6 +96,50 @@ func (mr *MockManagerMockRecorder) ModifyByID(ctx, id any, opts ...any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyByID", reflect.TypeOf((*MockManager)(nil).ModifyByID), varargs...)
 }
 
+// MoveUnder mocks base method.
+func (m *MockManager) MoveUnder(ctx context.Context, id, parentID string) (organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveUnder", ctx, id, parentID)
+	ret0, _ := ret[0].(organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveUnder indicates an expected call of MoveUnder.
+func (mr *MockManagerMockRecorder) MoveUnder(ctx, id, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveUnder", reflect.TypeOf((*MockManager)(nil).MoveUnder), ctx, id, parentID)
+}
+
+// PurgeByID mocks base method.
+func (m *MockManager) PurgeByID(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByID indicates an expected call of PurgeByID.
+func (mr *MockManagerMockRecorder) PurgeByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByID", reflect.TypeOf((*MockManager)(nil).PurgeByID), ctx, id)
+}
+
+// Reactivate mocks base method.
+func (m *MockManager) Reactivate(ctx context.Context, id string, reason organization.StatusReason) (organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", ctx, id, reason)
+	ret0, _ := ret[0].(organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reactivate indicates an expected call of Reactivate.
+func (mr *MockManagerMockRecorder) Reactivate(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockManager)(nil).Reactivate), ctx, id, reason)
+}
+
 // Register mocks base method.
 func (m *MockManager) Register(ctx context.Context, args organization.RegisterArguments) (organization.Organization, error) {
 	m.ctrl.T.Helper()
@@ -91,6 +155,36 @@ func (mr *MockManagerMockRecorder) Register(ctx, args any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockManager)(nil).Register), ctx, args)
 }
 
+// RestoreByID mocks base method.
+func (m *MockManager) RestoreByID(ctx context.Context, id string) (organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByID", ctx, id)
+	ret0, _ := ret[0].(organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByID indicates an expected call of RestoreByID.
+func (mr *MockManagerMockRecorder) RestoreByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByID", reflect.TypeOf((*MockManager)(nil).RestoreByID), ctx, id)
+}
+
+// Suspend mocks base method.
+func (m *MockManager) Suspend(ctx context.Context, id string, reason organization.StatusReason) (organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, id, reason)
+	ret0, _ := ret[0].(organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockManagerMockRecorder) Suspend(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockManager)(nil).Suspend), ctx, id, reason)
+}
+
 // MockFetcher is a mock of Fetcher interface.
 type MockFetcher struct {
 	ctrl     *gomock.Controller
@@ -116,18 +210,43 @@ func (m *MockFetcher) EXPECT() *MockFetcherMockRecorder {
 }
 
 // GetByID mocks base method.
-func (m *MockFetcher) GetByID(ctx context.Context, id string) (organization.Organization, error) {
+func (m *MockFetcher) GetByID(ctx context.Context, id string, opts ...organization.FetchOption) (organization.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetByID", varargs...)
 	ret0, _ := ret[0].(organization.Organization)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByID indicates an expected call of GetByID.
-func (mr *MockFetcherMockRecorder) GetByID(ctx, id any) *gomock.Call {
+func (mr *MockFetcherMockRecorder) GetByID(ctx, id any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFetcher)(nil).GetByID), ctx, id)
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFetcher)(nil).GetByID), varargs...)
+}
+
+// GetBySlug mocks base method.
+func (m *MockFetcher) GetBySlug(ctx context.Context, slug string, opts ...organization.FetchOption) (organization.Organization, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, slug}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBySlug", varargs...)
+	ret0, _ := ret[0].(organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockFetcherMockRecorder) GetBySlug(ctx, slug any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, slug}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockFetcher)(nil).GetBySlug), varargs...)
 }
 
 // MockLister is a mock of Lister interface.
@@ -173,3 +292,62 @@ func (mr *MockListerMockRecorder) List(ctx any, opts ...any) *gomock.Call {
 	varargs := append([]any{ctx}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLister)(nil).List), varargs...)
 }
+
+// ListAncestors mocks base method.
+func (m *MockLister) ListAncestors(ctx context.Context, id string) ([]organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAncestors", ctx, id)
+	ret0, _ := ret[0].([]organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAncestors indicates an expected call of ListAncestors.
+func (mr *MockListerMockRecorder) ListAncestors(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAncestors", reflect.TypeOf((*MockLister)(nil).ListAncestors), ctx, id)
+}
+
+// MockSearcher is a mock of Searcher interface.
+type MockSearcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearcherMockRecorder
+	isgomock struct{}
+}
+
+// MockSearcherMockRecorder is the mock recorder for MockSearcher.
+type MockSearcherMockRecorder struct {
+	mock *MockSearcher
+}
+
+// NewMockSearcher creates a new mock instance.
+func NewMockSearcher(ctrl *gomock.Controller) *MockSearcher {
+	mock := &MockSearcher{ctrl: ctrl}
+	mock.recorder = &MockSearcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearcher) EXPECT() *MockSearcherMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearcher) Search(ctx context.Context, query string, opts ...organization.SearchOption) (*paging.Page[organization.SearchResult], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, query}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Search", varargs...)
+	ret0, _ := ret[0].(*paging.Page[organization.SearchResult])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearcherMockRecorder) Search(ctx, query any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, query}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearcher)(nil).Search), varargs...)
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization/service_audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=organization/service_audit.go -destination=organizationmock/service_audit.go -package=organizationmock
+//
+
+// Package organizationmock is a generated GoMock package.
+package organizationmock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	paging "github.com/hadroncorp/geck/persistence/paging"
+	organization "github.com/hadroncorp/service-template/organization"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHistoryLister is a mock of HistoryLister interface.
+type MockHistoryLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryListerMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryListerMockRecorder is the mock recorder for MockHistoryLister.
+type MockHistoryListerMockRecorder struct {
+	mock *MockHistoryLister
+}
+
+// NewMockHistoryLister creates a new mock instance.
+func NewMockHistoryLister(ctrl *gomock.Controller) *MockHistoryLister {
+	mock := &MockHistoryLister{ctrl: ctrl}
+	mock.recorder = &MockHistoryListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryLister) EXPECT() *MockHistoryListerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockHistoryLister) List(ctx context.Context, organizationID string, opts ...organization.HistoryOption) (*paging.Page[organization.HistoryRecord], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, organizationID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].(*paging.Page[organization.HistoryRecord])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockHistoryListerMockRecorder) List(ctx, organizationID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, organizationID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockHistoryLister)(nil).List), varargs...)
+}
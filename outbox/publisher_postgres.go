@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/persistence/identifier"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+)
+
+// PostgresPublisher is an [event.Publisher] implementation writing events into the Postgres outbox table
+// (transactional outbox pattern) instead of writing them into the event infrastructure directly.
+//
+// Events are written using the transaction carried by the context (see [sqltx.Runner]), so they are only
+// stored if the entity-writing transaction gets committed. A relay process is then responsible for
+// propagating stored events into the event infrastructure (e.g. Apache Kafka).
+type PostgresPublisher struct {
+	db        *postgresgen.Queries
+	idFactory identifier.Factory
+}
+
+// compile-time assertion
+var _ event.Publisher = (*PostgresPublisher)(nil)
+
+// NewPostgresPublisher creates a new [PostgresPublisher] instance.
+func NewPostgresPublisher(db gecksql.DB, idFactory identifier.Factory) PostgresPublisher {
+	return PostgresPublisher{
+		db:        postgresgen.New(sqltx.NewConn(db)),
+		idFactory: idFactory,
+	}
+}
+
+func (p PostgresPublisher) Publish(ctx context.Context, events []event.Event) error {
+	now := time.Now().UTC()
+	for _, ev := range events {
+		id, err := p.idFactory.NewID()
+		if err != nil {
+			return err
+		}
+
+		payload, err := ev.Bytes()
+		if err != nil {
+			return err
+		}
+
+		err = p.db.CreateOutboxEvent(ctx, postgresgen.CreateOutboxEventParams{
+			EventID:        id,
+			Topic:          ev.Topic().String(),
+			PartitionKey:   ev.Key(),
+			Source:         ev.Source(),
+			Subject:        ev.Subject(),
+			SchemaSource:   ev.SchemaSource(),
+			ContentType:    string(ev.BytesContentType()),
+			Payload:        payload,
+			OccurrenceTime: ev.OccurrenceTime(),
+			CreateTime:     now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/persistence/postgres/postgrestest"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/outbox"
+)
+
+type postgresPublisherIntegrationSuite struct {
+	suite.Suite
+
+	baseCtx           context.Context
+	baseCtxCancelFunc context.CancelFunc
+	dbContainer       *postgrestest.Container
+	db                gecksql.DB
+	txRunner          sqltx.Runner
+	repository        organization.Repository
+	publisher         outbox.PostgresPublisher
+}
+
+func TestPostgresPublisherIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(postgresPublisherIntegrationSuite))
+}
+
+func (s *postgresPublisherIntegrationSuite) SetupSuite() {
+	// setup context
+	const testSuiteTimeout = time.Minute
+	s.baseCtx, s.baseCtxCancelFunc = context.WithTimeout(context.Background(), testSuiteTimeout)
+	s.baseCtx = identity.WithPrincipal(s.baseCtx, identity.NewBasicPrincipal("some-user"))
+
+	// setup container
+	var err error
+	s.dbContainer, err = postgrestest.NewContainer(s.baseCtx, s.T())
+	s.Require().NoError(err)
+	db, err := postgrestest.StartContainer(s.baseCtx, s.T(), s.dbContainer, "./thirdparty/postgres/migrations")
+	s.Require().NoError(err)
+	s.db = gecksql.NewDB(db)
+
+	s.txRunner = sqltx.NewDBRunner(s.db)
+	s.repository = organization.NewPostgresRepository(s.db)
+	s.publisher = outbox.NewPostgresPublisher(s.db, identifier.FactoryKSUID{})
+}
+
+func (s *postgresPublisherIntegrationSuite) TearDownSuite() {
+	defer s.baseCtxCancelFunc()
+	shutdownCtx, cancelFunc := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFunc()
+	s.Assert().NoError(s.dbContainer.Instance.Terminate(shutdownCtx))
+}
+
+func (s *postgresPublisherIntegrationSuite) countEvents(subject string) int {
+	var count int
+	err := s.db.QueryRowContext(s.baseCtx, "SELECT COUNT(*) FROM outbox_events WHERE subject = $1", subject).
+		Scan(&count)
+	s.Require().NoError(err)
+	return count
+}
+
+func (s *postgresPublisherIntegrationSuite) TestPostgresPublisher_Publish_Committed() {
+	// arrange
+	org := organization.New(s.baseCtx, "committed-org", "committed")
+
+	// act
+	err := s.txRunner.RunInTx(s.baseCtx, func(ctx context.Context) error {
+		if err := s.repository.Save(ctx, org); err != nil {
+			return err
+		}
+		return s.publisher.Publish(ctx, org.PullEvents())
+	})
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal(1, s.countEvents("committed-org"))
+
+	var (
+		topic        string
+		partitionKey string
+		source       string
+		schemaSource string
+		contentType  string
+		payload      []byte
+	)
+	err = s.db.QueryRowContext(s.baseCtx, `SELECT topic, partition_key, source, schema_source, content_type, payload
+		FROM outbox_events WHERE subject = $1`, "committed-org").
+		Scan(&topic, &partitionKey, &source, &schemaSource, &contentType, &payload)
+	s.Require().NoError(err)
+	s.Assert().Equal(organization.TopicCreated.String(), topic)
+	s.Assert().Equal("committed-org", partitionKey)
+	s.Assert().Equal("/organizations", source)
+	s.Assert().NotEmpty(schemaSource)
+	s.Assert().NotEmpty(contentType)
+	s.Assert().NotEmpty(payload)
+}
+
+func (s *postgresPublisherIntegrationSuite) TestPostgresPublisher_Publish_RolledBack() {
+	// arrange
+	org := organization.New(s.baseCtx, "rolled-back-org", "rolled-back")
+	errUnexpected := errors.New("unexpected failure")
+
+	// act
+	err := s.txRunner.RunInTx(s.baseCtx, func(ctx context.Context) error {
+		if err := s.repository.Save(ctx, org); err != nil {
+			return err
+		}
+		if err := s.publisher.Publish(ctx, org.PullEvents()); err != nil {
+			return err
+		}
+		return errUnexpected
+	})
+
+	// assert
+	s.Assert().ErrorIs(err, errUnexpected)
+	s.Assert().Zero(s.countEvents("rolled-back-org"))
+
+	var exists bool
+	err = s.db.QueryRowContext(s.baseCtx, "SELECT EXISTS(SELECT 1 FROM organizations WHERE organization_id = $1 LIMIT 1)",
+		"rolled-back-org").Scan(&exists)
+	s.Require().NoError(err)
+	s.Assert().False(exists)
+}
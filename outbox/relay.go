@@ -0,0 +1,411 @@
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/hadroncorp/geck/event"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+)
+
+const (
+	// _relayLockID is the Postgres advisory lock key guarding event claims, so only one relay instance
+	// claims events at a time and per-key ordering is kept across instances.
+	_relayLockID int64 = 0x6f7574626f78 // "outbox"
+	// _cloudEventsSpecVersion is the CloudEvents specification version used by relayed events.
+	_cloudEventsSpecVersion = "1.0"
+	_meterName              = "github.com/hadroncorp/service-template/outbox"
+)
+
+// RelayConfig is the configuration of the [Relay].
+type RelayConfig struct {
+	// Enabled indicates whether the relay must be started along the application.
+	Enabled bool `env:"OUTBOX_RELAY_ENABLED" envDefault:"true"`
+	// PollInterval is the time to wait between polls when the outbox table has been drained.
+	PollInterval time.Duration `env:"OUTBOX_RELAY_POLL_INTERVAL" envDefault:"500ms"`
+	// BatchSize is the maximum number of events relayed by poll.
+	BatchSize int32 `env:"OUTBOX_RELAY_BATCH_SIZE" envDefault:"100"`
+	// BaseBackoff is the delay applied to an event after its first failed attempt. Delay doubles on every
+	// subsequent failure.
+	BaseBackoff time.Duration `env:"OUTBOX_RELAY_BASE_BACKOFF" envDefault:"1s"`
+	// MaxBackoff is the maximum delay applied to a failing event.
+	MaxBackoff time.Duration `env:"OUTBOX_RELAY_MAX_BACKOFF" envDefault:"5m"`
+	// ClaimTimeout is the time a relay instance owns the events it claimed. Events neither relayed nor failed
+	// within this time (e.g. the instance crashed) are claimed again.
+	ClaimTimeout time.Duration `env:"OUTBOX_RELAY_CLAIM_TIMEOUT" envDefault:"30s"`
+	// Retention is the time sent events are kept for (e.g. to troubleshoot consumers), they are purged
+	// afterward. Use zero to keep sent events forever.
+	Retention time.Duration `env:"OUTBOX_RELAY_RETENTION" envDefault:"168h"`
+	// PurgeInterval is the time to wait between purges of sent events.
+	PurgeInterval time.Duration `env:"OUTBOX_RELAY_PURGE_INTERVAL" envDefault:"1m"`
+	// PurgeBatchSize is the maximum number of sent events deleted by statement, keeping purges from locking
+	// the outbox table for long.
+	PurgeBatchSize int32 `env:"OUTBOX_RELAY_PURGE_BATCH_SIZE" envDefault:"1000"`
+}
+
+// NewRelayConfig creates a new [RelayConfig] instance from environment variables.
+func NewRelayConfig() (RelayConfig, error) {
+	return env.ParseAs[RelayConfig]()
+}
+
+// Backoff returns the delay to apply to an event before its next attempt, given the number of attempts
+// that already failed.
+func (c RelayConfig) Backoff(attempts int32) time.Duration {
+	backoff := c.BaseBackoff
+	for i := int32(0); i < attempts && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, c.MaxBackoff)
+}
+
+// Relay is the component propagating events stored by [PostgresPublisher] into Apache Kafka.
+//
+// Events are claimed in insertion order within a short transaction, written into Kafka using the event key
+// as record key and marked as sent once acknowledged. Failed events are retried using an exponential backoff;
+// events sharing the key of a failed event are held until the failed event is relayed, keeping per-key ordering.
+// Sent events are purged once their retention expires.
+type Relay struct {
+	config   RelayConfig
+	db       *postgresgen.Queries
+	txRunner sqltx.Runner
+	client   *kgo.Client
+	logger   *slog.Logger
+	metrics  relayMetrics
+}
+
+type relayMetrics struct {
+	relayed metric.Int64Counter
+	failed  metric.Int64Counter
+	purged  metric.Int64Counter
+	pending metric.Int64Gauge
+	lag     metric.Float64Gauge
+}
+
+// NewRelay creates a new [Relay] instance.
+func NewRelay(config RelayConfig, db gecksql.DB, txRunner sqltx.Runner, client *kgo.Client,
+	logger *slog.Logger) (Relay, error) {
+	metrics, err := newRelayMetrics(otel.Meter(_meterName))
+	if err != nil {
+		return Relay{}, err
+	}
+	return Relay{
+		config:   config,
+		db:       postgresgen.New(sqltx.NewConn(db)),
+		txRunner: txRunner,
+		client:   client,
+		logger:   logger,
+		metrics:  metrics,
+	}, nil
+}
+
+func newRelayMetrics(meter metric.Meter) (relayMetrics, error) {
+	relayed, err := meter.Int64Counter("outbox.relay.events.relayed",
+		metric.WithDescription("Number of outbox events written into the event infrastructure."),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		return relayMetrics{}, err
+	}
+	failed, err := meter.Int64Counter("outbox.relay.events.failed",
+		metric.WithDescription("Number of outbox event relay attempts that failed."),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		return relayMetrics{}, err
+	}
+	purged, err := meter.Int64Counter("outbox.relay.events.purged",
+		metric.WithDescription("Number of sent outbox events deleted once their retention expired."),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		return relayMetrics{}, err
+	}
+	pending, err := meter.Int64Gauge("outbox.relay.events.pending",
+		metric.WithDescription("Number of outbox events waiting to be relayed."),
+		metric.WithUnit("{event}"))
+	if err != nil {
+		return relayMetrics{}, err
+	}
+	lag, err := meter.Float64Gauge("outbox.relay.lag",
+		metric.WithDescription("Age of the oldest outbox event waiting to be relayed."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return relayMetrics{}, err
+	}
+	return relayMetrics{
+		relayed: relayed,
+		failed:  failed,
+		purged:  purged,
+		pending: pending,
+		lag:     lag,
+	}, nil
+}
+
+// Run relays outbox events and purges sent events until ctx is done.
+func (r Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+	var nextPurgeTime time.Time
+	for {
+		if now := time.Now(); r.config.Retention > 0 && !now.Before(nextPurgeTime) {
+			if _, err := r.Purge(ctx); err != nil && ctx.Err() == nil {
+				r.logger.ErrorContext(ctx, "failed to purge outbox events", slog.String("error", err.Error()))
+			}
+			nextPurgeTime = now.Add(r.config.PurgeInterval)
+		}
+
+		processed, err := r.RelayBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.ErrorContext(ctx, "failed to relay outbox events", slog.String("error", err.Error()))
+		}
+		r.recordLag(ctx)
+
+		if err == nil && processed >= int(r.config.BatchSize) {
+			// outbox table might not be drained yet, poll again right away
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayBatch relays a single batch of pending outbox events.
+//
+// It returns the number of events processed (either relayed or failed).
+func (r Relay) RelayBatch(ctx context.Context) (int, error) {
+	events, err := r.claim(ctx)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+
+	// DEV-NOTE: Kafka is written outside the claim transaction, so neither the transaction nor the advisory
+	// lock are held during the round-trip. Claimed events are kept from other instances by their next attempt
+	// time until the claim expires.
+	produceCtx, cancel := context.WithTimeout(ctx, r.config.ClaimTimeout)
+	defer cancel()
+	outcome := r.produce(produceCtx, events)
+
+	err = r.txRunner.RunInTx(ctx, func(scopedCtx context.Context) error {
+		return r.settle(scopedCtx, outcome)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	r.metrics.relayed.Add(ctx, int64(len(outcome.relayed)))
+	r.metrics.failed.Add(ctx, int64(len(outcome.failed)))
+	return len(outcome.relayed) + len(outcome.failed), nil
+}
+
+// Purge deletes the events sent before the retention period, in batches of at most PurgeBatchSize events.
+//
+// It returns the number of events deleted.
+func (r Relay) Purge(ctx context.Context) (int, error) {
+	sendTimeEnd := time.Now().UTC().Add(-r.config.Retention)
+	var purged int
+	for {
+		deleted, err := r.db.DeleteSentOutboxEvents(ctx, postgresgen.DeleteSentOutboxEventsParams{
+			SendTimeEnd: sendTimeEnd,
+			BatchSize:   r.config.PurgeBatchSize,
+		})
+		if err != nil {
+			return purged, err
+		}
+		purged += int(deleted)
+		if deleted == 0 || deleted < int64(r.config.PurgeBatchSize) {
+			r.metrics.purged.Add(ctx, int64(purged))
+			return purged, nil
+		}
+	}
+}
+
+// claim claims a batch of pending outbox events, sorted in insertion order.
+func (r Relay) claim(ctx context.Context) ([]postgresgen.OutboxEvent, error) {
+	var events []postgresgen.OutboxEvent
+	err := r.txRunner.RunInTx(ctx, func(scopedCtx context.Context) error {
+		acquired, err := r.db.TryLockOutboxRelay(scopedCtx, _relayLockID)
+		if err != nil || !acquired {
+			// another relay instance is claiming events
+			return err
+		}
+
+		now := time.Now().UTC()
+		events, err = r.db.ClaimPendingOutboxEvents(scopedCtx, postgresgen.ClaimPendingOutboxEventsParams{
+			Now:             now,
+			BatchSize:       r.config.BatchSize,
+			ClaimExpireTime: now.Add(r.config.ClaimTimeout),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(events, func(a, b postgresgen.OutboxEvent) int {
+		return cmp.Compare(a.SequenceID, b.SequenceID)
+	})
+	return events, nil
+}
+
+// relayOutcome is the result of producing a batch of claimed outbox events.
+type relayOutcome struct {
+	// relayed holds the events acknowledged by Kafka.
+	relayed []postgresgen.OutboxEvent
+	// failed holds the events Kafka failed to acknowledge, at most one by key.
+	failed []failedEvent
+	// held holds the events not produced as an earlier event sharing their key failed.
+	held []postgresgen.OutboxEvent
+}
+
+type failedEvent struct {
+	event postgresgen.OutboxEvent
+	err   error
+}
+
+// produce writes the given events into Kafka.
+//
+// Events are produced in waves holding the oldest unproduced event of every key, so a record is only
+// produced once the previous record sharing its key was acknowledged. Producing for a key stops at its first
+// failure.
+func (r Relay) produce(ctx context.Context, events []postgresgen.OutboxEvent) relayOutcome {
+	queues := make(map[string][]postgresgen.OutboxEvent)
+	keys := make([]string, 0)
+	for _, ev := range events {
+		if _, ok := queues[ev.PartitionKey]; !ok {
+			keys = append(keys, ev.PartitionKey)
+		}
+		queues[ev.PartitionKey] = append(queues[ev.PartitionKey], ev)
+	}
+
+	var outcome relayOutcome
+	for len(keys) > 0 {
+		records := make([]*kgo.Record, 0, len(keys))
+		eventsByRecord := make(map[*kgo.Record]postgresgen.OutboxEvent, len(keys))
+		for _, key := range keys {
+			ev := queues[key][0]
+			queues[key] = queues[key][1:]
+			record := newRecord(ev)
+			records = append(records, record)
+			eventsByRecord[record] = ev
+		}
+
+		results := r.client.ProduceSync(ctx, records...)
+		errsByRecord := make(map[*kgo.Record]error, len(results))
+		for _, result := range results {
+			errsByRecord[result.Record] = result.Err
+		}
+
+		nextKeys := keys[:0]
+		for _, record := range records {
+			ev := eventsByRecord[record]
+			if produceErr := errsByRecord[record]; produceErr != nil {
+				outcome.failed = append(outcome.failed, failedEvent{event: ev, err: produceErr})
+				outcome.held = append(outcome.held, queues[ev.PartitionKey]...)
+				continue
+			}
+			outcome.relayed = append(outcome.relayed, ev)
+			if len(queues[ev.PartitionKey]) > 0 {
+				nextKeys = append(nextKeys, ev.PartitionKey)
+			}
+		}
+		keys = nextKeys
+	}
+	return outcome
+}
+
+// settle stores the given outcome, ending the claim of its events.
+func (r Relay) settle(ctx context.Context, outcome relayOutcome) error {
+	now := time.Now().UTC()
+	for _, ev := range outcome.relayed {
+		err := r.db.MarkOutboxEventSent(ctx, postgresgen.MarkOutboxEventSentParams{
+			SequenceID: ev.SequenceID,
+			SendTime: sql.NullTime{
+				Time:  now,
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, failed := range outcome.failed {
+		ev := failed.event
+		r.logger.WarnContext(ctx, "failed to relay outbox event",
+			slog.String("event_id", ev.EventID),
+			slog.String("topic", ev.Topic),
+			slog.String("key", ev.PartitionKey),
+			slog.Int("attempts", int(ev.Attempts)+1),
+			slog.String("error", failed.err.Error()),
+		)
+		err := r.db.MarkOutboxEventFailed(ctx, postgresgen.MarkOutboxEventFailedParams{
+			SequenceID:      ev.SequenceID,
+			NextAttemptTime: now.Add(r.config.Backoff(ev.Attempts)),
+			LastError: sql.NullString{
+				String: failed.err.Error(),
+				Valid:  true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// held events were never produced, they do not count an attempt and wait for the failed event instead
+	for _, ev := range outcome.held {
+		err := r.db.ReleaseOutboxEvent(ctx, postgresgen.ReleaseOutboxEventParams{
+			SequenceID:      ev.SequenceID,
+			NextAttemptTime: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r Relay) recordLag(ctx context.Context) {
+	lag, err := r.db.GetOutboxLag(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			r.logger.WarnContext(ctx, "failed to compute outbox lag", slog.String("error", err.Error()))
+		}
+		return
+	}
+	r.metrics.pending.Record(ctx, lag.PendingCount)
+	r.metrics.lag.Record(ctx, lag.LagSeconds)
+}
+
+func newRecord(ev postgresgen.OutboxEvent) *kgo.Record {
+	return &kgo.Record{
+		Topic: ev.Topic,
+		Key:   []byte(ev.PartitionKey),
+		Value: ev.Payload,
+		Headers: []kgo.RecordHeader{
+			{Key: event.HeaderEventID, Value: []byte(ev.EventID)},
+			{Key: event.HeaderSource, Value: []byte(ev.Source)},
+			{Key: event.HeaderSpecVersion, Value: []byte(_cloudEventsSpecVersion)},
+			{Key: event.HeaderEventType, Value: []byte(ev.Topic)},
+			{Key: event.HeaderDataContentType, Value: []byte(ev.ContentType)},
+			{Key: event.HeaderDataSchema, Value: []byte(ev.SchemaSource)},
+			{Key: event.HeaderSubject, Value: []byte(ev.Subject)},
+			{Key: event.HeaderEventTime, Value: []byte(ev.OccurrenceTime.UTC().Format(time.RFC3339Nano))},
+		},
+	}
+}
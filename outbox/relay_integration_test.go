@@ -0,0 +1,262 @@
+//go:build integration
+
+package outbox_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/persistence/identifier"
+	"github.com/hadroncorp/geck/persistence/postgres/postgrestest"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/hadroncorp/geck/transport/stream/kafka/kafkatest"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/proto"
+
+	"event-schema-registry/iampb"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/outbox"
+)
+
+// DEV-NOTE: These suites use actual infrastructure components (e.g. databases, message brokers, etc., NO MOCKS).
+
+type relayIntegrationSuite struct {
+	suite.Suite
+
+	baseCtx             context.Context
+	baseCtxCancelFunc   context.CancelFunc
+	psqlContainer       *postgrestest.Container
+	db                  gecksql.DB
+	kafkaContainer      *kafkatest.Container
+	kafkaProducerClient *kgo.Client
+
+	txRunner  sqltx.Runner
+	publisher outbox.PostgresPublisher
+	relay     outbox.Relay
+}
+
+func TestRelayIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(relayIntegrationSuite))
+}
+
+func (s *relayIntegrationSuite) SetupSuite() {
+	const testSuiteTimeout = time.Minute * 2
+	s.baseCtx, s.baseCtxCancelFunc = context.WithTimeout(context.Background(), testSuiteTimeout)
+	s.baseCtx = identity.WithPrincipal(s.baseCtx, identity.NewBasicPrincipal("some-user"))
+
+	// Start postgres
+	var err error
+	s.psqlContainer, err = postgrestest.NewContainer(s.baseCtx, s.T())
+	s.Require().NoError(err)
+	db, err := postgrestest.StartContainer(s.baseCtx, s.T(), s.psqlContainer, "./thirdparty/postgres/migrations")
+	s.Require().NoError(err)
+	s.db = gecksql.NewDB(db)
+
+	// Start Kafka
+	s.kafkaContainer, err = kafkatest.NewContainer(s.baseCtx, s.T())
+	s.Require().NoError(err)
+	s.Require().NotZero(s.kafkaContainer.SeedBrokerAddrs)
+	s.Require().NoError(s.kafkaContainer.Instance.Start(s.baseCtx))
+	s.kafkaProducerClient, err = kgo.NewClient(
+		kgo.SeedBrokers(s.kafkaContainer.SeedBrokerAddrs...),
+		kgo.AllowAutoTopicCreation(),
+	)
+	s.Require().NoError(err)
+
+	// Start relay
+	s.txRunner = sqltx.NewDBRunner(s.db)
+	s.publisher = outbox.NewPostgresPublisher(s.db, identifier.FactoryKSUID{})
+	s.relay, err = outbox.NewRelay(outbox.RelayConfig{
+		Enabled:      true,
+		PollInterval: time.Millisecond * 100,
+		BatchSize:    10,
+		BaseBackoff:  time.Second,
+		MaxBackoff:   time.Second * 10,
+		ClaimTimeout: time.Second * 10,
+	}, s.db, s.txRunner, s.kafkaProducerClient, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	s.Require().NoError(err)
+}
+
+func (s *relayIntegrationSuite) TearDownSuite() {
+	defer s.baseCtxCancelFunc()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancelFunc()
+	s.kafkaProducerClient.Close()
+	s.Assert().NoError(s.psqlContainer.Instance.Terminate(ctx))
+	s.Assert().NoError(s.kafkaContainer.Instance.Terminate(ctx))
+}
+
+func (s *relayIntegrationSuite) publish(events []event.Event) {
+	err := s.txRunner.RunInTx(s.baseCtx, func(ctx context.Context) error {
+		return s.publisher.Publish(ctx, events)
+	})
+	s.Require().NoError(err)
+}
+
+func (s *relayIntegrationSuite) consume(ctx context.Context, topic string, count int) []*kgo.Record {
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(s.kafkaContainer.SeedBrokerAddrs...),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	s.Require().NoError(err)
+	defer client.Close()
+
+	records := make([]*kgo.Record, 0, count)
+	for len(records) < count {
+		fetches := client.PollFetches(ctx)
+		s.Require().NoError(ctx.Err())
+		fetches.EachRecord(func(record *kgo.Record) {
+			records = append(records, record)
+		})
+	}
+	return records
+}
+
+func (s *relayIntegrationSuite) TestRelay_RelayBatch() {
+	// arrange
+	ctx, cancelFunc := context.WithTimeout(s.baseCtx, time.Second*30)
+	defer cancelFunc()
+	org := organization.New(ctx, "relay-org", "relay")
+	_ = org.Update(ctx, organization.WithUpdatedName(lo.ToPtr("relay-updated")))
+	_ = org.Update(ctx, organization.WithUpdatedName(lo.ToPtr("relay-updated-twice")))
+	s.publish(org.PullEvents())
+
+	// act
+	processed, err := s.relay.RelayBatch(ctx)
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal(3, processed)
+
+	var pending int
+	err = s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM outbox_events WHERE subject = $1 AND send_time IS NULL",
+		"relay-org").Scan(&pending)
+	s.Require().NoError(err)
+	s.Assert().Zero(pending)
+
+	created := s.consume(ctx, organization.TopicCreated.String(), 1)
+	s.Assert().Equal("relay-org", string(created[0].Key))
+	headers := lo.SliceToMap(created[0].Headers, func(h kgo.RecordHeader) (string, string) {
+		return h.Key, string(h.Value)
+	})
+	s.Assert().NotEmpty(headers[event.HeaderEventID])
+	s.Assert().Equal("/organizations", headers[event.HeaderSource])
+	s.Assert().Equal("relay-org", headers[event.HeaderSubject])
+	s.Assert().Equal(organization.TopicCreated.String(), headers[event.HeaderEventType])
+	s.Assert().NotEmpty(headers[event.HeaderDataSchema])
+	s.Assert().NotEmpty(headers[event.HeaderDataContentType])
+	s.Assert().NotEmpty(headers[event.HeaderEventTime])
+
+	// per-key ordering
+	updated := s.consume(ctx, organization.TopicUpdated.String(), 2)
+	names := lo.Map(updated, func(record *kgo.Record, _ int) string {
+		ev := &iampb.OrganizationUpdatedEvent{}
+		s.Require().NoError(proto.Unmarshal(record.Value, ev))
+		return ev.GetName()
+	})
+	s.Assert().Equal([]string{"relay-updated", "relay-updated-twice"}, names)
+
+	// drained
+	processed, err = s.relay.RelayBatch(ctx)
+	s.Require().NoError(err)
+	s.Assert().Zero(processed)
+}
+
+func (s *relayIntegrationSuite) TestRelay_RelayBatch_Failed_Key() {
+	// arrange
+	ctx, cancelFunc := context.WithTimeout(s.baseCtx, time.Second*30)
+	defer cancelFunc()
+	// an invalid topic name makes Kafka reject the first event of the key
+	_, err := s.db.ExecContext(ctx, `INSERT INTO outbox_events (event_id, topic, partition_key, source, subject,
+		schema_source, content_type, payload, occurrence_time, create_time)
+		VALUES
+			('failed-1', 'invalid topic!', 'failed-key', '/test', 'failed-key', '', 'application/protobuf', '', now(), now()),
+			('failed-2', 'relay-failed-key', 'failed-key', '/test', 'failed-key', '', 'application/protobuf', '', now(), now()),
+			('other-1', 'relay-other-key', 'other-key', '/test', 'other-key', '', 'application/protobuf', '', now(), now())`)
+	s.Require().NoError(err)
+
+	// act
+	processed, err := s.relay.RelayBatch(ctx)
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal(2, processed)
+
+	type row struct {
+		sent     bool
+		attempts int
+		failed   bool
+	}
+	rows := make(map[string]row, 3)
+	dbRows, err := s.db.QueryContext(ctx, `SELECT event_id, send_time IS NOT NULL, attempts, last_error IS NOT NULL
+		FROM outbox_events WHERE partition_key IN ('failed-key', 'other-key')`)
+	s.Require().NoError(err)
+	defer dbRows.Close()
+	for dbRows.Next() {
+		var (
+			id string
+			r  row
+		)
+		s.Require().NoError(dbRows.Scan(&id, &r.sent, &r.attempts, &r.failed))
+		rows[id] = r
+	}
+	s.Require().NoError(dbRows.Err())
+	s.Assert().Equal(row{sent: false, attempts: 1, failed: true}, rows["failed-1"])
+	// held behind the failed event, never produced
+	s.Assert().Equal(row{sent: false, attempts: 0, failed: false}, rows["failed-2"])
+	s.Assert().Equal(row{sent: true, attempts: 0, failed: false}, rows["other-1"])
+
+	// held event is not claimed until the failed event is relayed
+	processed, err = s.relay.RelayBatch(ctx)
+	s.Require().NoError(err)
+	s.Assert().Zero(processed)
+}
+
+func (s *relayIntegrationSuite) TestRelay_Purge() {
+	// arrange
+	ctx, cancelFunc := context.WithTimeout(s.baseCtx, time.Second*30)
+	defer cancelFunc()
+	relay, err := outbox.NewRelay(outbox.RelayConfig{
+		Retention:      time.Hour,
+		PurgeBatchSize: 2,
+	}, s.db, s.txRunner, s.kafkaProducerClient, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	s.Require().NoError(err)
+	_, err = s.db.ExecContext(ctx, `INSERT INTO outbox_events (event_id, topic, partition_key, source, subject,
+		schema_source, content_type, payload, occurrence_time, create_time, send_time)
+		VALUES
+			('expired-1', 'relay-purge', 'purge-key', '/test', 'purge-key', '', 'application/protobuf', '', now(), now(), now() - interval '3 hours'),
+			('expired-2', 'relay-purge', 'purge-key', '/test', 'purge-key', '', 'application/protobuf', '', now(), now(), now() - interval '2 hours'),
+			('expired-3', 'relay-purge', 'purge-key', '/test', 'purge-key', '', 'application/protobuf', '', now(), now(), now() - interval '2 hours'),
+			('retained', 'relay-purge', 'purge-key', '/test', 'purge-key', '', 'application/protobuf', '', now(), now(), now()),
+			('pending', 'relay-purge', 'purge-key', '/test', 'purge-key', '', 'application/protobuf', '', now(), now(), NULL)`)
+	s.Require().NoError(err)
+
+	// act
+	purged, err := relay.Purge(ctx)
+
+	// assert
+	s.Require().NoError(err)
+	s.Assert().Equal(3, purged)
+
+	var remaining []string
+	dbRows, err := s.db.QueryContext(ctx,
+		`SELECT event_id FROM outbox_events WHERE partition_key = 'purge-key' ORDER BY event_id`)
+	s.Require().NoError(err)
+	defer dbRows.Close()
+	for dbRows.Next() {
+		var id string
+		s.Require().NoError(dbRows.Scan(&id))
+		remaining = append(remaining, id)
+	}
+	s.Require().NoError(dbRows.Err())
+	s.Assert().Equal([]string{"pending", "retained"}, remaining)
+}
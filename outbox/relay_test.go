@@ -0,0 +1,48 @@
+package outbox_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hadroncorp/service-template/outbox"
+)
+
+func TestRelayConfig_Backoff(t *testing.T) {
+	config := outbox.RelayConfig{
+		BaseBackoff: time.Second,
+		MaxBackoff:  time.Second * 10,
+	}
+	tests := []struct {
+		name       string
+		inAttempts int32
+		exp        time.Duration
+	}{
+		{
+			name:       "first failure",
+			inAttempts: 0,
+			exp:        time.Second,
+		},
+		{
+			name:       "exponential",
+			inAttempts: 3,
+			exp:        time.Second * 8,
+		},
+		{
+			name:       "capped",
+			inAttempts: 4,
+			exp:        time.Second * 10,
+		},
+		{
+			name:       "capped on huge attempts",
+			inAttempts: 1000,
+			exp:        time.Second * 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, config.Backoff(tt.inAttempts))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package outboxfx
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/event"
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/outbox"
+)
+
+// PublisherName is the name of the transactional outbox [event.Publisher] in the dependency graph.
+//
+// Use it as a parameter tag (e.g. `name:"outbox_publisher"`) to request the outbox publisher.
+const PublisherName = "outbox_publisher"
+
+var Module = fx.Module("hadron/iam/outbox",
+	fx.Provide(
+		fx.Annotate(
+			sqltx.NewDBRunner,
+			fx.As(new(sqltx.Runner)),
+		),
+		fx.Annotate(
+			outbox.NewPostgresPublisher,
+			fx.ResultTags(`name:"`+PublisherName+`"`),
+			fx.As(new(event.Publisher)),
+		),
+		outbox.NewRelayConfig,
+		outbox.NewRelay,
+	),
+	fx.Invoke(startRelay),
+)
+
+// startRelay runs the [outbox.Relay] in background during the application lifetime.
+func startRelay(lc fx.Lifecycle, config outbox.RelayConfig, relay outbox.Relay) {
+	if !config.Enabled {
+		return
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			go func() {
+				defer close(done)
+				relay.Run(ctx)
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancelFunc()
+			select {
+			case <-done:
+				return nil
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+		},
+	})
+}
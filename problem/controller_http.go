@@ -0,0 +1,29 @@
+package problem
+
+import (
+	geckhttp "github.com/hadroncorp/geck/transport/http"
+	"github.com/labstack/echo/v4"
+)
+
+// ControllerHTTP is the HTTP controller installing [ErrorHandlerHTTP] as the error handler of the server.
+type ControllerHTTP struct {
+	handler ErrorHandlerHTTP
+}
+
+// compile-time assertion
+var _ geckhttp.Controller = (*ControllerHTTP)(nil)
+
+// NewControllerHTTP creates a new instance of [ControllerHTTP].
+func NewControllerHTTP(handler ErrorHandlerHTTP) ControllerHTTP {
+	return ControllerHTTP{
+		handler: handler,
+	}
+}
+
+func (c ControllerHTTP) SetEndpoints(e *echo.Echo) {
+	// DEV-NOTE: Controllers are set once the server is built, so this replaces the default error handler of echo.
+	e.HTTPErrorHandler = c.handler.Handle
+}
+
+func (c ControllerHTTP) SetVersionedEndpoints(_ *echo.Group) {
+}
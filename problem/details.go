@@ -0,0 +1,192 @@
+package problem
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/hadroncorp/geck/syserr"
+	"github.com/labstack/echo/v4"
+)
+
+// MIMEApplicationProblemJSON is the media type of problem details documents.
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// _typeBlank is the problem type used when the problem has no additional semantics than its status code
+// (RFC 7807, section 4.2). Clients must rely on [Details.Code] instead.
+const _typeBlank = "about:blank"
+
+// Code is a stable, machine-readable error code. Unlike messages, codes never change so clients may
+// branch on them.
+type Code string
+
+const (
+	CodeInvalidArgument    Code = "INVALID_ARGUMENT"
+	CodeUnauthenticated    Code = "UNAUTHENTICATED"
+	CodePermissionDenied   Code = "PERMISSION_DENIED"
+	CodeNotFound           Code = "NOT_FOUND"
+	CodeAlreadyExists      Code = "ALREADY_EXISTS"
+	CodeAborted            Code = "ABORTED"
+	CodeFailedPrecondition Code = "FAILED_PRECONDITION"
+	CodeResourceExhausted  Code = "RESOURCE_EXHAUSTED"
+	CodeUnavailable        Code = "UNAVAILABLE"
+	CodeInternal           Code = "INTERNAL"
+)
+
+// Details is a problem details document (RFC 7807) describing an error.
+type Details struct {
+	// Type is a URI reference identifying the problem type.
+	Type string `json:"type"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title"`
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+	// Detail is a human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference identifying this occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+	// Code is the machine-readable error code.
+	Code Code `json:"code"`
+	// TraceID is the identifier of the trace the failed request belongs to.
+	TraceID string `json:"trace_id,omitempty"`
+	// Violations lists the fields of the request failing validation.
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Violation is a request field failing validation.
+type Violation struct {
+	// Field is the path of the field (e.g. `name` or `address.city`).
+	Field string `json:"field"`
+	// Code is the machine-readable code of the failed rule (e.g. `required`).
+	Code string `json:"code"`
+	// Message is a human-readable description of the violation.
+	Message string `json:"message"`
+}
+
+// NewDetails translates err into a [Details] document.
+//
+// Validation errors are reported as invalid arguments listing every field violation, [echo.HTTPError]
+// keeps its status code and message and [syserr] errors are mapped into their status code counterpart.
+// Any other error is reported as internal, hiding its message from clients.
+func NewDetails(err error) Details {
+	var (
+		validationErrs validator.ValidationErrors
+		httpErr        *echo.HTTPError
+	)
+	switch {
+	case errors.As(err, &validationErrs):
+		details := newDetails(http.StatusBadRequest, CodeInvalidArgument, "request is invalid")
+		details.Violations = make([]Violation, 0, len(validationErrs))
+		for _, fieldErr := range validationErrs {
+			details.Violations = append(details.Violations, newViolation(fieldErr))
+		}
+		return details
+	case errors.As(err, &httpErr):
+		details := newDetails(httpErr.Code, newCode(httpErr.Code), fmt.Sprint(httpErr.Message))
+		if httpErr.Code >= http.StatusInternalServerError {
+			details.Detail = ""
+		}
+		return details
+	case errors.Is(err, syserr.ErrResourceNotFound):
+		return newDetails(http.StatusNotFound, CodeNotFound, err.Error())
+	case errors.Is(err, syserr.ErrResourceAlreadyExists):
+		return newDetails(http.StatusConflict, CodeAlreadyExists, err.Error())
+	case errors.Is(err, syserr.ErrResourceConflict):
+		return newDetails(http.StatusConflict, CodeAborted, err.Error())
+	case errors.Is(err, syserr.ErrForbidden):
+		return newDetails(http.StatusForbidden, CodePermissionDenied, err.Error())
+	default:
+		return newDetails(http.StatusInternalServerError, CodeInternal, "")
+	}
+}
+
+func newDetails(status int, code Code, detail string) Details {
+	return Details{
+		Type:   _typeBlank,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// newCode returns the [Code] matching an HTTP status code.
+func newCode(status int) Code {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusRequestEntityTooLarge,
+		http.StatusUnsupportedMediaType:
+		return CodeInvalidArgument
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodePermissionDenied
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeAborted
+	case http.StatusPreconditionFailed, http.StatusPreconditionRequired:
+		return CodeFailedPrecondition
+	case http.StatusTooManyRequests:
+		return CodeResourceExhausted
+	case http.StatusServiceUnavailable:
+		return CodeUnavailable
+	default:
+		if status < http.StatusInternalServerError {
+			return CodeInvalidArgument
+		}
+		return CodeInternal
+	}
+}
+
+// newViolation creates a [Violation] from a validator field error.
+func newViolation(fieldErr validator.FieldError) Violation {
+	// namespace is prefixed by the struct name (e.g. registerRequestHTTP.Name)
+	_, path, _ := strings.Cut(fieldErr.Namespace(), ".")
+	if path == "" {
+		path = fieldErr.Field()
+	}
+	segments := strings.Split(path, ".")
+	for i, segment := range segments {
+		segments[i] = toSnakeCase(segment)
+	}
+
+	var message string
+	switch fieldErr.Tag() {
+	case "required":
+		message = "must be set"
+	case "lte", "max":
+		message = "must be at most " + fieldErr.Param()
+	case "gte", "min":
+		message = "must be at least " + fieldErr.Param()
+	case "oneof":
+		message = "must be one of " + fieldErr.Param()
+	default:
+		message = fmt.Sprintf("failed %q validation", fieldErr.Tag())
+	}
+	return Violation{
+		Field:   strings.Join(segments, "."),
+		Code:    fieldErr.Tag(),
+		Message: message,
+	}
+}
+
+// toSnakeCase converts a Go field name (e.g. `OrganizationID`) into its JSON name (e.g. `organization_id`).
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var builder strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			isWordStart := i > 0 && (unicode.IsLower(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+			if isWordStart && runes[i-1] != '_' {
+				builder.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
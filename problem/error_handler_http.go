@@ -0,0 +1,66 @@
+package problem
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrorHandlerHTTP is the HTTP error handler rendering errors as problem details documents
+// (`application/problem+json`).
+//
+// It is installed as the [echo.HTTPErrorHandler] of the server (see [ControllerHTTP]), so router errors (e.g. 404,
+// 405) and errors returned by any route or middleware are rendered the same way.
+type ErrorHandlerHTTP struct {
+	logger *slog.Logger
+}
+
+// NewErrorHandlerHTTP creates a new [ErrorHandlerHTTP] instance.
+func NewErrorHandlerHTTP(logger *slog.Logger) ErrorHandlerHTTP {
+	return ErrorHandlerHTTP{
+		logger: logger,
+	}
+}
+
+// Handle is the [echo.HTTPErrorHandler] translating err using [NewDetails].
+func (h ErrorHandlerHTTP) Handle(err error, e echo.Context) {
+	if e.Response().Committed {
+		return
+	}
+
+	details := NewDetails(err)
+	details.Instance = e.Request().URL.Path
+	details.TraceID = newTraceID(e)
+	if details.Status >= http.StatusInternalServerError {
+		h.logger.ErrorContext(e.Request().Context(), "http request failed",
+			slog.String("method", e.Request().Method),
+			slog.String("path", e.Request().URL.Path),
+			slog.String("trace_id", details.TraceID),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	e.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+	if e.Request().Method == http.MethodHead {
+		err = e.NoContent(details.Status)
+	} else {
+		err = e.JSON(details.Status, details)
+	}
+	if err != nil {
+		h.logger.ErrorContext(e.Request().Context(), "failed to write problem details",
+			slog.String("error", err.Error()))
+	}
+}
+
+// newTraceID returns the identifier of the trace carried by the request. If the request is not traced, the
+// request identifier (X-Request-ID header) is used.
+func newTraceID(e echo.Context) string {
+	if spanCtx := trace.SpanContextFromContext(e.Request().Context()); spanCtx.HasTraceID() {
+		return spanCtx.TraceID().String()
+	} else if requestID := e.Response().Header().Get(echo.HeaderXRequestID); requestID != "" {
+		return requestID
+	}
+	return e.Request().Header.Get(echo.HeaderXRequestID)
+}
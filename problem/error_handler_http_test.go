@@ -0,0 +1,198 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/hadroncorp/geck/syserr"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hadroncorp/service-template/problem"
+)
+
+type fooRequest struct {
+	Name           string `json:"name" validate:"required"`
+	OrganizationID string `json:"organization_id" validate:"lte=4"`
+}
+
+func newValidationError() error {
+	return validator.New().Struct(fooRequest{OrganizationID: "12345"})
+}
+
+func TestErrorHandlerHTTP_Handle(t *testing.T) {
+	tests := []struct {
+		name          string
+		inErr         error
+		inRequestID   string
+		expStatus     int
+		expCode       problem.Code
+		expDetail     string
+		expViolations []problem.Violation
+	}{
+		{
+			name:      "no error",
+			expStatus: http.StatusOK,
+		},
+		{
+			name:      "not found",
+			inErr:     fmt.Errorf("lookup: %w", syserr.ErrResourceNotFound),
+			expStatus: http.StatusNotFound,
+			expCode:   problem.CodeNotFound,
+			expDetail: "lookup: " + syserr.ErrResourceNotFound.Error(),
+		},
+		{
+			name:      "already exists",
+			inErr:     syserr.ErrResourceAlreadyExists,
+			expStatus: http.StatusConflict,
+			expCode:   problem.CodeAlreadyExists,
+			expDetail: syserr.ErrResourceAlreadyExists.Error(),
+		},
+		{
+			name:      "conflict",
+			inErr:     syserr.ErrResourceConflict,
+			expStatus: http.StatusConflict,
+			expCode:   problem.CodeAborted,
+			expDetail: syserr.ErrResourceConflict.Error(),
+		},
+		{
+			name:      "forbidden",
+			inErr:     syserr.ErrForbidden,
+			expStatus: http.StatusForbidden,
+			expCode:   problem.CodePermissionDenied,
+			expDetail: syserr.ErrForbidden.Error(),
+		},
+		{
+			name:      "validation",
+			inErr:     newValidationError(),
+			expStatus: http.StatusBadRequest,
+			expCode:   problem.CodeInvalidArgument,
+			expDetail: "request is invalid",
+			expViolations: []problem.Violation{
+				{Field: "name", Code: "required", Message: "must be set"},
+				{Field: "organization_id", Code: "lte", Message: "must be at most 4"},
+			},
+		},
+		{
+			name:      "bind",
+			inErr:     echo.NewHTTPError(http.StatusBadRequest, "Syntax error: offset=1").SetInternal(errors.New("json")),
+			expStatus: http.StatusBadRequest,
+			expCode:   problem.CodeInvalidArgument,
+			expDetail: "Syntax error: offset=1",
+		},
+		{
+			name:      "precondition failed",
+			inErr:     echo.NewHTTPError(http.StatusPreconditionFailed, "organization does not match If-Match header"),
+			expStatus: http.StatusPreconditionFailed,
+			expCode:   problem.CodeFailedPrecondition,
+			expDetail: "organization does not match If-Match header",
+		},
+		{
+			name:        "internal",
+			inErr:       errors.New("connection refused"),
+			inRequestID: "request-1",
+			expStatus:   http.StatusInternalServerError,
+			expCode:     problem.CodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			e := echo.New()
+			e.HTTPErrorHandler = problem.NewErrorHandlerHTTP(slog.Default()).Handle
+			e.GET("/organizations/:organization_id", func(e echo.Context) error {
+				if tt.inErr != nil {
+					return tt.inErr
+				}
+				return e.NoContent(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/organizations/1", nil)
+			if tt.inRequestID != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.inRequestID)
+			}
+			rec := httptest.NewRecorder()
+
+			// act
+			e.ServeHTTP(rec, req)
+
+			// assert
+			assert.Equal(t, tt.expStatus, rec.Code)
+			if tt.inErr == nil {
+				assert.Empty(t, rec.Body.String())
+				return
+			}
+			assert.True(t, strings.HasPrefix(rec.Header().Get(echo.HeaderContentType),
+				problem.MIMEApplicationProblemJSON))
+
+			details := problem.Details{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &details))
+			assert.Equal(t, "about:blank", details.Type)
+			assert.Equal(t, http.StatusText(tt.expStatus), details.Title)
+			assert.Equal(t, tt.expStatus, details.Status)
+			assert.Equal(t, tt.expCode, details.Code)
+			assert.Equal(t, tt.expDetail, details.Detail)
+			assert.Equal(t, "/organizations/1", details.Instance)
+			assert.Equal(t, tt.inRequestID, details.TraceID)
+			assert.Equal(t, tt.expViolations, details.Violations)
+		})
+	}
+}
+
+func TestErrorHandlerHTTP_Handle_Router(t *testing.T) {
+	tests := []struct {
+		name      string
+		inMethod  string
+		inPath    string
+		expStatus int
+		expCode   problem.Code
+	}{
+		{
+			name:      "route not found",
+			inMethod:  http.MethodGet,
+			inPath:    "/foo",
+			expStatus: http.StatusNotFound,
+			expCode:   problem.CodeNotFound,
+		},
+		{
+			name:      "method not allowed",
+			inMethod:  http.MethodPut,
+			inPath:    "/organizations/1",
+			expStatus: http.StatusMethodNotAllowed,
+			expCode:   problem.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			e := echo.New()
+			e.HTTPErrorHandler = problem.NewErrorHandlerHTTP(slog.Default()).Handle
+			e.GET("/organizations/:organization_id", func(e echo.Context) error {
+				return e.NoContent(http.StatusOK)
+			})
+			req := httptest.NewRequest(tt.inMethod, tt.inPath, nil)
+			rec := httptest.NewRecorder()
+
+			// act
+			e.ServeHTTP(rec, req)
+
+			// assert
+			assert.Equal(t, tt.expStatus, rec.Code)
+			assert.True(t, strings.HasPrefix(rec.Header().Get(echo.HeaderContentType),
+				problem.MIMEApplicationProblemJSON))
+
+			details := problem.Details{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &details))
+			assert.Equal(t, tt.expStatus, details.Status)
+			assert.Equal(t, tt.expCode, details.Code)
+			assert.Equal(t, tt.inPath, details.Instance)
+		})
+	}
+}
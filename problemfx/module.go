@@ -0,0 +1,15 @@
+package problemfx
+
+import (
+	"github.com/hadroncorp/geck/transportfx/httpfx"
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/problem"
+)
+
+var Module = fx.Module("hadron/iam/problem",
+	fx.Provide(
+		problem.NewErrorHandlerHTTP,
+		httpfx.AsController(problem.NewControllerHTTP),
+	),
+)
@@ -0,0 +1,181 @@
+package settings
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hadroncorp/geck/transport"
+	geckhttp "github.com/hadroncorp/geck/transport/http"
+	"github.com/hadroncorp/geck/validation"
+	"github.com/labstack/echo/v4"
+
+	"github.com/hadroncorp/service-template/authn"
+)
+
+const (
+	_headerETag    = "ETag"
+	_headerIfMatch = "If-Match"
+)
+
+type ControllerHTTP struct {
+	manager   Manager
+	reader    Reader
+	registry  *Registry
+	authn     authn.MiddlewareHTTP
+	validator validation.Validator
+}
+
+// compile-time assertion
+var _ geckhttp.Controller = (*ControllerHTTP)(nil)
+
+// NewControllerHTTP creates a new instance of [ControllerHTTP].
+func NewControllerHTTP(manager Manager, reader Reader, registry *Registry, authnMiddleware authn.MiddlewareHTTP,
+	validator validation.Validator) ControllerHTTP {
+	return ControllerHTTP{
+		manager:   manager,
+		reader:    reader,
+		registry:  registry,
+		authn:     authnMiddleware,
+		validator: validator,
+	}
+}
+
+func (c ControllerHTTP) SetEndpoints(_ *echo.Echo) {
+}
+
+func (c ControllerHTTP) SetVersionedEndpoints(g *echo.Group) {
+	sg := g.Group("/organizations/:organization_id/settings", c.authn.Handle)
+	sg.GET("", c.get)
+	sg.PUT("", c.replace)
+}
+
+func (c ControllerHTTP) get(e echo.Context) error {
+	settings, err := c.reader.Get(e.Request().Context(), e.Param("organization_id"))
+	if err != nil {
+		return err
+	}
+
+	setETag(e, settings)
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: c.newResponseHTTP(settings),
+	})
+}
+
+// DEV-NOTE: PUT replaces every public setting, settings left out of the body go back to their default value.
+// Internal settings are neither exposed nor modified, they belong to other modules of the service.
+
+func (c ControllerHTTP) replace(e echo.Context) error {
+	body := replaceRequestHTTP{}
+	if err := e.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.validator.Validate(e.Request().Context(), body); err != nil {
+		return err
+	}
+
+	values := make(map[string]any, len(body.Settings))
+	for key, value := range body.Settings {
+		if def, ok := c.registry.Lookup(key); !ok || def.Visibility != VisibilityPublic {
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown setting "+key)
+		}
+		values[key] = value
+	}
+	for _, def := range c.registry.Definitions() {
+		if _, ok := values[def.Key]; !ok && def.Visibility == VisibilityPublic {
+			values[def.Key] = nil
+		}
+	}
+	var opts []UpdateOption
+	expectedVersion, err := parseIfMatch(e)
+	if err != nil {
+		return err
+	} else if expectedVersion != nil {
+		opts = append(opts, WithExpectedVersion(*expectedVersion))
+	}
+
+	settings, err := c.manager.Update(e.Request().Context(), e.Param("organization_id"), values, opts...)
+	if errors.Is(err, ErrInvalidValue) || errors.Is(err, ErrUnknownSetting) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	} else if errors.Is(err, ErrVersionConflict) && expectedVersion != nil {
+		return echo.NewHTTPError(http.StatusPreconditionFailed, "settings do not match If-Match header").
+			SetInternal(err)
+	} else if err != nil {
+		return err
+	}
+
+	setETag(e, settings)
+	return e.JSON(http.StatusOK, transport.DataContainer[responseHTTP]{
+		Data: c.newResponseHTTP(settings),
+	})
+}
+
+// -- Conditional request(s) --
+
+// setETag sets the ETag header of the response using the version of the given [Settings], settings never stored
+// have version zero.
+func setETag(e echo.Context, settings Settings) {
+	e.Response().Header().Set(_headerETag, strconv.Quote(strconv.FormatUint(settings.Version(), 10)))
+}
+
+// parseIfMatch returns the settings version expected by the client through the If-Match header.
+//
+// It returns nil if the header was not set or if it matches any version (i.e. `*`).
+func parseIfMatch(e echo.Context) (*uint64, error) {
+	header := strings.TrimSpace(e.Request().Header.Get(_headerIfMatch))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	// If-Match uses strong comparison, weak tags never match
+	version, err := strconv.ParseUint(strings.Trim(header, `"`), 10, 64)
+	if strings.HasPrefix(header, "W/") || err != nil {
+		return nil, echo.NewHTTPError(http.StatusPreconditionFailed, "settings do not match If-Match header")
+	}
+	return &version, nil
+}
+
+// -- Models --
+
+type replaceRequestHTTP struct {
+	// Settings holds the value of the settings to set, keyed by setting key.
+	Settings map[string]any `json:"settings" validate:"required"`
+}
+
+type responseHTTP struct {
+	OrganizationID string                `json:"organization_id"`
+	Settings       []settingResponseHTTP `json:"settings"`
+}
+
+type settingResponseHTTP struct {
+	Key          string `json:"key"`
+	Description  string `json:"description,omitempty"`
+	Type         string `json:"type"`
+	Value        any    `json:"value"`
+	DefaultValue any    `json:"default_value"`
+	IsSet        bool   `json:"is_set"`
+}
+
+func (c ControllerHTTP) newResponseHTTP(settings Settings) responseHTTP {
+	res := responseHTTP{
+		OrganizationID: settings.OrganizationID(),
+		Settings:       make([]settingResponseHTTP, 0),
+	}
+	for _, def := range c.registry.Definitions() {
+		if def.Visibility != VisibilityPublic {
+			continue
+		}
+		value, _ := settings.Value(def.Key)
+		res.Settings = append(res.Settings, settingResponseHTTP{
+			Key:          def.Key,
+			Description:  def.Description,
+			Type:         string(def.Type),
+			Value:        value,
+			DefaultValue: def.Default,
+			IsSet:        settings.IsSet(def.Key),
+		})
+	}
+	return res
+}
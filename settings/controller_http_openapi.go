@@ -0,0 +1,59 @@
+package settings
+
+import (
+	"net/http"
+
+	"github.com/hadroncorp/geck/transport"
+
+	"github.com/hadroncorp/service-template/openapi"
+)
+
+// compile-time assertion
+var _ openapi.Describer = (*ControllerHTTP)(nil)
+
+var _tagsOpenAPI = []string{"Settings"}
+
+func (c ControllerHTTP) Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			ID:      "GetOrganizationSettings",
+			Method:  http.MethodGet,
+			Handler: c.get,
+			Summary: "Retrieves the settings of an organization, settings not set hold their default value.",
+			Tags:    _tagsOpenAPI,
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization settings.",
+					Body:        transport.DataContainer[responseHTTP]{},
+				},
+			},
+		},
+		{
+			ID:      "ReplaceOrganizationSettings",
+			Method:  http.MethodPut,
+			Handler: c.replace,
+			Summary: "Replaces the settings of an organization, settings left out go back to their default value.",
+			Tags:    _tagsOpenAPI,
+			Parameters: []openapi.Parameter{
+				{
+					Name:        "If-Match",
+					In:          "header",
+					Description: "Entity tag the settings must match (optimistic concurrency control).",
+				},
+			},
+			RequestBody: replaceRequestHTTP{},
+			Responses: []openapi.Response{
+				{
+					StatusCode:  http.StatusOK,
+					Description: "Organization settings replaced.",
+					Body:        transport.DataContainer[responseHTTP]{},
+				},
+				{
+					StatusCode:  http.StatusPreconditionFailed,
+					Description: "Settings do not match the If-Match header.",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,228 @@
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+)
+
+var (
+	// ErrInvalidDefinition is returned when a [Definition] cannot be registered (e.g. its key is taken).
+	ErrInvalidDefinition = errors.New("settings: invalid definition")
+	// ErrUnknownSetting is returned when a setting has no [Definition] in the [Registry].
+	ErrUnknownSetting = errors.New("settings: unknown setting")
+	// ErrInvalidValue is returned when a setting value does not match the type or the validation rules of its
+	// [Definition].
+	ErrInvalidValue = errors.New("settings: invalid value")
+)
+
+// Type is the type of the value of a setting.
+type Type string
+
+const (
+	// TypeString is the type of settings holding text, values are string.
+	TypeString Type = "string"
+	// TypeBool is the type of settings holding a flag, values are bool.
+	TypeBool Type = "bool"
+	// TypeInt is the type of settings holding an integer, values are int64.
+	TypeInt Type = "int"
+)
+
+// IsValid checks whether t is a supported type.
+func (t Type) IsValid() bool {
+	return t == TypeString || t == TypeBool || t == TypeInt
+}
+
+// normalize converts value into the Go type of t. It returns false if value is not of type t.
+//
+// Integers might come as floats (e.g. decoded from JSON), they are accepted as long as they have no fractional
+// part.
+func (t Type) normalize(value any) (any, bool) {
+	switch t {
+	case TypeString:
+		v, ok := value.(string)
+		return v, ok
+	case TypeBool:
+		v, ok := value.(bool)
+		return v, ok
+	case TypeInt:
+		switch v := value.(type) {
+		case int:
+			return int64(v), true
+		case int32:
+			return int64(v), true
+		case int64:
+			return v, true
+		case float64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+				return nil, false
+			}
+			return int64(v), true
+		}
+	}
+	return nil, false
+}
+
+// Visibility determines who can read and write a setting.
+type Visibility string
+
+const (
+	// VisibilityPublic is the visibility of settings exposed to organization members through the API of the
+	// service, within the limits of their permissions.
+	VisibilityPublic Visibility = "public"
+	// VisibilityInternal is the visibility of settings only available to other modules of the service (e.g.
+	// flags operated by the platform team).
+	VisibilityInternal Visibility = "internal"
+)
+
+// IsValid checks whether v is a supported visibility.
+func (v Visibility) IsValid() bool {
+	return v == VisibilityPublic || v == VisibilityInternal
+}
+
+// Definition describes a setting: its type, default value and validation rules.
+type Definition struct {
+	// Key is the unique identifier of the setting (e.g. mfa_required).
+	Key         string
+	Description string
+	Type        Type
+	// Default is the value of the setting for organizations that did not set it. It must be valid.
+	Default    any
+	Visibility Visibility
+	// Validate checks values beyond their type (e.g. ranges), it might be nil. Values are given in the Go type of
+	// the setting type (see [Type]).
+	Validate func(value any) error
+}
+
+// normalize converts value into the Go type of the setting and validates it, it returns an error wrapping
+// [ErrInvalidValue] if value is not valid.
+func (d Definition) normalize(value any) (any, error) {
+	normalized, ok := d.Type.normalize(value)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be of type %s", ErrInvalidValue, d.Key, d.Type)
+	} else if d.Validate == nil {
+		return normalized, nil
+	} else if err := d.Validate(normalized); err != nil {
+		return nil, fmt.Errorf("%w: %s %w", ErrInvalidValue, d.Key, err)
+	}
+	return normalized, nil
+}
+
+// - Built-in definition(s) -
+
+const (
+	// KeyDefaultCurrency is the key of the ISO 4217 code of the currency used by default by an organization
+	// (e.g. in invoices).
+	KeyDefaultCurrency = "default_currency"
+	// KeyMFARequired is the key of the flag requiring members of an organization to use multi-factor
+	// authentication.
+	KeyMFARequired = "mfa_required"
+	// KeyDataRetentionDays is the key of the number of days the data of an organization is kept for once
+	// deleted.
+	KeyDataRetentionDays = "data_retention_days"
+)
+
+// MaxDataRetentionDays is the maximum value of the [KeyDataRetentionDays] setting.
+const MaxDataRetentionDays = 3650
+
+var _currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// DefaultDefinitions returns the definitions of the settings of every organization, other modules register their
+// own definitions along them.
+func DefaultDefinitions() []Definition {
+	return []Definition{
+		{
+			Key:         KeyDefaultCurrency,
+			Description: "ISO 4217 code of the currency used by default.",
+			Type:        TypeString,
+			Default:     "USD",
+			Visibility:  VisibilityPublic,
+			Validate: func(value any) error {
+				if !_currencyRegexp.MatchString(value.(string)) {
+					return errors.New("must be an ISO 4217 currency code (e.g. USD)")
+				}
+				return nil
+			},
+		},
+		{
+			Key:         KeyMFARequired,
+			Description: "Whether members must use multi-factor authentication.",
+			Type:        TypeBool,
+			Default:     false,
+			Visibility:  VisibilityPublic,
+		},
+		{
+			Key:         KeyDataRetentionDays,
+			Description: "Number of days data is kept for once deleted.",
+			Type:        TypeInt,
+			Default:     int64(365),
+			Visibility:  VisibilityPublic,
+			Validate: func(value any) error {
+				if days := value.(int64); days < 1 || days > MaxDataRetentionDays {
+					return fmt.Errorf("must be between 1 and %d", MaxDataRetentionDays)
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// - Registry -
+
+// Registry holds the [Definition] of every setting of the service.
+type Registry struct {
+	definitions map[string]Definition
+}
+
+// NewRegistry creates a new [Registry] instance holding the given definitions.
+//
+// It returns an error wrapping [ErrInvalidDefinition] if a definition is malformed, its default value is not
+// valid or its key is taken by another definition.
+func NewRegistry(defs ...Definition) (*Registry, error) {
+	r := &Registry{definitions: make(map[string]Definition, len(defs))}
+	for _, def := range defs {
+		if def.Key == "" || !def.Type.IsValid() || !def.Visibility.IsValid() {
+			return nil, fmt.Errorf("%w: %q must have a key, a type and a visibility", ErrInvalidDefinition, def.Key)
+		} else if _, ok := r.definitions[def.Key]; ok {
+			return nil, fmt.Errorf("%w: %q is already registered", ErrInvalidDefinition, def.Key)
+		}
+		defaultValue, err := def.normalize(def.Default)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q default value %w", ErrInvalidDefinition, def.Key, err)
+		}
+		def.Default = defaultValue
+		r.definitions[def.Key] = def
+	}
+	return r, nil
+}
+
+// Lookup retrieves the [Definition] of the setting identified by key.
+func (r *Registry) Lookup(key string) (Definition, bool) {
+	def, ok := r.definitions[key]
+	return def, ok
+}
+
+// Definitions returns every registered [Definition], sorted by key.
+func (r *Registry) Definitions() []Definition {
+	keys := slices.Sorted(maps.Keys(r.definitions))
+	defs := make([]Definition, 0, len(keys))
+	for _, key := range keys {
+		defs = append(defs, r.definitions[key])
+	}
+	return defs
+}
+
+// Normalize converts the value of the setting identified by key into the Go type of the setting and validates it.
+//
+// It returns an error wrapping [ErrUnknownSetting] if the setting is not registered or wrapping
+// [ErrInvalidValue] if value is not valid.
+func (r *Registry) Normalize(key string, value any) (any, error) {
+	def, ok := r.definitions[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSetting, key)
+	}
+	return def.normalize(value)
+}
@@ -0,0 +1,75 @@
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hadroncorp/service-template/settings"
+)
+
+func TestNewRegistry(t *testing.T) {
+	tests := []struct {
+		name   string
+		inDefs []settings.Definition
+		expErr error
+	}{
+		{name: "built-in", inDefs: settings.DefaultDefinitions()},
+		{
+			name: "duplicate key",
+			inDefs: append(settings.DefaultDefinitions(), settings.Definition{
+				Key: settings.KeyMFARequired, Type: settings.TypeBool, Default: true,
+				Visibility: settings.VisibilityInternal,
+			}),
+			expErr: settings.ErrInvalidDefinition,
+		},
+		{
+			name: "invalid default",
+			inDefs: []settings.Definition{{
+				Key: "seats", Type: settings.TypeInt, Default: "ten", Visibility: settings.VisibilityPublic,
+			}},
+			expErr: settings.ErrInvalidDefinition,
+		},
+		{
+			name:   "missing visibility",
+			inDefs: []settings.Definition{{Key: "seats", Type: settings.TypeInt, Default: 10}},
+			expErr: settings.ErrInvalidDefinition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := settings.NewRegistry(tt.inDefs...)
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestRegistry_Normalize(t *testing.T) {
+	registry, err := settings.NewRegistry(settings.DefaultDefinitions()...)
+	if !assert.NoError(t, err) {
+		return
+	}
+	tests := []struct {
+		name    string
+		inKey   string
+		inValue any
+		exp     any
+		expErr  error
+	}{
+		{name: "string", inKey: settings.KeyDefaultCurrency, inValue: "MXN", exp: "MXN"},
+		{name: "bool", inKey: settings.KeyMFARequired, inValue: true, exp: true},
+		{name: "int from JSON", inKey: settings.KeyDataRetentionDays, inValue: float64(30), exp: int64(30)},
+		{name: "fractional int", inKey: settings.KeyDataRetentionDays, inValue: 30.5, expErr: settings.ErrInvalidValue},
+		{name: "out of range", inKey: settings.KeyDataRetentionDays, inValue: 0, expErr: settings.ErrInvalidValue},
+		{name: "invalid currency", inKey: settings.KeyDefaultCurrency, inValue: "pesos", expErr: settings.ErrInvalidValue},
+		{name: "wrong type", inKey: settings.KeyMFARequired, inValue: "yes", expErr: settings.ErrInvalidValue},
+		{name: "unknown", inKey: "seats", inValue: 10, expErr: settings.ErrUnknownSetting},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := registry.Normalize(tt.inKey, tt.inValue)
+			assert.ErrorIs(t, err, tt.expErr)
+			assert.Equal(t, tt.exp, out)
+		})
+	}
+}
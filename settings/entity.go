@@ -0,0 +1,156 @@
+package settings
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/persistence/audit"
+)
+
+// Settings is the configuration of an organization, made of the settings described by a [Registry].
+//
+// Only settings explicitly set are stored, settings left out hold the default value of their [Definition] so
+// changes of defaults reach every organization.
+type Settings struct {
+	audit.Auditable
+	event.AggregatorTemplate
+	organizationID string
+	// values holds the settings explicitly set.
+	values map[string]any
+	// resolved holds the value of every registered setting, defaults included (see [Settings.resolve]).
+	resolved map[string]any
+	// persistedVersion is the version the settings are expected to have in the persistence store, used to
+	// detect concurrent modifications (optimistic concurrency control). It is zero for settings never stored.
+	persistedVersion uint64
+}
+
+// New creates the [Settings] of the organization identified by organizationID, every setting holds its default
+// value.
+//
+// Settings are stored once changed for the first time, until then their version is zero.
+func New(organizationID string) Settings {
+	return Settings{
+		organizationID: organizationID,
+		values:         make(map[string]any),
+	}
+}
+
+// OrganizationID returns the unique identifier of the organization the settings belong to.
+func (s Settings) OrganizationID() string {
+	return s.organizationID
+}
+
+// Value returns the value of the setting identified by key, it returns false if the setting is not registered.
+func (s Settings) Value(key string) (any, bool) {
+	value, ok := s.resolved[key]
+	return value, ok
+}
+
+// Values returns the value of every registered setting, defaults included.
+func (s Settings) Values() map[string]any {
+	return maps.Clone(s.resolved)
+}
+
+// IsSet checks whether the setting identified by key was explicitly set (i.e. it does not hold its default
+// value).
+func (s Settings) IsSet(key string) bool {
+	_, ok := s.values[key]
+	return ok
+}
+
+// String returns the value of the [TypeString] setting identified by key, it returns an empty string if the
+// setting is not registered or is of another type.
+func (s Settings) String(key string) string {
+	value, _ := s.resolved[key].(string)
+	return value
+}
+
+// Bool returns the value of the [TypeBool] setting identified by key, it returns false if the setting is not
+// registered or is of another type.
+func (s Settings) Bool(key string) bool {
+	value, _ := s.resolved[key].(bool)
+	return value
+}
+
+// Int returns the value of the [TypeInt] setting identified by key, it returns zero if the setting is not
+// registered or is of another type.
+func (s Settings) Int(key string) int64 {
+	value, _ := s.resolved[key].(int64)
+	return value
+}
+
+// Change changes the [Settings] with the given values (JSON merge patch semantics): settings with a value are
+// set, settings with a nil value go back to their default and settings left out are not modified. Values must be
+// normalized (see [Registry.Normalize]).
+//
+// It returns true if a setting changed, false otherwise.
+func (s *Settings) Change(ctx context.Context, values map[string]any) bool {
+	changed := make([]string, 0, len(values))
+	merged := maps.Clone(s.values)
+	if merged == nil {
+		merged = make(map[string]any, len(values))
+	}
+	for key, value := range values {
+		prev, wasSet := merged[key]
+		if value == nil {
+			if !wasSet {
+				continue
+			}
+			delete(merged, key)
+		} else if wasSet && prev == value {
+			continue
+		} else {
+			merged[key] = value
+		}
+		changed = append(changed, key)
+	}
+	if len(changed) == 0 {
+		return false // no-op
+	}
+
+	if s.CreateTime().IsZero() {
+		// first change, settings were never stored
+		created := audit.NewWithDefaults(ctx)
+		s.Auditable = audit.New(audit.NewArgs{
+			CreateTime:     created.CreateTime(),
+			CreateBy:       created.CreateBy(),
+			LastUpdateTime: created.LastUpdateTime(),
+			LastUpdateBy:   created.LastUpdateBy(),
+		})
+	}
+	s.values = merged
+	audit.Update(ctx, &s.Auditable)
+	slices.Sort(changed)
+	s.RegisterEvents(newChangedEvent(s, changed))
+	return true
+}
+
+// resolve fills the value of every setting of r, settings not explicitly set (or whose stored value is no longer
+// valid) hold their default value.
+func (s *Settings) resolve(r *Registry) {
+	s.resolved = make(map[string]any, len(r.definitions))
+	for key, def := range r.definitions {
+		s.resolved[key] = def.Default
+		if value, ok := s.values[key]; ok {
+			if normalized, err := def.normalize(value); err == nil {
+				s.values[key] = normalized
+				s.resolved[key] = normalized
+			}
+		}
+	}
+}
+
+// UpdateOption represents an option for changing [Settings].
+type UpdateOption func(*Settings)
+
+// WithExpectedVersion sets the version the [Settings] are expected to have before the change, zero for settings
+// never stored.
+//
+// Changing the settings fails with [ErrVersionConflict] if the stored version differs.
+func WithExpectedVersion(version uint64) UpdateOption {
+	return func(s *Settings) {
+		s.persistedVersion = version
+	}
+}
@@ -0,0 +1,88 @@
+package settings
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"event-schema-registry/iampb"
+)
+
+const (
+	_eventSource = "/organizations/settings"
+)
+
+var (
+	// TopicChanged is the event topic for settings change.
+	TopicChanged = event.NewTopic("hadron", "organization_settings", "changed",
+		event.WithPlatform("iam"))
+)
+
+// ChangedEvent is an event that is emitted when the settings of an organization change.
+type ChangedEvent struct {
+	src         *Settings
+	changedKeys []string
+	topic       event.Topic
+}
+
+// compile-time assertion
+var _ event.Event = (*ChangedEvent)(nil)
+
+func newChangedEvent(src *Settings, changedKeys []string) ChangedEvent {
+	return ChangedEvent{
+		src:         src,
+		changedKeys: changedKeys,
+		topic:       TopicChanged,
+	}
+}
+
+func (e ChangedEvent) Topic() event.Topic {
+	return e.topic
+}
+
+func (e ChangedEvent) Key() string {
+	return e.src.organizationID
+}
+
+func (e ChangedEvent) Bytes() ([]byte, error) {
+	values := make(map[string]string, len(e.src.values))
+	for key, value := range e.src.values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		values[key] = string(encoded)
+	}
+	return proto.Marshal(&iampb.OrganizationSettingsChangedEvent{
+		OrganizationId: e.src.organizationID,
+		ChangedKeys:    e.changedKeys,
+		Settings:       values,
+		ChangeTime:     timestamppb.New(e.src.LastUpdateTime()),
+		ChangeBy:       e.src.LastUpdateBy(),
+	})
+}
+
+func (e ChangedEvent) BytesContentType() transport.MimeType {
+	return transport.MimeTypeProtobuf
+}
+
+func (e ChangedEvent) Source() string {
+	return _eventSource
+}
+
+func (e ChangedEvent) Subject() string {
+	return e.src.organizationID
+}
+
+func (e ChangedEvent) OccurrenceTime() time.Time {
+	return e.src.LastUpdateTime()
+}
+
+func (e ChangedEvent) SchemaSource() string {
+	return reflect.TypeFor[iampb.OrganizationSettingsChangedEvent]().PkgPath()
+}
@@ -0,0 +1,17 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hadroncorp/geck/persistence"
+)
+
+// Repository offers a set of routines to manage [Settings] persistence store operations.
+//
+// Settings are keyed by the unique identifier of their organization and removed along it.
+type Repository interface {
+	persistence.ReadRepository[string, Settings]
+	// Save stores the settings, it returns [ErrVersionConflict] if the stored settings do not have the version
+	// the settings are expected to have (see [WithExpectedVersion]).
+	Save(ctx context.Context, entity Settings) error
+}
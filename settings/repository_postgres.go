@@ -0,0 +1,99 @@
+package settings
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/hadroncorp/geck/persistence/audit"
+	gecksql "github.com/hadroncorp/geck/persistence/sql"
+	"github.com/samber/lo"
+
+	"github.com/hadroncorp/service-template/internal/postgresgen"
+	"github.com/hadroncorp/service-template/internal/sqltx"
+)
+
+// PostgresRepository is the concrete implementation of the [Repository] interface for Postgres.
+type PostgresRepository struct {
+	db *postgresgen.Queries
+}
+
+// compile-time assertion(s)
+var (
+	_ Repository = (*PostgresRepository)(nil)
+)
+
+// NewPostgresRepository creates a new [PostgresRepository] instance.
+//
+// Operations take part of the transaction carried by the context, if any (see [sqltx.Runner]).
+func NewPostgresRepository(db gecksql.DB) PostgresRepository {
+	return PostgresRepository{
+		db: postgresgen.New(sqltx.NewConn(db)),
+	}
+}
+
+func (p PostgresRepository) Save(ctx context.Context, entity Settings) error {
+	values, err := json.Marshal(entity.values)
+	if err != nil {
+		return err
+	}
+
+	// DEV-NOTE: Settings are upserted, settings never stored have a zero version so concurrent first changes
+	// conflict like any other change.
+	affected, err := p.db.UpsertOrganizationSettings(ctx, postgresgen.UpsertOrganizationSettingsParams{
+		OrganizationID:     entity.organizationID,
+		Settings:           values,
+		CreateTime:         entity.CreateTime(),
+		CreateBy:           entity.CreateBy(),
+		LastUpdateTime:     entity.LastUpdateTime(),
+		LastUpdateBy:       entity.LastUpdateBy(),
+		RowVersion:         int64(entity.Version()),
+		ExpectedRowVersion: int64(entity.persistedVersion),
+	})
+	if err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+func (p PostgresRepository) FindByKey(ctx context.Context, organizationID string) (*Settings, error) {
+	model, err := p.db.GetOrganizationSettings(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	settings, err := newFromPostgres(model)
+	if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(settings), nil
+}
+
+// - Mapper(s) -
+
+// newFromPostgres builds [Settings] from their Postgres model.
+func newFromPostgres(model postgresgen.OrganizationSetting) (Settings, error) {
+	values := make(map[string]any)
+	if len(model.Settings) > 0 {
+		// numbers are decoded as float64, integers are restored once resolved against the registry
+		if err := json.Unmarshal(model.Settings, &values); err != nil {
+			return Settings{}, err
+		}
+	}
+	return Settings{
+		organizationID:   model.OrganizationID,
+		values:           values,
+		persistedVersion: uint64(model.RowVersion),
+		Auditable: audit.New(audit.NewArgs{
+			CreateTime:     model.CreateTime,
+			CreateBy:       model.CreateBy,
+			LastUpdateTime: model.LastUpdateTime,
+			LastUpdateBy:   model.LastUpdateBy,
+			Version:        uint64(model.RowVersion),
+		}),
+	}, nil
+}
@@ -0,0 +1,190 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hadroncorp/geck/event"
+	"github.com/hadroncorp/geck/syserr"
+
+	"github.com/hadroncorp/service-template/internal/sqltx"
+	"github.com/hadroncorp/service-template/organization"
+)
+
+// - Error(s) -
+
+var (
+	// ErrVersionConflict is returned when the settings were modified by someone else (i.e. their stored version
+	// differs from the expected one).
+	ErrVersionConflict = syserr.NewResourceConflict[Settings]()
+)
+
+// - Domain Service(s) -
+
+// getByOrganization retrieves the [Settings] of an organization, resolved against r. Settings never stored hold
+// their default values.
+func getByOrganization(ctx context.Context, repo Repository, r *Registry, organizationID string) (Settings, error) {
+	stored, err := repo.FindByKey(ctx, organizationID)
+	if err != nil {
+		return Settings{}, err
+	}
+	settings := New(organizationID)
+	if stored != nil {
+		settings = *stored
+	}
+	settings.resolve(r)
+	return settings, nil
+}
+
+// - Application Service(s) -
+
+// -- Reader --
+
+// A Reader is the service that reads the [Settings] of organizations.
+//
+// Other modules inject it to read the configuration of an organization (e.g. whether MFA is required).
+type Reader interface {
+	// Get retrieves the settings of the organization identified by organizationID, settings not explicitly set
+	// hold their default value.
+	Get(ctx context.Context, organizationID string) (Settings, error)
+}
+
+// --- Implementation(s) ---
+
+// LocalReader is a concrete implementation of the [Reader] interface that uses local resources (from the service
+// perspective).
+type LocalReader struct {
+	repository    Repository
+	orgRepository organization.ReadRepository
+	registry      *Registry
+}
+
+// compile-time assertion
+var _ Reader = (*LocalReader)(nil)
+
+// NewLocalReader creates a new [LocalReader] instance.
+func NewLocalReader(r Repository, orgRepository organization.ReadRepository, registry *Registry) LocalReader {
+	return LocalReader{repository: r, orgRepository: orgRepository, registry: registry}
+}
+
+// Get retrieves the settings of the organization identified by organizationID.
+func (l LocalReader) Get(ctx context.Context, organizationID string) (Settings, error) {
+	org, err := l.orgRepository.FindByKey(ctx, organizationID)
+	if err != nil {
+		return Settings{}, err
+	} else if org == nil || org.IsDeleted() {
+		return Settings{}, organization.ErrNotFound
+	}
+	return getByOrganization(ctx, l.repository, l.registry, organizationID)
+}
+
+// -- Manager --
+
+// A Manager is the service that manages the [Settings] of organizations.
+type Manager interface {
+	// Update changes the settings of the organization identified by organizationID (JSON merge patch
+	// semantics): settings with a value are set, settings with a nil value go back to their default and settings
+	// left out are not modified.
+	//
+	// It returns an error wrapping [ErrUnknownSetting] or [ErrInvalidValue] if a setting is not valid.
+	Update(ctx context.Context, organizationID string, values map[string]any, opts ...UpdateOption) (Settings,
+		error)
+}
+
+// --- Implementation(s) ---
+
+// LocalManager is a concrete implementation of the [Manager] interface that uses local resources (from the service
+// perspective).
+type LocalManager struct {
+	repository     Repository
+	orgRepository  organization.Repository
+	registry       *Registry
+	eventPublisher event.Publisher
+}
+
+// compile-time assertion
+var _ Manager = (*LocalManager)(nil)
+
+// NewLocalManager creates a new [LocalManager] instance.
+func NewLocalManager(r Repository, orgRepository organization.Repository, registry *Registry,
+	p event.Publisher) LocalManager {
+	return LocalManager{repository: r, orgRepository: orgRepository, registry: registry, eventPublisher: p}
+}
+
+// Update changes the settings of the organization identified by organizationID.
+func (l LocalManager) Update(ctx context.Context, organizationID string, values map[string]any,
+	opts ...UpdateOption) (Settings, error) {
+	normalized := make(map[string]any, len(values))
+	for key, value := range values {
+		if value == nil {
+			if _, ok := l.registry.Lookup(key); !ok {
+				return Settings{}, fmt.Errorf("%w: %s", ErrUnknownSetting, key)
+			}
+			normalized[key] = nil
+			continue
+		}
+		normalizedValue, err := l.registry.Normalize(key, value)
+		if err != nil {
+			return Settings{}, err
+		}
+		normalized[key] = normalizedValue
+	}
+
+	if err := organization.EnsureActive(ctx, l.orgRepository, organizationID); err != nil {
+		return Settings{}, err
+	}
+
+	settings, err := getByOrganization(ctx, l.repository, l.registry, organizationID)
+	if err != nil {
+		return Settings{}, err
+	}
+	storedVersion := settings.persistedVersion
+	for _, opt := range opts {
+		opt(&settings)
+	}
+	if settings.persistedVersion != storedVersion {
+		return Settings{}, ErrVersionConflict
+	} else if !settings.Change(ctx, normalized) {
+		return settings, nil // no-op
+	}
+
+	if err = l.repository.Save(ctx, settings); err != nil {
+		return Settings{}, err
+	}
+	if err = l.eventPublisher.Publish(ctx, settings.PullEvents()); err != nil {
+		return Settings{}, err
+	}
+	settings.resolve(l.registry)
+	return settings, nil
+}
+
+// TransactionalManager is a [Manager] decorator executing every operation of the underlying [Manager] within
+// a single transaction.
+//
+// Use it along a transactional outbox [event.Publisher] to make entity writes and event writes atomic.
+type TransactionalManager struct {
+	next     Manager
+	txRunner sqltx.Runner
+}
+
+// compile-time assertion
+var _ Manager = (*TransactionalManager)(nil)
+
+// NewTransactionalManager creates a new [TransactionalManager] instance.
+func NewTransactionalManager(next Manager, r sqltx.Runner) TransactionalManager {
+	return TransactionalManager{next: next, txRunner: r}
+}
+
+// Update changes the settings of the organization identified by organizationID.
+func (t TransactionalManager) Update(ctx context.Context, organizationID string, values map[string]any,
+	opts ...UpdateOption) (Settings, error) {
+	var settings Settings
+	err := t.txRunner.RunInTx(ctx, func(scopedCtx context.Context) (err error) {
+		settings, err = t.next.Update(scopedCtx, organizationID, values, opts...)
+		return err
+	})
+	if err != nil {
+		return Settings{}, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"context"
+
+	"github.com/hadroncorp/service-template/authz"
+)
+
+const (
+	// PermissionRead is the permission to read the settings of an organization.
+	PermissionRead authz.Permission = "setting:read"
+	// PermissionUpdate is the permission to change the settings of an organization.
+	PermissionUpdate authz.Permission = "setting:update"
+)
+
+// DefaultPolicy returns the roles granting the settings permissions unless configured otherwise (see
+// [authz.Config]).
+func DefaultPolicy() authz.Policy {
+	return authz.Policy{
+		PermissionRead:   {authz.RoleOwner, authz.RoleAdmin, authz.RoleMember, authz.RoleIAMAdmin},
+		PermissionUpdate: {authz.RoleOwner, authz.RoleAdmin, authz.RoleIAMAdmin},
+	}
+}
+
+// AuthorizedReader is a [Reader] decorator allowing callers to read the settings of an organization only if the
+// [authz.Authorizer] grants them [PermissionRead] on the organization.
+type AuthorizedReader struct {
+	next       Reader
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Reader = (*AuthorizedReader)(nil)
+
+// NewAuthorizedReader creates a new [AuthorizedReader] instance.
+func NewAuthorizedReader(next Reader, a authz.Authorizer) AuthorizedReader {
+	return AuthorizedReader{next: next, authorizer: a}
+}
+
+// Get retrieves the settings of the organization identified by organizationID.
+func (a AuthorizedReader) Get(ctx context.Context, organizationID string) (Settings, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionRead, organizationID); err != nil {
+		return Settings{}, err
+	}
+	return a.next.Get(ctx, organizationID)
+}
+
+// AuthorizedManager is a [Manager] decorator allowing callers to perform an operation of the underlying
+// [Manager] only if the [authz.Authorizer] grants them its permission on the organization.
+type AuthorizedManager struct {
+	next       Manager
+	authorizer authz.Authorizer
+}
+
+// compile-time assertion
+var _ Manager = (*AuthorizedManager)(nil)
+
+// NewAuthorizedManager creates a new [AuthorizedManager] instance.
+func NewAuthorizedManager(next Manager, a authz.Authorizer) AuthorizedManager {
+	return AuthorizedManager{next: next, authorizer: a}
+}
+
+// Update changes the settings of the organization identified by organizationID.
+func (a AuthorizedManager) Update(ctx context.Context, organizationID string, values map[string]any,
+	opts ...UpdateOption) (Settings, error) {
+	if err := a.authorizer.Authorize(ctx, PermissionUpdate, organizationID); err != nil {
+		return Settings{}, err
+	}
+	return a.next.Update(ctx, organizationID, values, opts...)
+}
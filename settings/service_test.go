@@ -0,0 +1,125 @@
+package settings_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hadroncorp/geck/eventmock"
+	"github.com/hadroncorp/geck/security/identity"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hadroncorp/service-template/organization"
+	"github.com/hadroncorp/service-template/organizationmock"
+	"github.com/hadroncorp/service-template/settings"
+	"github.com/hadroncorp/service-template/settingsmock"
+)
+
+type localManagerSuite struct {
+	suite.Suite
+
+	repository     *settingsmock.MockRepository
+	orgRepository  *organizationmock.MockRepository
+	eventPublisher *eventmock.MockPublisher
+	manager        settings.LocalManager
+	baseCtx        context.Context
+}
+
+func TestLocalManagerSuite(t *testing.T) {
+	suite.Run(t, new(localManagerSuite))
+}
+
+func (s *localManagerSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.repository = settingsmock.NewMockRepository(ctrl)
+	s.orgRepository = organizationmock.NewMockRepository(ctrl)
+	s.eventPublisher = eventmock.NewMockPublisher(ctrl)
+	registry, err := settings.NewRegistry(settings.DefaultDefinitions()...)
+	s.Require().NoError(err)
+	s.manager = settings.NewLocalManager(s.repository, s.orgRepository, registry, s.eventPublisher)
+	s.baseCtx = identity.WithPrincipal(context.Background(), identity.NewBasicPrincipal("some-user"))
+}
+
+func (s *localManagerSuite) TestLocalManager_Update() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return((*settings.Settings)(nil), error(nil))
+	s.repository.EXPECT().
+		Save(s.baseCtx, gomock.Any()).
+		Times(1).
+		Return(error(nil))
+	s.eventPublisher.EXPECT().
+		Publish(s.baseCtx, gomock.Len(1)).
+		Times(1).
+		Return(error(nil))
+
+	// act
+	out, err := s.manager.Update(s.baseCtx, "1", map[string]any{
+		settings.KeyMFARequired:       true,
+		settings.KeyDataRetentionDays: float64(30),
+	}, settings.WithExpectedVersion(0))
+
+	// assert
+	s.Assert().NoError(err)
+	s.Assert().True(out.Bool(settings.KeyMFARequired))
+	s.Assert().Equal(int64(30), out.Int(settings.KeyDataRetentionDays))
+	s.Assert().Equal("USD", out.String(settings.KeyDefaultCurrency))
+	s.Assert().False(out.IsSet(settings.KeyDefaultCurrency))
+	s.Assert().NotZero(out.Version())
+}
+
+func (s *localManagerSuite) TestLocalManager_Update_Invalid_Value() {
+	// act
+	_, err := s.manager.Update(s.baseCtx, "1", map[string]any{
+		settings.KeyDataRetentionDays: float64(settings.MaxDataRetentionDays + 1),
+	})
+
+	// assert
+	s.Assert().ErrorIs(err, settings.ErrInvalidValue)
+}
+
+func (s *localManagerSuite) TestLocalManager_Update_Version_Conflict() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+	s.repository.EXPECT().
+		FindByKey(s.baseCtx, "1").
+		Times(1).
+		Return((*settings.Settings)(nil), error(nil))
+
+	// act
+	_, err := s.manager.Update(s.baseCtx, "1", map[string]any{
+		settings.KeyMFARequired: true,
+	}, settings.WithExpectedVersion(3))
+
+	// assert
+	s.Assert().ErrorIs(err, settings.ErrVersionConflict)
+}
+
+func (s *localManagerSuite) TestLocalManager_Update_Not_Active() {
+	// arrange
+	org := organization.New(s.baseCtx, "1", "foo")
+	s.Require().NoError(org.Suspend(s.baseCtx, organization.ReasonNonPayment))
+	s.orgRepository.EXPECT().
+		LockByKey(s.baseCtx, "1").
+		Times(1).
+		Return(&org, error(nil))
+
+	// act
+	_, err := s.manager.Update(s.baseCtx, "1", map[string]any{
+		settings.KeyMFARequired: true,
+	})
+
+	// assert
+	s.Assert().ErrorIs(err, organization.ErrNotActive)
+}
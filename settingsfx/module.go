@@ -0,0 +1,79 @@
+package settingsfx
+
+import (
+	"github.com/hadroncorp/geck/transportfx/httpfx"
+	"go.uber.org/fx"
+
+	"github.com/hadroncorp/service-template/authzfx"
+	"github.com/hadroncorp/service-template/openapifx"
+	"github.com/hadroncorp/service-template/outboxfx"
+	"github.com/hadroncorp/service-template/settings"
+)
+
+// _definitionGroup is the value group holding every [settings.Definition] registered by other modules.
+const _definitionGroup = "settings_definitions"
+
+var Module = fx.Module("hadron/iam/settings",
+	fx.Provide(
+		fx.Annotate(
+			newRegistry,
+			fx.ParamTags(`group:"`+_definitionGroup+`"`),
+		),
+		fx.Annotate(
+			settings.NewPostgresRepository,
+			fx.As(new(settings.Repository)),
+		),
+		// DEV-NOTE: Other modules read settings on behalf of the service, not of the caller, so the Reader
+		// they inject is not authorized. The HTTP controller uses the authorized one.
+		fx.Annotate(
+			settings.NewLocalReader,
+			fx.As(new(settings.Reader)),
+		),
+		fx.Annotate(
+			settings.NewAuthorizedReader,
+			fx.ResultTags(`name:"settings_authorized_reader"`),
+			fx.As(new(settings.Reader)),
+		),
+		fx.Annotate(
+			settings.NewLocalManager,
+			fx.ParamTags(``, ``, ``, `name:"`+outboxfx.PublisherName+`"`),
+			fx.ResultTags(`name:"settings_local_manager"`),
+			fx.As(new(settings.Manager)),
+		),
+		fx.Annotate(
+			settings.NewTransactionalManager,
+			fx.ParamTags(`name:"settings_local_manager"`),
+			fx.ResultTags(`name:"settings_transactional_manager"`),
+			fx.As(new(settings.Manager)),
+		),
+		fx.Annotate(
+			settings.NewAuthorizedManager,
+			fx.ParamTags(`name:"settings_transactional_manager"`),
+			fx.As(new(settings.Manager)),
+		),
+		authzfx.AsPolicy(settings.DefaultPolicy),
+		httpfx.AsController(fx.Annotate(
+			settings.NewControllerHTTP,
+			fx.ParamTags(``, `name:"settings_authorized_reader"`),
+		)),
+		openapifx.AsDescriber(fx.Annotate(
+			settings.NewControllerHTTP,
+			fx.ParamTags(``, `name:"settings_authorized_reader"`),
+		)),
+	),
+)
+
+// AsDefinition annotates the given constructor so its result is registered along the built-in settings (see
+// [settings.DefaultDefinitions]).
+func AsDefinition(f any) any {
+	return fx.Annotate(
+		f,
+		fx.ResultTags(`group:"`+_definitionGroup+`"`),
+	)
+}
+
+// newRegistry creates the [settings.Registry] of the built-in settings and of the settings registered by other
+// modules.
+func newRegistry(defs []settings.Definition) (*settings.Registry, error) {
+	return settings.NewRegistry(append(settings.DefaultDefinitions(), defs...)...)
+}
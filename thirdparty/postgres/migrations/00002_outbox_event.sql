@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS outbox_events (
+    sequence_id BIGSERIAL PRIMARY KEY,
+    event_id VARCHAR(48) NOT NULL UNIQUE,
+    topic VARCHAR(255) NOT NULL,
+    partition_key VARCHAR(255) NOT NULL,
+    source TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    schema_source TEXT NOT NULL,
+    content_type VARCHAR(128) NOT NULL,
+    payload BYTEA NOT NULL,
+    occurrence_time TIMESTAMPTZ NOT NULL,
+    create_time TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox_events;
+-- +goose StatementEnd
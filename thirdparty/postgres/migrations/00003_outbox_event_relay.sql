@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE outbox_events
+    ADD COLUMN IF NOT EXISTS send_time TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_time TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS last_error TEXT NULL;
+-- For relay polling
+CREATE INDEX idx_outbox_events_pending ON outbox_events(sequence_id) WHERE send_time IS NULL;
+-- For per-key ordering checks
+CREATE INDEX idx_outbox_events_pending_key ON outbox_events(partition_key, sequence_id) WHERE send_time IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_events_pending_key;
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_time,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS send_time;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Duplicate names among non-deleted organizations (case-insensitive) are resolved first, the oldest organization
+-- keeps the name while the rest get their ID appended.
+UPDATE organizations o
+SET name = o.name || ' (' || o.organization_id || ')',
+    row_version = o.row_version + 1
+FROM (
+    SELECT organization_id,
+           row_number() OVER (PARTITION BY lower(name) ORDER BY create_time, organization_id) AS name_rank
+    FROM organizations
+    WHERE is_deleted = false
+) duplicates
+WHERE o.organization_id = duplicates.organization_id AND duplicates.name_rank > 1;
+-- Names are unique (case-insensitive) among non-deleted organizations.
+CREATE UNIQUE INDEX idx_organizations_name_unique ON organizations(lower(name)) WHERE is_deleted = false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_organizations_name_unique;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+-- For full-text and autocomplete (prefix) searches
+CREATE INDEX idx_organizations_name_search ON organizations USING GIN (to_tsvector('simple', name)) WHERE is_deleted = false;
+-- For typo-tolerant (trigram) searches
+CREATE INDEX idx_organizations_name_trgm ON organizations USING GIN (name gin_trgm_ops) WHERE is_deleted = false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_organizations_name_trgm;
+DROP INDEX IF EXISTS idx_organizations_name_search;
+-- +goose StatementEnd
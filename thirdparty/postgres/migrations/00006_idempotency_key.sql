@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    scope TEXT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    fingerprint BYTEA NOT NULL,
+    -- Response is NULL while the original request is in progress, headers are JSON-encoded
+    status_code INT,
+    response_header BYTEA,
+    response_body BYTEA,
+    create_time TIMESTAMPTZ NOT NULL,
+    expire_time TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (scope, idempotency_key)
+);
+-- For expired keys purging
+CREATE INDEX idx_idempotency_keys_expire_time ON idempotency_keys(expire_time);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_idempotency_keys_expire_time;
+DROP TABLE IF EXISTS idempotency_keys;
+-- +goose StatementEnd
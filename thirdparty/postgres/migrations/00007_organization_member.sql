@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id VARCHAR(48) NOT NULL REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    user_id VARCHAR(96) NOT NULL,
+    role VARCHAR(16) NOT NULL,
+    create_time TIMESTAMPTZ NOT NULL,
+    create_by VARCHAR(96) NOT NULL,
+    last_update_time TIMESTAMPTZ NOT NULL,
+    last_update_by VARCHAR(96) NOT NULL,
+    row_version BIGINT NOT NULL,
+    PRIMARY KEY (organization_id, user_id)
+);
+-- For organizations of a user lookups
+CREATE INDEX idx_organization_members_user_id ON organization_members(user_id, organization_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_organization_members_user_id;
+DROP TABLE IF EXISTS organization_members;
+-- +goose StatementEnd
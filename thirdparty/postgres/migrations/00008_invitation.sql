@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS invitations (
+    invitation_id VARCHAR(48) PRIMARY KEY,
+    organization_id VARCHAR(48) NOT NULL REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    email VARCHAR(320) NOT NULL,
+    role VARCHAR(16) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    expire_time TIMESTAMPTZ NOT NULL,
+    send_count INT NOT NULL,
+    last_send_time TIMESTAMPTZ NOT NULL,
+    create_time TIMESTAMPTZ NOT NULL,
+    create_by VARCHAR(96) NOT NULL,
+    last_update_time TIMESTAMPTZ NOT NULL,
+    last_update_by VARCHAR(96) NOT NULL,
+    row_version BIGINT NOT NULL
+);
+-- At most one pending invitation per email within an organization
+CREATE UNIQUE INDEX idx_invitations_pending_email ON invitations(organization_id, email) WHERE status = 'pending';
+-- For organization-based pagination
+CREATE INDEX idx_invitations_organization_id ON invitations(organization_id, invitation_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_invitations_organization_id;
+DROP INDEX IF EXISTS idx_invitations_pending_email;
+DROP TABLE IF EXISTS invitations;
+-- +goose StatementEnd
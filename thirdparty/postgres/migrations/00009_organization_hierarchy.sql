@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Organizations with children cannot be purged (ON DELETE RESTRICT), children must be moved first.
+ALTER TABLE organizations ADD COLUMN parent_id VARCHAR(48) NULL REFERENCES organizations(organization_id) ON DELETE RESTRICT;
+-- For children lookups (listings and recursive traversals)
+CREATE INDEX idx_organizations_parent_id ON organizations(parent_id, create_time, organization_id) WHERE parent_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_organizations_parent_id;
+ALTER TABLE organizations DROP COLUMN IF EXISTS parent_id;
+-- +goose StatementEnd
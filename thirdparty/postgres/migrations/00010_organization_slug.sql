@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE organizations ADD COLUMN slug TEXT NULL;
+-- Existing organizations get their ID as slug, it can be changed afterwards.
+UPDATE organizations SET slug = lower(organization_id);
+ALTER TABLE organizations ALTER COLUMN slug SET NOT NULL;
+-- Slugs are unique (case-insensitive) among every organization, deleted ones included, so restored organizations
+-- keep their URLs.
+CREATE UNIQUE INDEX idx_organizations_slug_unique ON organizations(lower(slug));
+
+-- Previous slugs of organizations, resolved as redirects until another organization takes them.
+CREATE TABLE IF NOT EXISTS organization_slug_redirects (
+    slug TEXT PRIMARY KEY, -- lowercase
+    organization_id VARCHAR(48) NOT NULL REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    create_time TIMESTAMPTZ NOT NULL
+);
+-- For cascading deletions
+CREATE INDEX idx_organization_slug_redirects_organization_id ON organization_slug_redirects(organization_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_organization_slug_redirects_organization_id;
+DROP TABLE IF EXISTS organization_slug_redirects;
+DROP INDEX IF EXISTS idx_organizations_slug_unique;
+ALTER TABLE organizations DROP COLUMN IF EXISTS slug;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Profile attributes are optional, empty strings stand for unset values.
+ALTER TABLE organizations
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN website_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN logo_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN country_code VARCHAR(2) NOT NULL DEFAULT '', -- ISO 3166-1 alpha-2
+    ADD COLUMN locale VARCHAR(35) NOT NULL DEFAULT '', -- BCP 47 language tag
+    ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT '', -- IANA time zone
+    ADD COLUMN legal_id VARCHAR(32) NOT NULL DEFAULT '',
+    ADD COLUMN tax_id VARCHAR(32) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE organizations
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS website_url,
+    DROP COLUMN IF EXISTS logo_url,
+    DROP COLUMN IF EXISTS country_code,
+    DROP COLUMN IF EXISTS locale,
+    DROP COLUMN IF EXISTS time_zone,
+    DROP COLUMN IF EXISTS legal_id,
+    DROP COLUMN IF EXISTS tax_id;
+-- +goose StatementEnd
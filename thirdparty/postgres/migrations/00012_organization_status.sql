@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Organizations go through the active, suspended and archived statuses, status_reason is the reason code of the
+-- latest transition (empty for organizations never transitioned).
+ALTER TABLE organizations
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active'
+        CONSTRAINT organizations_status_check CHECK (status IN ('active', 'suspended', 'archived')),
+    ADD COLUMN status_reason VARCHAR(32) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE organizations
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS status_reason;
+-- +goose StatementEnd
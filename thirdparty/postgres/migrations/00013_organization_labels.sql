@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Labels are key/value pairs stored as a JSON object (e.g. {"tier": "enterprise", "region": "eu"}).
+ALTER TABLE organizations
+    ADD COLUMN labels JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- Serves label selectors (containment and key existence operators).
+CREATE INDEX idx_organizations_labels ON organizations USING GIN (labels);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_organizations_labels;
+
+ALTER TABLE organizations
+    DROP COLUMN IF EXISTS labels;
+-- +goose StatementEnd
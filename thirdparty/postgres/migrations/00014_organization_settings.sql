@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Holds the settings explicitly set for an organization as a JSON object (e.g. {"mfa_required": true}), settings
+-- left out hold their default value.
+CREATE TABLE IF NOT EXISTS organization_settings (
+    organization_id VARCHAR(48) PRIMARY KEY REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    settings JSONB NOT NULL DEFAULT '{}'::jsonb,
+    create_time TIMESTAMPTZ NOT NULL,
+    create_by VARCHAR(96) NOT NULL,
+    last_update_time TIMESTAMPTZ NOT NULL,
+    last_update_by VARCHAR(96) NOT NULL,
+    row_version BIGINT NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS organization_settings;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Audit trail of organizations, every write appends a record. Records outlive their organization (no foreign key)
+-- so purges are audited as well. Snapshots are JSON objects, or JSON null when the organization did not exist
+-- before (e.g. registered) or after (e.g. purged) the operation.
+CREATE TABLE IF NOT EXISTS organization_history (
+    record_id BIGSERIAL PRIMARY KEY,
+    organization_id VARCHAR(48) NOT NULL,
+    operation VARCHAR(32) NOT NULL,
+    actor VARCHAR(96) NOT NULL,
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    before_snapshot JSONB NOT NULL,
+    after_snapshot JSONB NOT NULL,
+    record_time TIMESTAMPTZ NOT NULL
+);
+-- For history of an organization lookups, newest first
+CREATE INDEX idx_organization_history_organization_id ON organization_history(organization_id, record_id DESC);
+
+-- Records are immutable, they are never updated nor deleted.
+CREATE OR REPLACE FUNCTION reject_organization_history_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'organization_history records are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_organization_history_immutable
+    BEFORE UPDATE OR DELETE ON organization_history
+    FOR EACH ROW EXECUTE FUNCTION reject_organization_history_change();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_organization_history_immutable ON organization_history;
+DROP FUNCTION IF EXISTS reject_organization_history_change();
+DROP INDEX IF EXISTS idx_organization_history_organization_id;
+DROP TABLE IF EXISTS organization_history;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- For retention purges
+CREATE INDEX idx_outbox_events_sent ON outbox_events(send_time) WHERE send_time IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_events_sent;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- name: AcquireIdempotencyKey :execrows
+-- Reserves a key, taking over expired keys.
+INSERT INTO idempotency_keys (scope, idempotency_key, fingerprint, create_time, expire_time)
+VALUES
+    ($1, $2, $3, $4, $5)
+ON CONFLICT (scope, idempotency_key) DO UPDATE
+SET
+    fingerprint = EXCLUDED.fingerprint,
+    status_code = NULL,
+    response_header = NULL,
+    response_body = NULL,
+    create_time = EXCLUDED.create_time,
+    expire_time = EXCLUDED.expire_time
+WHERE idempotency_keys.expire_time <= EXCLUDED.create_time;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys WHERE scope = $1 AND idempotency_key = $2 LIMIT 1;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET
+    status_code = $3,
+    response_header = $4,
+    response_body = $5,
+    expire_time = $6
+WHERE scope = $1 AND idempotency_key = $2;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE scope = $1 AND idempotency_key = $2;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expire_time <= $1;
@@ -0,0 +1,34 @@
+-- name: CreateInvitation :exec
+INSERT INTO invitations (invitation_id, organization_id, email, role, status, expire_time, send_count, last_send_time, create_time, create_by, last_update_time, last_update_by, row_version)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+
+-- name: GetInvitationByID :one
+SELECT * FROM invitations WHERE invitation_id = $1 LIMIT 1;
+
+-- name: GetPendingInvitation :one
+SELECT * FROM invitations WHERE organization_id = $1 AND email = $2 AND status = 'pending' LIMIT 1;
+
+-- name: UpdateInvitation :execrows
+UPDATE invitations
+SET
+    status = $2,
+    expire_time = $3,
+    send_count = $4,
+    last_send_time = $5,
+    last_update_time = $6,
+    last_update_by = $7,
+    row_version = $8
+WHERE invitation_id = $1 AND row_version = sqlc.arg('expected_row_version');
+
+-- name: ListInvitations :many
+SELECT *
+FROM invitations
+WHERE
+    organization_id = sqlc.arg('organization_id')
+    -- Optional filters
+    AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+    -- Optional page cursor
+    AND (sqlc.narg('cursor_invitation_id')::text IS NULL OR invitation_id > sqlc.narg('cursor_invitation_id')::text)
+ORDER BY invitation_id
+LIMIT sqlc.arg('page_size');
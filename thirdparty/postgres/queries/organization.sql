@@ -1,23 +1,58 @@
 -- name: CreateOrganization :exec
-INSERT INTO organizations (organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted)
+INSERT INTO organizations (organization_id, name, create_time, create_by, last_update_time, last_update_by, row_version, is_deleted, parent_id, slug,
+                           description, website_url, logo_url, country_code, locale, time_zone, legal_id, tax_id, status, status_reason, labels)
 VALUES
-    ($1, $2, $3, $4, $5, $6, $7, $8);
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21);
 
 -- name: GetOrganizationByID :one
 SELECT * FROM organizations WHERE organization_id = $1 LIMIT 1;
 
+-- name: GetOrganizationBySlug :one
+SELECT * FROM organizations WHERE lower(slug) = lower(sqlc.arg('slug')) LIMIT 1;
+
 -- name: ExistOrganizationByName :one
-SELECT EXISTS(SELECT 1 FROM organizations WHERE name = $1 LIMIT 1);
+SELECT EXISTS(
+    SELECT 1
+    FROM organizations
+    WHERE
+        lower(name) = lower(sqlc.arg('name'))
+        AND is_deleted = false
+        AND organization_id <> sqlc.arg('exclude_organization_id')
+    LIMIT 1
+);
 
--- name: UpdateOrganization :exec
+-- name: ExistOrganizationBySlug :one
+SELECT EXISTS(
+    SELECT 1
+    FROM organizations
+    WHERE
+        lower(slug) = lower(sqlc.arg('slug'))
+        AND organization_id <> sqlc.arg('exclude_organization_id')
+    LIMIT 1
+);
+
+-- name: UpdateOrganization :execrows
 UPDATE organizations
 SET
     name = $2,
     last_update_time = $3,
     last_update_by = $4,
     row_version = $5,
-    is_deleted = $6
-WHERE organization_id = $1;
+    is_deleted = $6,
+    parent_id = $7,
+    slug = $8,
+    description = $9,
+    website_url = $10,
+    logo_url = $11,
+    country_code = $12,
+    locale = $13,
+    time_zone = $14,
+    legal_id = $15,
+    tax_id = $16,
+    status = $17,
+    status_reason = $18,
+    labels = $19
+WHERE organization_id = $1 AND row_version = sqlc.arg('expected_row_version');
 
 -- name: DeleteOrganization :exec
 DELETE FROM organizations WHERE organization_id = $1;
@@ -29,32 +64,152 @@ DELETE FROM organizations WHERE name = $1;
 SELECT *
 FROM organizations
 WHERE
-    -- Optional is_deleted filter
-    sqlc.narg('is_deleted')::boolean IS NULL OR is_deleted = sqlc.narg('is_deleted')::boolean
+    -- Optional filters
+    (sqlc.narg('is_deleted')::boolean IS NULL OR is_deleted = sqlc.narg('is_deleted')::boolean)
+    AND (sqlc.narg('create_by')::text IS NULL OR create_by = sqlc.narg('create_by')::text)
+    AND (sqlc.narg('create_time_start')::timestamptz IS NULL OR create_time >= sqlc.narg('create_time_start')::timestamptz)
+    AND (sqlc.narg('create_time_end')::timestamptz IS NULL OR create_time < sqlc.narg('create_time_end')::timestamptz)
+    AND (sqlc.narg('name_prefix')::text IS NULL OR starts_with(lower(name), lower(sqlc.narg('name_prefix')::text)))
+    AND (sqlc.narg('parent_id')::text IS NULL OR parent_id = sqlc.narg('parent_id')::text)
+    AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status')::text)
+    -- Optional membership filter, only organizations the user is a member of
+    AND (sqlc.narg('member_user_id')::text IS NULL OR EXISTS (
+        SELECT 1
+        FROM organization_members
+        WHERE organization_members.organization_id = organizations.organization_id AND organization_members.user_id = sqlc.narg('member_user_id')::text
+    ))
+    -- Optional label selector, the containment (@>) prefilter is served by the labels index while requirements
+    -- (a JSON array of {"key", "op", "values"} objects) are evaluated one by one
+    AND (sqlc.narg('label_contains')::text IS NULL OR labels @> sqlc.narg('label_contains')::text::jsonb)
+    AND (sqlc.narg('label_requirements')::text IS NULL OR NOT EXISTS (
+        SELECT 1
+        FROM jsonb_to_recordset(sqlc.narg('label_requirements')::text::jsonb) AS req(key text, op text, "values" jsonb)
+        WHERE NOT CASE req.op
+            WHEN 'exists' THEN labels ? req.key
+            WHEN '!' THEN NOT labels ? req.key
+            WHEN 'in' THEN COALESCE(req."values" ? (labels ->> req.key), false)
+            WHEN 'notin' THEN NOT COALESCE(req."values" ? (labels ->> req.key), false)
+            ELSE false
+        END
+    ))
     AND (
-        -- Optional page cursor
-        sqlc.narg('cursor_value')::timestamptz IS NULL -- Ignore if no cursor
-        OR (sqlc.narg('is_cursor_forward')::boolean = true AND create_time > sqlc.narg('cursor_value')::timestamptz) -- Next page
-        OR (sqlc.narg('is_cursor_forward')::boolean = false AND create_time < sqlc.narg('cursor_value')::timestamptz) -- Previous page
+        -- Optional page cursor, the sort key is paired with organization_id to break ties
+        sqlc.narg('cursor_organization_id')::text IS NULL -- Ignore if no cursor
+        OR (sqlc.arg('sort_by')::text = 'create_time' AND sqlc.arg('is_seek_ascending')::boolean = true
+            AND (create_time, organization_id) > (sqlc.narg('cursor_create_time')::timestamptz, sqlc.narg('cursor_organization_id')::text))
+        OR (sqlc.arg('sort_by')::text = 'create_time' AND sqlc.arg('is_seek_ascending')::boolean = false
+            AND (create_time, organization_id) < (sqlc.narg('cursor_create_time')::timestamptz, sqlc.narg('cursor_organization_id')::text))
+        OR (sqlc.arg('sort_by')::text = 'name' AND sqlc.arg('is_seek_ascending')::boolean = true
+            AND (name, organization_id) > (sqlc.narg('cursor_name')::text, sqlc.narg('cursor_organization_id')::text))
+        OR (sqlc.arg('sort_by')::text = 'name' AND sqlc.arg('is_seek_ascending')::boolean = false
+            AND (name, organization_id) < (sqlc.narg('cursor_name')::text, sqlc.narg('cursor_organization_id')::text))
     )
 ORDER BY
-    -- Order depends on direction
-    CASE WHEN sqlc.narg('is_cursor_forward')::boolean = true THEN create_time ELSE create_time END ASC
-LIMIT CASE WHEN sqlc.narg('page_size')::int IS NULL THEN 100 ELSE sqlc.narg('page_size') END;
+    -- Rows are read in seek order (closest rows to the cursor first), callers must reverse them when
+    -- seeking against the sort order (i.e. previous pages)
+    CASE WHEN sqlc.arg('sort_by')::text = 'create_time' AND sqlc.arg('is_seek_ascending')::boolean = true THEN create_time END ASC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'create_time' AND sqlc.arg('is_seek_ascending')::boolean = false THEN create_time END DESC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'name' AND sqlc.arg('is_seek_ascending')::boolean = true THEN name END ASC,
+    CASE WHEN sqlc.arg('sort_by')::text = 'name' AND sqlc.arg('is_seek_ascending')::boolean = false THEN name END DESC,
+    CASE WHEN sqlc.arg('is_seek_ascending')::boolean = true THEN organization_id END ASC,
+    CASE WHEN sqlc.arg('is_seek_ascending')::boolean = false THEN organization_id END DESC
+LIMIT sqlc.arg('page_size');
 
--- name: HasMorePagesOrganizationList :one
-SELECT EXISTS(
-    SELECT 1
+-- name: SearchOrganizations :many
+WITH matches AS (
+    SELECT
+        organization_id,
+        (
+            ts_rank(to_tsvector('simple', name), websearch_to_tsquery('simple', sqlc.arg('query')::text))
+            + ts_rank(to_tsvector('simple', name), to_tsquery('simple', sqlc.arg('prefix_query')::text))
+            + word_similarity(sqlc.arg('query')::text, name)
+        )::float8 AS rank
     FROM organizations
     WHERE
-        create_time > sqlc.narg('cursor_next')::timestamptz
-    LIMIT 1
-) AS has_next,
-EXISTS(
-    SELECT 1
-    FROM organizations
-    WHERE
-        create_time < sqlc.narg('cursor_prev')::timestamptz
-    ORDER BY create_time DESC
-    LIMIT 1
-) AS has_prev;
+        is_deleted = false
+        AND (
+            -- Full-text match
+            to_tsvector('simple', name) @@ websearch_to_tsquery('simple', sqlc.arg('query')::text)
+            -- Autocomplete (last term is a prefix)
+            OR to_tsvector('simple', name) @@ to_tsquery('simple', sqlc.arg('prefix_query')::text)
+            -- Typo-tolerant match
+            OR sqlc.arg('query')::text <% name
+        )
+        -- Optional membership filter, only organizations the user is a member of
+        AND (sqlc.narg('member_user_id')::text IS NULL OR EXISTS (
+            SELECT 1
+            FROM organization_members
+            WHERE organization_members.organization_id = organizations.organization_id AND organization_members.user_id = sqlc.narg('member_user_id')::text
+        ))
+)
+SELECT
+    sqlc.embed(organizations),
+    matches.rank,
+    ts_headline('simple', organizations.name,
+        websearch_to_tsquery('simple', sqlc.arg('query')::text) || to_tsquery('simple', sqlc.arg('prefix_query')::text),
+        'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')::text AS highlight
+FROM matches
+JOIN organizations ON organizations.organization_id = matches.organization_id
+WHERE
+    -- Optional page cursor, results are sorted by (rank DESC, organization_id ASC)
+    sqlc.narg('cursor_rank')::float8 IS NULL -- Ignore if no cursor
+    OR matches.rank < sqlc.narg('cursor_rank')::float8
+    OR (matches.rank = sqlc.narg('cursor_rank')::float8 AND matches.organization_id > sqlc.narg('cursor_organization_id')::text)
+ORDER BY matches.rank DESC, matches.organization_id ASC
+LIMIT sqlc.arg('page_size');
+
+-- name: ListOrganizationAncestors :many
+-- Lists the ancestors of an organization, from its parent (depth 1) up to the root.
+WITH RECURSIVE ancestors AS (
+    SELECT parent.organization_id, parent.parent_id, 1 AS depth
+    FROM organizations child
+    JOIN organizations parent ON parent.organization_id = child.parent_id
+    WHERE child.organization_id = sqlc.arg('organization_id')
+    UNION ALL
+    SELECT parent.organization_id, parent.parent_id, ancestors.depth + 1
+    FROM ancestors
+    JOIN organizations parent ON parent.organization_id = ancestors.parent_id
+)
+SELECT sqlc.embed(organizations), ancestors.depth::int AS depth
+FROM ancestors
+JOIN organizations ON organizations.organization_id = ancestors.organization_id
+ORDER BY ancestors.depth ASC;
+
+-- name: ListOrganizationDescendants :many
+-- Lists the descendants of an organization breadth-first, from its children (depth 1) down to the leaves.
+WITH RECURSIVE descendants AS (
+    SELECT child.organization_id, 1 AS depth
+    FROM organizations child
+    WHERE child.parent_id = sqlc.arg('organization_id')
+    UNION ALL
+    SELECT child.organization_id, descendants.depth + 1
+    FROM descendants
+    JOIN organizations child ON child.parent_id = descendants.organization_id
+)
+SELECT sqlc.embed(organizations), descendants.depth::int AS depth
+FROM descendants
+JOIN organizations ON organizations.organization_id = descendants.organization_id
+ORDER BY descendants.depth ASC, organizations.organization_id ASC;
+
+-- name: LockOrganizationByID :one
+-- Retrieves an organization and locks it until the transaction ends, so it cannot change in the meantime.
+SELECT * FROM organizations WHERE organization_id = $1 LIMIT 1 FOR UPDATE;
+
+-- name: LockOrganizationHierarchy :exec
+-- Locks the organization hierarchy until the transaction ends, so concurrent moves are serialized.
+SELECT pg_advisory_xact_lock(sqlc.arg('lock_id')::bigint);
+
+-- name: GetOrganizationSlugRedirect :one
+SELECT organization_id FROM organization_slug_redirects WHERE slug = lower(sqlc.arg('slug')) LIMIT 1;
+
+-- name: UpsertOrganizationSlugRedirect :exec
+INSERT INTO organization_slug_redirects (slug, organization_id, create_time)
+VALUES
+    (lower(sqlc.arg('slug')), sqlc.arg('organization_id'), sqlc.arg('create_time'))
+ON CONFLICT (slug) DO UPDATE
+SET
+    organization_id = EXCLUDED.organization_id,
+    create_time = EXCLUDED.create_time;
+
+-- name: DeleteOrganizationSlugRedirect :exec
+DELETE FROM organization_slug_redirects WHERE slug = lower(sqlc.arg('slug'));
@@ -0,0 +1,17 @@
+-- name: CreateOrganizationHistoryRecord :exec
+INSERT INTO organization_history (organization_id, operation, actor, request_id, before_snapshot, after_snapshot, record_time)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListOrganizationHistory :many
+SELECT *
+FROM organization_history
+WHERE
+    organization_id = sqlc.arg('organization_id')
+    -- Optional filters
+    AND (sqlc.narg('start_time')::timestamptz IS NULL OR record_time >= sqlc.narg('start_time')::timestamptz)
+    AND (sqlc.narg('end_time')::timestamptz IS NULL OR record_time < sqlc.narg('end_time')::timestamptz)
+    -- Optional page cursor
+    AND (sqlc.narg('cursor_record_id')::bigint IS NULL OR record_id < sqlc.narg('cursor_record_id')::bigint)
+ORDER BY record_id DESC
+LIMIT sqlc.arg('page_size');
@@ -0,0 +1,35 @@
+-- name: CreateOrganizationMember :exec
+INSERT INTO organization_members (organization_id, user_id, role, create_time, create_by, last_update_time, last_update_by, row_version)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetOrganizationMember :one
+SELECT * FROM organization_members WHERE organization_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: UpdateOrganizationMember :execrows
+UPDATE organization_members
+SET
+    role = $3,
+    last_update_time = $4,
+    last_update_by = $5,
+    row_version = $6
+WHERE organization_id = $1 AND user_id = $2 AND row_version = sqlc.arg('expected_row_version');
+
+-- name: DeleteOrganizationMember :exec
+DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2;
+
+-- name: LockOrganizationMembersByRole :many
+-- Locks the members holding a role until the transaction ends, so concurrent role changes are serialized.
+SELECT user_id FROM organization_members WHERE organization_id = $1 AND role = $2 FOR UPDATE;
+
+-- name: ListOrganizationMembers :many
+SELECT *
+FROM organization_members
+WHERE
+    organization_id = sqlc.arg('organization_id')
+    -- Optional filters
+    AND (sqlc.narg('role')::text IS NULL OR role = sqlc.narg('role')::text)
+    -- Optional page cursor
+    AND (sqlc.narg('cursor_user_id')::text IS NULL OR user_id > sqlc.narg('cursor_user_id')::text)
+ORDER BY user_id
+LIMIT sqlc.arg('page_size');
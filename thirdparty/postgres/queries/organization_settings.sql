@@ -0,0 +1,15 @@
+-- name: GetOrganizationSettings :one
+SELECT * FROM organization_settings WHERE organization_id = $1 LIMIT 1;
+
+-- name: UpsertOrganizationSettings :execrows
+-- Settings stored by someone else in the meantime (i.e. with another row version) are not overwritten.
+INSERT INTO organization_settings (organization_id, settings, create_time, create_by, last_update_time, last_update_by, row_version)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (organization_id) DO UPDATE
+SET
+    settings = EXCLUDED.settings,
+    last_update_time = EXCLUDED.last_update_time,
+    last_update_by = EXCLUDED.last_update_by,
+    row_version = EXCLUDED.row_version
+WHERE organization_settings.row_version = sqlc.arg('expected_row_version');
@@ -0,0 +1,77 @@
+-- name: CreateOutboxEvent :exec
+INSERT INTO outbox_events (event_id, topic, partition_key, source, subject, schema_source, content_type, payload,
+                           occurrence_time, create_time)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: TryLockOutboxRelay :one
+SELECT pg_try_advisory_xact_lock(sqlc.arg('lock_id')::bigint) AS acquired;
+
+-- name: ClaimPendingOutboxEvents :many
+-- Claims a batch of pending events by postponing their next attempt until the claim expires, so the caller
+-- can relay them outside the transaction. Claimed events are returned in no particular order.
+WITH pending AS (
+    SELECT e.sequence_id
+    FROM outbox_events e
+    WHERE
+        e.send_time IS NULL
+        AND e.next_attempt_time <= sqlc.arg('now')::timestamptz
+        -- Keep per-key ordering: skip events queued behind an earlier event waiting for a retry (or claimed)
+        AND NOT EXISTS(
+            SELECT 1
+            FROM outbox_events prev
+            WHERE
+                prev.partition_key = e.partition_key
+                AND prev.send_time IS NULL
+                AND prev.sequence_id < e.sequence_id
+                AND prev.next_attempt_time > sqlc.arg('now')::timestamptz
+        )
+    ORDER BY e.sequence_id ASC
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE outbox_events
+SET next_attempt_time = sqlc.arg('claim_expire_time')::timestamptz
+FROM pending
+WHERE outbox_events.sequence_id = pending.sequence_id
+RETURNING outbox_events.*;
+
+-- name: MarkOutboxEventSent :exec
+UPDATE outbox_events
+SET
+    send_time = $2,
+    last_error = NULL
+WHERE sequence_id = $1;
+
+-- name: MarkOutboxEventFailed :exec
+UPDATE outbox_events
+SET
+    attempts = attempts + 1,
+    next_attempt_time = $2,
+    last_error = $3
+WHERE sequence_id = $1;
+
+-- name: ReleaseOutboxEvent :exec
+-- Releases a claimed event without counting an attempt, so it is picked up by the next poll.
+UPDATE outbox_events
+SET next_attempt_time = $2
+WHERE sequence_id = $1;
+
+-- name: GetOutboxLag :one
+SELECT
+    COUNT(*) AS pending_count,
+    COALESCE(EXTRACT(EPOCH FROM (now() - MIN(create_time))), 0)::float8 AS lag_seconds
+FROM outbox_events
+WHERE send_time IS NULL;
+
+-- name: DeleteSentOutboxEvents :execrows
+-- Deletes a batch of events sent before the given time, oldest first. Events deleted by others are skipped.
+DELETE FROM outbox_events
+WHERE sequence_id IN (
+    SELECT sequence_id
+    FROM outbox_events
+    WHERE send_time < sqlc.arg('send_time_end')::timestamptz
+    ORDER BY sequence_id ASC
+    LIMIT sqlc.arg('batch_size')::int
+    FOR UPDATE SKIP LOCKED
+);